@@ -307,4 +307,28 @@ var (
 		Kinds:    naming.NewKinds(naming.KindSvc, naming.KindVol),
 		PG:       true,
 	}
+	SnapshotCreate = Properties{
+		Name:     "snapshot create",
+		Local:    true,
+		MustLock: true,
+		Kinds:    naming.NewKinds(naming.KindVol),
+	}
+	SnapshotDelete = Properties{
+		Name:     "snapshot delete",
+		Local:    true,
+		MustLock: true,
+		Kinds:    naming.NewKinds(naming.KindVol),
+	}
+	SnapshotRollback = Properties{
+		Name:     "snapshot rollback",
+		Local:    true,
+		MustLock: true,
+		Kinds:    naming.NewKinds(naming.KindVol),
+	}
+	Clone = Properties{
+		Name:     "clone",
+		Local:    true,
+		MustLock: true,
+		Kinds:    naming.NewKinds(naming.KindVol),
+	}
 )
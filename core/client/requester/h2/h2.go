@@ -15,6 +15,7 @@ import (
 	"github.com/opensvc/om3/daemon/api"
 	"github.com/opensvc/om3/daemon/daemonenv"
 	"github.com/opensvc/om3/util/httpclientcache"
+	"github.com/opensvc/om3/util/tracing"
 
 	"golang.org/x/net/http2"
 )
@@ -78,7 +79,7 @@ func NewUDS(config Config) (apiClient *api.ClientWithResponses, err error) {
 		Transport: tp,
 		Timeout:   config.Timeout,
 	}
-	if apiClient, err = api.NewClientWithResponses("http://localhost", api.WithHTTPClient(httpClient)); err != nil {
+	if apiClient, err = api.NewClientWithResponses("http://localhost", api.WithHTTPClient(httpClient), api.WithRequestEditorFn(tracing.InjectRequest)); err != nil {
 		return apiClient, err
 	} else {
 		return apiClient, nil
@@ -106,7 +107,10 @@ func NewInet(config Config) (apiClient *api.ClientWithResponses, err error) {
 		config.URL += fmt.Sprintf(":%d", daemonenv.HTTPPort)
 	}
 
-	options := []api.ClientOption{api.WithHTTPClient(httpClient)}
+	options := []api.ClientOption{
+		api.WithHTTPClient(httpClient),
+		api.WithRequestEditorFn(tracing.InjectRequest),
+	}
 
 	if config.Username != "" && config.Password != "" {
 		provider, err := securityprovider.NewSecurityProviderBasicAuth(config.Username, config.Password)
@@ -1,5 +1,7 @@
 package cluster
 
+import "time"
+
 type (
 	Nodes []string
 
@@ -19,6 +21,18 @@ type (
 		// fields private, no exposed in daemon data
 		// json nor events
 		secret string
+
+		// nextSecret is the secret distributed to the cluster nodes
+		// before a secret rotation switch.
+		nextSecret string
+
+		// previousSecret is the secret replaced by a secret rotation,
+		// still accepted until it is retired.
+		previousSecret string
+
+		// secretGracePeriod is the minimum delay between a secret rotation
+		// switch and the previous secret retirement.
+		secretGracePeriod time.Duration
 	}
 	ConfigListener struct {
 		CRL             string `json:"crl"`
@@ -54,6 +68,43 @@ func (t *Config) SetSecret(s string) {
 	t.secret = s
 }
 
+func (t Config) NextSecret() string {
+	return t.nextSecret
+}
+
+func (t *Config) SetNextSecret(s string) {
+	t.nextSecret = s
+}
+
+func (t Config) PreviousSecret() string {
+	return t.previousSecret
+}
+
+func (t *Config) SetPreviousSecret(s string) {
+	t.previousSecret = s
+}
+
+func (t Config) SecretGracePeriod() time.Duration {
+	return t.secretGracePeriod
+}
+
+func (t *Config) SetSecretGracePeriod(d time.Duration) {
+	t.secretGracePeriod = d
+}
+
+// AltSecrets returns the secrets accepted in addition to the current secret
+// during a secret rotation: the next secret, then the previous secret.
+func (t Config) AltSecrets() []string {
+	l := make([]string, 0, 2)
+	if t.nextSecret != "" {
+		l = append(l, t.nextSecret)
+	}
+	if t.previousSecret != "" {
+		l = append(l, t.previousSecret)
+	}
+	return l
+}
+
 func (t Nodes) Contains(s string) bool {
 	for _, nodename := range t {
 		if nodename == s {
@@ -74,6 +125,10 @@ func (t *Config) DeepCopy() *Config {
 		Quorum:     t.Quorum,
 		Vip:        *t.Vip.DeepCopy(),
 		secret:     t.secret,
+
+		nextSecret:        t.nextSecret,
+		previousSecret:    t.previousSecret,
+		secretGracePeriod: t.secretGracePeriod,
 	}
 }
 
@@ -0,0 +1,219 @@
+// Package confighistory stores the past revisions of an object
+// configuration file, so they can be listed, compared and restored.
+//
+// The revisions are stored in the object var directory, as a
+// <id>.conf file with the configuration content and a <id>.json file
+// with the revision metadata.
+package confighistory
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/util/hostname"
+)
+
+type (
+	// T is the configuration history of an object.
+	T struct {
+		path naming.Path
+		dir  string
+		max  int
+	}
+
+	// Revision describes a stored configuration revision.
+	Revision struct {
+		ID        int       `json:"id"`
+		Author    string    `json:"author"`
+		Node      string    `json:"node"`
+		CreatedAt time.Time `json:"created_at"`
+		Comment   string    `json:"comment"`
+	}
+
+	Revisions []Revision
+)
+
+const (
+	// DefaultMax is the default number of revisions kept per object.
+	DefaultMax = 10
+)
+
+var (
+	ErrNotFound = errors.New("config revision not found")
+
+	// mu serializes the history updates of all objects. Updates are
+	// rare, so there is no need for a per-object lock.
+	mu sync.Mutex
+)
+
+// New returns the configuration history of the object p, keeping at most
+// max revisions. A max lower than 1 disables the recording of new
+// revisions.
+func New(p naming.Path, max int) *T {
+	return &T{
+		path: p,
+		dir:  filepath.Join(p.VarDir(), "config_history"),
+		max:  max,
+	}
+}
+
+// List returns the stored revisions, oldest first.
+func (t *T) List() (Revisions, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	return t.list()
+}
+
+func (t *T) list() (Revisions, error) {
+	l := make(Revisions, 0)
+	matches, err := filepath.Glob(filepath.Join(t.dir, "*.json"))
+	if err != nil {
+		return l, err
+	}
+	for _, filename := range matches {
+		var rev Revision
+		b, err := os.ReadFile(filename)
+		if err != nil {
+			return l, err
+		}
+		if err := json.Unmarshal(b, &rev); err != nil {
+			return l, fmt.Errorf("%s: %w", filename, err)
+		}
+		l = append(l, rev)
+	}
+	sort.Slice(l, func(i, j int) bool { return l[i].ID < l[j].ID })
+	return l, nil
+}
+
+// Get returns the revision id and its configuration content.
+func (t *T) Get(id int) (Revision, []byte, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	return t.get(id)
+}
+
+func (t *T) get(id int) (Revision, []byte, error) {
+	var rev Revision
+	b, err := os.ReadFile(t.metaFile(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return rev, nil, fmt.Errorf("%w: %s revision %d", ErrNotFound, t.path, id)
+	} else if err != nil {
+		return rev, nil, err
+	}
+	if err := json.Unmarshal(b, &rev); err != nil {
+		return rev, nil, err
+	}
+	data, err := os.ReadFile(t.dataFile(id))
+	if err != nil {
+		return rev, nil, err
+	}
+	return rev, data, nil
+}
+
+// RecordFile records the current content of the object configuration file
+// as a new revision. See Record.
+func (t *T) RecordFile(author, comment string) (Revision, bool, error) {
+	b, err := os.ReadFile(t.path.ConfigFile())
+	if errors.Is(err, os.ErrNotExist) {
+		return Revision{}, false, nil
+	} else if err != nil {
+		return Revision{}, false, err
+	}
+	return t.Record(b, author, comment)
+}
+
+// Record stores data as a new revision and drops the revisions exceeding
+// the history size. No revision is stored if data is the content of the
+// latest revision, in which case the returned bool is false.
+func (t *T) Record(data []byte, author, comment string) (Revision, bool, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	if t.max < 1 {
+		return Revision{}, false, nil
+	}
+	l, err := t.list()
+	if err != nil {
+		return Revision{}, false, err
+	}
+	rev := Revision{
+		ID:        1,
+		Author:    author,
+		Node:      hostname.Hostname(),
+		CreatedAt: time.Now(),
+		Comment:   comment,
+	}
+	if n := len(l); n > 0 {
+		latest := l[n-1]
+		if _, b, err := t.get(latest.ID); err == nil && bytes.Equal(b, data) {
+			return latest, false, nil
+		}
+		rev.ID = latest.ID + 1
+	}
+	if err := os.MkdirAll(t.dir, 0700); err != nil {
+		return rev, false, err
+	}
+	if err := os.WriteFile(t.dataFile(rev.ID), data, 0600); err != nil {
+		return rev, false, err
+	}
+	if b, err := json.Marshal(rev); err != nil {
+		return rev, false, err
+	} else if err := os.WriteFile(t.metaFile(rev.ID), b, 0600); err != nil {
+		return rev, false, err
+	}
+	l = append(l, rev)
+	for len(l) > t.max {
+		_ = os.Remove(t.metaFile(l[0].ID))
+		_ = os.Remove(t.dataFile(l[0].ID))
+		l = l[1:]
+	}
+	return rev, true, nil
+}
+
+func (t *T) metaFile(id int) string {
+	return filepath.Join(t.dir, strconv.Itoa(id)+".json")
+}
+
+func (t *T) dataFile(id int) string {
+	return filepath.Join(t.dir, strconv.Itoa(id)+".conf")
+}
+
+// Diff returns the unified diff from the content a named aName to the
+// content b named bName.
+func Diff(aName string, a []byte, bName string, b []byte) string {
+	edits := myers.ComputeEdits(span.URIFromPath(aName), string(a), string(b))
+	return fmt.Sprint(gotextdiff.ToUnified(aName, bName, string(a), edits))
+}
+
+// Name returns the revision name used in diff headers.
+func (t Revision) Name() string {
+	return "revision " + strconv.Itoa(t.ID)
+}
+
+// String returns a one line description of the revision.
+func (t Revision) String() string {
+	l := []string{t.Name(), t.CreatedAt.Format(time.RFC3339)}
+	if t.Author != "" {
+		l = append(l, "by "+t.Author)
+	}
+	if t.Node != "" {
+		l = append(l, "on "+t.Node)
+	}
+	if t.Comment != "" {
+		l = append(l, "("+t.Comment+")")
+	}
+	return strings.Join(l, " ")
+}
@@ -0,0 +1,63 @@
+package confighistory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/testhelper"
+)
+
+func TestRecord(t *testing.T) {
+	testhelper.Setup(t)
+	p := naming.Path{Name: "foo", Kind: naming.KindSvc, Namespace: "root"}
+	h := New(p, 2)
+
+	rev, recorded, err := h.Record([]byte("[DEFAULT]\nnodes = n1\n"), "root", "")
+	require.NoError(t, err)
+	assert.True(t, recorded)
+	assert.Equal(t, 1, rev.ID)
+
+	rev, recorded, err = h.Record([]byte("[DEFAULT]\nnodes = n1\n"), "root", "")
+	require.NoError(t, err)
+	assert.False(t, recorded, "same content as the latest revision")
+	assert.Equal(t, 1, rev.ID)
+
+	for _, s := range []string{"n1 n2", "n1 n2 n3"} {
+		_, recorded, err = h.Record([]byte("[DEFAULT]\nnodes = "+s+"\n"), "admin", "")
+		require.NoError(t, err)
+		assert.True(t, recorded)
+	}
+
+	l, err := h.List()
+	require.NoError(t, err)
+	require.Len(t, l, 2, "the oldest revision is dropped")
+	assert.Equal(t, 2, l[0].ID)
+	assert.Equal(t, 3, l[1].ID)
+	assert.Equal(t, "admin", l[1].Author)
+
+	_, _, err = h.Get(1)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, b, err := h.Get(3)
+	require.NoError(t, err)
+	assert.Equal(t, "[DEFAULT]\nnodes = n1 n2 n3\n", string(b))
+
+	diff := Diff(l[0].Name(), []byte("[DEFAULT]\nnodes = n1 n2\n"), l[1].Name(), b)
+	assert.Contains(t, diff, "-nodes = n1 n2\n")
+	assert.Contains(t, diff, "+nodes = n1 n2 n3\n")
+}
+
+func TestRecordDisabled(t *testing.T) {
+	testhelper.Setup(t)
+	p := naming.Path{Name: "foo", Kind: naming.KindSvc, Namespace: "root"}
+	h := New(p, 0)
+	_, recorded, err := h.Record([]byte("[DEFAULT]\n"), "root", "")
+	require.NoError(t, err)
+	assert.False(t, recorded)
+	l, err := h.List()
+	require.NoError(t, err)
+	assert.Len(t, l, 0)
+}
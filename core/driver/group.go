@@ -17,6 +17,7 @@ const (
 	GroupShare
 	GroupContainer
 	GroupApp
+	GroupCheck
 	GroupSync
 	GroupTask
 	GroupCertificate
@@ -33,7 +34,7 @@ const (
 )
 
 var (
-	resourceGroups = GroupIP | GroupVolume | GroupDisk | GroupFS | GroupShare | GroupContainer | GroupApp | GroupSync | GroupTask | GroupCertificate | GroupExpose | GroupRoute | GroupVhost
+	resourceGroups = GroupIP | GroupVolume | GroupDisk | GroupFS | GroupShare | GroupContainer | GroupApp | GroupCheck | GroupSync | GroupTask | GroupCertificate | GroupExpose | GroupRoute | GroupVhost
 
 	toGroupID = map[string]Group{
 		"ip":          GroupIP,
@@ -43,6 +44,7 @@ var (
 		"share":       GroupShare,
 		"container":   GroupContainer,
 		"app":         GroupApp,
+		"check":       GroupCheck,
 		"sync":        GroupSync,
 		"task":        GroupTask,
 		"certificate": GroupCertificate,
@@ -65,6 +67,7 @@ var (
 		GroupShare:       "share",
 		GroupContainer:   "container",
 		GroupApp:         "app",
+		GroupCheck:       "check",
 		GroupSync:        "sync",
 		GroupTask:        "task",
 		GroupCertificate: "certificate",
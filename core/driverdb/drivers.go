@@ -18,6 +18,9 @@ import (
 	_ "github.com/opensvc/om3/drivers/resappforking"
 	_ "github.com/opensvc/om3/drivers/resappsimple"
 	_ "github.com/opensvc/om3/drivers/rescertificatetls"
+	_ "github.com/opensvc/om3/drivers/rescheckexec"
+	_ "github.com/opensvc/om3/drivers/rescheckhttp"
+	_ "github.com/opensvc/om3/drivers/reschecktcp"
 	_ "github.com/opensvc/om3/drivers/resdiskdisk"
 	_ "github.com/opensvc/om3/drivers/resdiskloop"
 	_ "github.com/opensvc/om3/drivers/resdisklv"
@@ -25,6 +28,7 @@ import (
 	_ "github.com/opensvc/om3/drivers/resdiskraw"
 	_ "github.com/opensvc/om3/drivers/resdiskvg"
 	_ "github.com/opensvc/om3/drivers/resexposeenvoy"
+	_ "github.com/opensvc/om3/drivers/resfsbind"
 	_ "github.com/opensvc/om3/drivers/resfsdir"
 	_ "github.com/opensvc/om3/drivers/resfsflag"
 	_ "github.com/opensvc/om3/drivers/resfshost"
@@ -33,6 +37,9 @@ import (
 	_ "github.com/opensvc/om3/drivers/resiproute"
 	_ "github.com/opensvc/om3/drivers/resrouteenvoy"
 	_ "github.com/opensvc/om3/drivers/ressharenfs"
+	_ "github.com/opensvc/om3/drivers/ressharesmb"
+	_ "github.com/opensvc/om3/drivers/ressyncblockdelta"
+	_ "github.com/opensvc/om3/drivers/ressyncbtrfs"
 	_ "github.com/opensvc/om3/drivers/ressyncrsync"
 	_ "github.com/opensvc/om3/drivers/ressyncsymsnapvx"
 	_ "github.com/opensvc/om3/drivers/ressynczfs"
@@ -41,4 +48,7 @@ import (
 	_ "github.com/opensvc/om3/drivers/restaskhost"
 	_ "github.com/opensvc/om3/drivers/resvhostenvoy"
 	_ "github.com/opensvc/om3/drivers/resvol"
+	_ "github.com/opensvc/om3/drivers/stonithcmd"
+	_ "github.com/opensvc/om3/drivers/stonithipmi"
+	_ "github.com/opensvc/om3/drivers/stonithredfish"
 )
@@ -10,16 +10,21 @@ import (
 	_ "github.com/opensvc/om3/drivers/pooldrbd"
 	_ "github.com/opensvc/om3/drivers/poolloop"
 	_ "github.com/opensvc/om3/drivers/poolvg"
+	_ "github.com/opensvc/om3/drivers/resappsystemd"
 	_ "github.com/opensvc/om3/drivers/rescontainerdocker"
 	_ "github.com/opensvc/om3/drivers/rescontainerdockercli"
 	_ "github.com/opensvc/om3/drivers/rescontainerkvm"
 	_ "github.com/opensvc/om3/drivers/rescontainerlxc"
+	_ "github.com/opensvc/om3/drivers/rescontainernspawn"
 	_ "github.com/opensvc/om3/drivers/rescontainerpodman"
 	_ "github.com/opensvc/om3/drivers/rescontainervbox"
 	_ "github.com/opensvc/om3/drivers/resdiskcrypt"
 	_ "github.com/opensvc/om3/drivers/resdiskdrbd"
+	_ "github.com/opensvc/om3/drivers/resdiskiscsi"
 	_ "github.com/opensvc/om3/drivers/resdiskzpool"
 	_ "github.com/opensvc/om3/drivers/resdiskzvol"
+	_ "github.com/opensvc/om3/drivers/resfsoverlay"
 	_ "github.com/opensvc/om3/drivers/resipcni"
 	_ "github.com/opensvc/om3/drivers/resipnetns"
+	_ "github.com/opensvc/om3/drivers/resipvrrp"
 )
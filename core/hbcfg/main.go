@@ -82,6 +82,7 @@ type (
 		Rx() hbtype.Receiver
 		Nodes() []string
 		Signature() string
+		Compression() string
 
 		SetName(string)
 		SetDriver(string)
@@ -227,6 +228,12 @@ func (t *T) Signature() string {
 	return t.sig
 }
 
+// Compression returns the name of the codec the tx should use to compress
+// the messages, when supported by all peers.
+func (t *T) Compression() string {
+	return t.GetString("compression")
+}
+
 func (t *T) Interval() time.Duration {
 	return t.interval
 }
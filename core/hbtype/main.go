@@ -19,6 +19,29 @@ type (
 		Events    map[string][]event.Event `json:"events,omitempty"`
 		NodeData  node.Node                `json:"node_data,omitempty"`
 		Nodename  string                   `json:"nodename"`
+
+		// Codecs are the compression codecs the sender can decode. The
+		// peers use them to select the codec of the messages they send.
+		Codecs []string `json:"codecs,omitempty"`
+
+		// Bases are the gens of the peers node data applied by the sender,
+		// kept when the sender asks for a full message. The peers use them
+		// as the base of the delta of their full messages.
+		Bases map[string]uint64 `json:"bases,omitempty"`
+
+		// Delta is set when the NodeData of a full message only holds the
+		// instances changed since the Delta.Base gen.
+		Delta *MsgDelta `json:"delta,omitempty"`
+	}
+
+	// MsgDelta describes the instances of a full message NodeData.
+	MsgDelta struct {
+		// Base is the sender gen the NodeData instances are a delta of.
+		Base uint64 `json:"base"`
+
+		// Instances are the paths of all the sender instances. The
+		// instances absent from the NodeData are unchanged since Base.
+		Instances []string `json:"instances"`
 	}
 
 	// IDStopper is the interface to stop a hb driver
@@ -33,6 +56,12 @@ type (
 		Start(cmdC chan<- interface{}, dataC <-chan []byte) error
 	}
 
+	// MsgSizeLimiter is the interface a Transmitter implements when the
+	// size of the messages it can send is limited, like the hb disk slots.
+	MsgSizeLimiter interface {
+		MaxMsgSize() int
+	}
+
 	// Receiver is the interface that wraps the basic methods for hb driver to receive hb messages
 	Receiver interface {
 		IDStopper
@@ -15,26 +15,32 @@ type (
 	// Config describes a configuration file content checksum,
 	// timestamp of last change and the nodes it should be installed on.
 	Config struct {
-		App              string           `json:"app,omitempty"`
-		Checksum         string           `json:"csum"`
-		Children         naming.Relations `json:"children,omitempty"`
-		DRP              bool             `json:"drp,omitempty"`
-		Env              string           `json:"env,omitempty"`
-		FlexMax          int              `json:"flex_max,omitempty"`
-		FlexMin          int              `json:"flex_min,omitempty"`
-		FlexTarget       int              `json:"flex_target,omitempty"`
-		MonitorAction    MonitorAction    `json:"monitor_action,omitempty"`
-		PreMonitorAction string           `json:"pre_monitor_action,omitempty"`
-		Orchestrate      string           `json:"orchestrate"`
-		Path             naming.Path      `json:"-"`
-		Parents          naming.Relations `json:"parents,omitempty"`
-		PlacementPolicy  placement.Policy `json:"placement_policy"`
-		Priority         priority.T       `json:"priority,omitempty"`
-		Resources        ResourceConfigs  `json:"resources"`
-		Scope            []string         `json:"scope"`
-		Subsets          SubsetConfigs    `json:"subsets"`
-		Topology         topology.T       `json:"topology"`
-		UpdatedAt        time.Time        `json:"updated_at"`
+		App               string           `json:"app,omitempty"`
+		Checksum          string           `json:"csum"`
+		Children          naming.Relations `json:"children,omitempty"`
+		CPUReqs           float64          `json:"cpu_reqs,omitempty"`
+		DRP               bool             `json:"drp,omitempty"`
+		Env               string           `json:"env,omitempty"`
+		FlexMax           int              `json:"flex_max,omitempty"`
+		FlexMin           int              `json:"flex_min,omitempty"`
+		FlexTarget        int              `json:"flex_target,omitempty"`
+		HardAffinity      []string         `json:"hard_affinity,omitempty"`
+		HardAntiAffinity  []string         `json:"hard_anti_affinity,omitempty"`
+		MaintenanceWindow string           `json:"maintenance_window,omitempty"`
+		MemReqs           int64            `json:"mem_reqs,omitempty"`
+		MonitorAction     MonitorAction    `json:"monitor_action,omitempty"`
+		PreMonitorAction  string           `json:"pre_monitor_action,omitempty"`
+		Orchestrate       string           `json:"orchestrate"`
+		Path              naming.Path      `json:"-"`
+		Parents           naming.Relations `json:"parents,omitempty"`
+		PlacementPolicy   placement.Policy `json:"placement_policy"`
+		Priority          priority.T       `json:"priority,omitempty"`
+		Resources         ResourceConfigs  `json:"resources"`
+		Scope             []string         `json:"scope"`
+		Stonith           bool             `json:"stonith,omitempty"`
+		Subsets           SubsetConfigs    `json:"subsets"`
+		Topology          topology.T       `json:"topology"`
+		UpdatedAt         time.Time        `json:"updated_at"`
 
 		// Volume specific
 		Pool *string `json:"pool,omitempty"`
@@ -65,6 +71,8 @@ func (m SubsetConfigs) DeepCopy() SubsetConfigs {
 func (cfg Config) DeepCopy() *Config {
 	newCfg := cfg
 	newCfg.Scope = append([]string{}, cfg.Scope...)
+	newCfg.HardAffinity = append([]string{}, cfg.HardAffinity...)
+	newCfg.HardAntiAffinity = append([]string{}, cfg.HardAntiAffinity...)
 	newCfg.Subsets = cfg.Subsets.DeepCopy()
 	newCfg.Resources = cfg.Resources.DeepCopy()
 	return &newCfg
@@ -100,11 +108,16 @@ func (t Config) Unstructured() map[string]any {
 		"app":                t.App,
 		"csum":               t.Checksum,
 		"children":           t.Children,
+		"cpu_reqs":           t.CPUReqs,
 		"drp":                t.DRP,
 		"env":                t.Env,
 		"flex_max":           t.FlexMax,
 		"flex_min":           t.FlexMin,
 		"flex_target":        t.FlexTarget,
+		"hard_affinity":      t.HardAffinity,
+		"hard_anti_affinity": t.HardAntiAffinity,
+		"maintenance_window": t.MaintenanceWindow,
+		"mem_reqs":           t.MemReqs,
 		"monitor_action":     t.MonitorAction,
 		"pre_monitor_action": t.PreMonitorAction,
 		"orchestrate":        t.Orchestrate,
@@ -23,6 +23,9 @@ type (
 		nodeToPath map[string]map[naming.Path]struct{}
 		pathToNode map[naming.Path]map[string]struct{}
 		data       map[string]*T
+
+		// gen is incremented on each data change, see Gen.
+		gen uint64
 	}
 )
 
@@ -50,6 +53,7 @@ func (c *Data[T]) Set(p naming.Path, nodename string, v *T) {
 	c.nodeToPath[nodename][p] = struct{}{}
 	c.pathToNode[p][nodename] = struct{}{}
 	c.data[InstanceString(p, nodename)] = v
+	c.gen++
 }
 
 // Unset removes an instance data
@@ -65,6 +69,7 @@ func (c *Data[T]) Unset(p naming.Path, nodename string) {
 		delete(c.pathToNode, p)
 	}
 	delete(c.data, InstanceString(p, nodename))
+	c.gen++
 }
 
 // DropNode removes node instances
@@ -76,6 +81,16 @@ func (c *Data[T]) DropNode(nodename string) {
 		delete(c.data, InstanceString(p, nodename))
 	}
 	delete(c.nodeToPath, nodename)
+	c.gen++
+}
+
+// Gen returns the generation of the data, incremented on each change, so
+// the users caching a value computed from the data can detect it is stale
+// without walking the data.
+func (c *Data[T]) Gen() uint64 {
+	c.RLock()
+	defer c.RUnlock()
+	return c.gen
 }
 
 // Get returns an instance data or nil if data is not found
@@ -37,3 +37,21 @@ func Test_Mapper(t *testing.T) {
 	require.Len(t, mapper.GetByNode("node1"), 1)
 	require.Len(t, mapper.GetByNode("node2"), 1)
 }
+
+func Test_MapperGen(t *testing.T) {
+	mapper := NewData[Status]()
+	p, _ := naming.ParsePath("foo")
+	gen := mapper.Gen()
+	mapper.Set(p, "node1", &Status{Avail: status.Up})
+	require.Greater(t, mapper.Gen(), gen)
+	gen = mapper.Gen()
+	mapper.Unset(p, "node1")
+	require.Greater(t, mapper.Gen(), gen)
+	gen = mapper.Gen()
+	mapper.DropNode("node1")
+	require.Greater(t, mapper.Gen(), gen)
+	gen = mapper.Gen()
+	mapper.Get(p, "node1")
+	mapper.GetAll()
+	require.Equal(t, gen, mapper.Gen(), "the reads don't change the gen")
+}
@@ -9,8 +9,10 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/opensvc/om3/core/placement"
 	"github.com/opensvc/om3/core/resource"
 	"github.com/opensvc/om3/core/resourceid"
+	"github.com/opensvc/om3/core/schedule"
 	"github.com/opensvc/om3/core/status"
 	"github.com/opensvc/om3/util/xmap"
 )
@@ -53,6 +55,24 @@ type (
 
 		Parents  map[string]status.T `json:"parents,omitempty"`
 		Children map[string]status.T `json:"children,omitempty"`
+
+		// PlacementExplain explains the rank of the local node in the
+		// candidates list of placement policies supporting explanations.
+		PlacementExplain *placement.Candidate `json:"placement_explain,omitempty"`
+
+		// PlacementRank is the 1-based rank of the local node in the ha
+		// leader candidates list sorted by the placement policy. Zero means
+		// the local node is not a candidate.
+		PlacementRank int `json:"placement_rank,omitempty"`
+
+		// MaintenanceWindow is the open or next maintenance window of the
+		// local instance, combining the node and object windows.
+		MaintenanceWindow *schedule.Window `json:"maintenance_window,omitempty"`
+
+		// TraceParent is the W3C trace context of the request that started
+		// the orchestration, so the orchestration steps of all nodes join
+		// the request trace.
+		TraceParent string `json:"trace_parent,omitempty"`
 	}
 
 	ResourceMonitors map[string]ResourceMonitor
@@ -68,6 +88,10 @@ type (
 
 		// CandidateOrchestrationID is a candidate orchestration id for a new imon orchestration.
 		CandidateOrchestrationID uuid.UUID `json:"orchestration_id"`
+
+		// TraceParent is the W3C trace context of the request setting the
+		// candidate orchestration.
+		TraceParent string `json:"trace_parent,omitempty"`
 	}
 
 	// ResourceMonitor describes the restart states maintained by the daemon
@@ -447,6 +471,14 @@ func (mon Monitor) ResourceFlagRestartString(rid resourceid.T, r resource.Status
 func (mon Monitor) DeepCopy() *Monitor {
 	v := mon
 	v.Resources = v.Resources.DeepCopy()
+	if mon.PlacementExplain != nil {
+		explain := *mon.PlacementExplain
+		v.PlacementExplain = &explain
+	}
+	if mon.MaintenanceWindow != nil {
+		w := *mon.MaintenanceWindow
+		v.MaintenanceWindow = &w
+	}
 	if mon.GlobalExpectOptions != nil {
 		switch mon.GlobalExpect {
 		case MonitorGlobalExpectPlacedAt:
@@ -505,6 +537,18 @@ func (t Monitor) Unstructured() map[string]any {
 	if len(t.Children) > 0 {
 		m["children"] = t.Children
 	}
+	if t.PlacementExplain != nil {
+		m["placement_explain"] = *t.PlacementExplain
+	}
+	if t.PlacementRank > 0 {
+		m["placement_rank"] = t.PlacementRank
+	}
+	if t.MaintenanceWindow != nil {
+		m["maintenance_window"] = *t.MaintenanceWindow
+	}
+	if t.TraceParent != "" {
+		m["trace_parent"] = t.TraceParent
+	}
 	return m
 }
 
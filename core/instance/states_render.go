@@ -2,6 +2,7 @@ package instance
 
 import (
 	"strings"
+	"time"
 
 	"github.com/opensvc/om3/core/colorstatus"
 	"github.com/opensvc/om3/core/provisioned"
@@ -92,6 +93,8 @@ func (t States) LoadTreeNode(head *tree.Node) {
 	}
 	t.loadTreeNodeParents(head)
 	t.loadTreeNodeChildren(head)
+	t.loadTreeNodePlacement(head)
+	t.loadTreeNodeMaintenanceWindow(head)
 }
 
 func (t States) descString() string {
@@ -188,3 +191,32 @@ func (t States) loadTreeNodeChildren(head *tree.Node) {
 		pNode.AddColumn().AddText(colorstatus.Sprint(availStatus, rawconfig.Colorize))
 	}
 }
+
+func (t States) loadTreeNodePlacement(head *tree.Node) {
+	if t.Monitor.PlacementExplain == nil {
+		return
+	}
+	n := head.AddNode()
+	n.AddColumn().AddText("placement")
+	n.AddColumn()
+	n.AddColumn()
+	desc := n.AddColumn().AddText(t.Monitor.PlacementExplain.String())
+	if !t.Monitor.PlacementExplain.Eligible {
+		desc.SetColor(rawconfig.Color.Warning)
+	}
+}
+
+func (t States) loadTreeNodeMaintenanceWindow(head *tree.Node) {
+	if t.Monitor.MaintenanceWindow == nil {
+		return
+	}
+	w := *t.Monitor.MaintenanceWindow
+	n := head.AddNode()
+	n.AddColumn().AddText("maintenance window")
+	n.AddColumn()
+	n.AddColumn()
+	desc := n.AddColumn().AddText(w.Key + ": " + w.String())
+	if w.IsOpen(time.Now()) {
+		desc.SetColor(rawconfig.Color.Warning)
+	}
+}
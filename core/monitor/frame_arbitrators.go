@@ -3,7 +3,9 @@ package monitor
 import (
 	"fmt"
 	"sort"
+	"time"
 
+	"github.com/opensvc/om3/core/node"
 	"github.com/opensvc/om3/core/status"
 )
 
@@ -21,7 +23,7 @@ func (f Frame) wArbitrators() {
 	for _, name := range arbitrators {
 		for i, node := range f.Current.Cluster.Config.Nodes {
 			if i == 0 {
-				s += bold(" "+name) + "\t\t\t" + f.info.separator + "\t"
+				s += bold(" "+name) + "\t" + f.sArbitratorLease(name) + "\t" + f.info.separator + "\t"
 			}
 			aStatus := f.Current.Cluster.Node[node].Status.Arbitrators[name].Status
 			switch aStatus {
@@ -36,3 +38,22 @@ func (f Frame) wArbitrators() {
 	fmt.Fprintf(f.w, s)
 	fmt.Fprintln(f.w, f.info.empty)
 }
+
+// sArbitratorLease returns the holder and the age of a qdisk arbitrator
+// lease, from the most recent lease renewal seen by the cluster nodes.
+func (f Frame) sArbitratorLease(name string) string {
+	var lease *node.ArbitratorLease
+	for _, nodename := range f.Current.Cluster.Config.Nodes {
+		l := f.Current.Cluster.Node[nodename].Status.Arbitrators[name].Lease
+		if l == nil {
+			continue
+		}
+		if lease == nil || l.RenewedAt.After(lease.RenewedAt) {
+			lease = l
+		}
+	}
+	if lease == nil {
+		return "\t"
+	}
+	return lease.Holder + "\t" + time.Since(lease.TakenAt).Round(time.Second).String()
+}
@@ -2,6 +2,7 @@ package monitor
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/opensvc/om3/daemon/daemonsubsystem"
 )
@@ -108,7 +109,7 @@ func (f Frame) wThreadHeartbeats() string {
 		default:
 			s += red("unknown") + sThreadAlerts(hbStatus.Alerts)
 		}
-		s += "\t" + hbStatus.Type + "\t"
+		s += "\t" + hbStatus.Type + sThreadHeartbeatStats(hbStatus.Peers) + "\t"
 		s += f.info.separator + "\t"
 		for _, peer := range f.Current.Cluster.Config.Nodes {
 			if peer == f.Nodename {
@@ -131,6 +132,100 @@ func (f Frame) wThreadHeartbeats() string {
 	return s
 }
 
+// sThreadHeartbeatStats returns the highest peer latency and the total
+// peer reconnects of a heartbeat stream, if reported by its driver.
+func sThreadHeartbeatStats(peers map[string]daemonsubsystem.HeartbeatStreamPeerStatus) string {
+	var (
+		latency    time.Duration
+		reconnects int
+	)
+	for _, peer := range peers {
+		if peer.Latency > latency {
+			latency = peer.Latency
+		}
+		reconnects += peer.Reconnects
+	}
+	var s string
+	if latency > 0 {
+		s += " rtt=" + latency.Round(time.Microsecond).String()
+	}
+	if reconnects > 0 {
+		s += fmt.Sprintf(" reconnects=%d", reconnects)
+	}
+	return s
+}
+
+func (f Frame) wThreadNotifier() string {
+	s := fmt.Sprintf(" %s\t\t\t%s", bold("notify"), f.info.separator+"\t")
+	s += f.info.emptyNodes
+	for _, notifierStatus := range f.Current.Cluster.Node[f.Nodename].Daemon.Notifier.Notifiers {
+		s += bold("\n  "+notifierStatus.ID) + "\t"
+		switch notifierStatus.State {
+		case "idle", "ok":
+			s += green(notifierStatus.State)
+		case "failed":
+			s += red("failed")
+		default:
+			s += red("unknown")
+		}
+		if notifierStatus.Failed > 0 || notifierStatus.Dropped > 0 {
+			s += yellow("!")
+		}
+		s += "\t" + notifierStatus.Type + "\t"
+		s += f.info.separator + "\t"
+		for _, peer := range f.Current.Cluster.Config.Nodes {
+			s += sThreadNotifierPeer(f.Current.Cluster.Node[peer].Daemon.Notifier, notifierStatus.ID) + "\t"
+		}
+	}
+	return s
+}
+
+// sThreadNotifierPeer returns the icon of the delivery state of the
+// notifier id on a node.
+func sThreadNotifierPeer(data daemonsubsystem.Notifier, id string) string {
+	for _, notifierStatus := range data.Notifiers {
+		if notifierStatus.ID != id {
+			continue
+		}
+		switch notifierStatus.State {
+		case "idle":
+			return iconStandbyUp
+		case "ok":
+			return iconUp
+		case "failed":
+			return iconDownIssue
+		}
+	}
+	return iconUndef
+}
+
+// wThreadClusterSecret returns the cluster secret rotation step of each
+// node.
+func (f Frame) wThreadClusterSecret() string {
+	local := f.Current.Cluster.Node[f.Nodename].Daemon.ClusterSecret
+	s := bold(" secret") + "\t" + local.State
+	if len(local.Waiting) > 0 {
+		s += yellow("!")
+	}
+	s += "\t\t" + f.info.separator + "\t"
+	for _, node := range f.Current.Cluster.Config.Nodes {
+		switch f.Current.Cluster.Node[node].Daemon.ClusterSecret.State {
+		case "idle":
+			s += iconNotApplicable
+		case "distributed":
+			s += iconStandbyUp
+		case "switched":
+			s += iconWarning
+		case "reencrypted":
+			s += iconUp
+		default:
+			s += iconUndef
+		}
+		s += "\t"
+	}
+	return s
+}
+
 func sThreadAlerts(data []daemonsubsystem.Alert) string {
 	if len(data) > 0 {
 		return yellow("!")
@@ -148,5 +243,11 @@ func (f Frame) wThreads() {
 	fmt.Fprintln(f.w, f.wThreadHeartbeats())
 	fmt.Fprintln(f.w, f.wThreadListener())
 	fmt.Fprintln(f.w, f.wThreadScheduler())
+	if len(f.Current.Cluster.Node[f.Nodename].Daemon.Notifier.Notifiers) > 0 {
+		fmt.Fprintln(f.w, f.wThreadNotifier())
+	}
+	if state := f.Current.Cluster.Node[f.Nodename].Daemon.ClusterSecret.State; state != "" && state != "idle" {
+		fmt.Fprintln(f.w, f.wThreadClusterSecret())
+	}
 	fmt.Fprintln(f.w, f.info.empty)
 }
@@ -4,8 +4,10 @@ import "time"
 
 type (
 	Config struct {
+		ConfigHistorySize      int           `json:"config_history_size"`
 		Env                    string        `json:"env"`
 		MaintenanceGracePeriod time.Duration `json:"maintenance_grace_period"`
+		MaintenanceWindow      string        `json:"maintenance_window"`
 		MaxParallel            int           `json:"max_parallel"`
 		ReadyPeriod            time.Duration `json:"ready_period"`
 		RejoinGracePeriod      time.Duration `json:"rejoin_grace_period"`
@@ -21,8 +23,10 @@ func (t *Config) DeepCopy() *Config {
 
 func (t *Config) Unstructured() map[string]any {
 	return map[string]any{
+		"config_history_size":      t.ConfigHistorySize,
 		"env":                      t.Env,
 		"maintenance_grace_period": t.MaintenanceGracePeriod,
+		"maintenance_window":       t.MaintenanceWindow,
 		"max_parallel":             t.MaxParallel,
 		"ready_period":             t.ReadyPeriod,
 		"rejoin_grace_period":      t.RejoinGracePeriod,
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/core/schedule"
 )
 
 type (
@@ -26,6 +28,9 @@ type (
 		SessionID           uuid.UUID `json:"session_id"`
 
 		IsPreserved bool `json:"preserved"`
+
+		// MaintenanceWindow is the open or next node maintenance window.
+		MaintenanceWindow *schedule.Window `json:"maintenance_window,omitempty"`
 	}
 
 	// MonitorUpdate is embedded in the SetNodeMonitor message to
@@ -183,6 +188,10 @@ func (t MonitorState) IsRankable() bool {
 func (n *Monitor) DeepCopy() *Monitor {
 	var d Monitor
 	d = *n
+	if n.MaintenanceWindow != nil {
+		w := *n.MaintenanceWindow
+		d.MaintenanceWindow = &w
+	}
 	return &d
 }
 
@@ -253,7 +262,7 @@ func (t *MonitorGlobalExpect) UnmarshalText(b []byte) error {
 }
 
 func (t *Monitor) Unstructured() map[string]any {
-	return map[string]any{
+	m := map[string]any{
 		"global_expect":            t.GlobalExpect,
 		"local_expect":             t.LocalExpect,
 		"state":                    t.State,
@@ -265,6 +274,10 @@ func (t *Monitor) Unstructured() map[string]any {
 		"orchestration_is_done":    t.OrchestrationIsDone,
 		"session_id":               t.SessionID,
 	}
+	if t.MaintenanceWindow != nil {
+		m["maintenance_window"] = *t.MaintenanceWindow
+	}
+	return m
 }
 
 func (t MonitorUpdate) String() string {
@@ -7,6 +7,7 @@ type (
 		Load15M      float64 `json:"load_15m"`
 		MemAvailPct  uint64  `json:"mem_avail"`
 		MemTotalMB   uint64  `json:"mem_total"`
+		NumCPU       int     `json:"num_cpu"`
 		Score        uint64  `json:"score"`
 		SwapAvailPct uint64  `json:"swap_avail"`
 		SwapTotalMB  uint64  `json:"swap_total"`
@@ -35,6 +35,16 @@ type (
 	ArbitratorStatus struct {
 		URL    string   `json:"url"`
 		Status status.T `json:"status"`
+
+		// Lease is the lease of a qdisk arbitrator, as last seen by the node.
+		Lease *ArbitratorLease `json:"lease,omitempty"`
+	}
+
+	// ArbitratorLease describes the holder of a qdisk arbitrator lease.
+	ArbitratorLease struct {
+		Holder    string    `json:"holder"`
+		TakenAt   time.Time `json:"taken_at"`
+		RenewedAt time.Time `json:"renewed_at"`
 	}
 
 	// NodesInfo is the dataset exposed via the GET /nodes_info handler,
@@ -64,6 +74,10 @@ func (t *Status) DeepCopy() *Status {
 	result := *t
 	newArbitrator := make(map[string]ArbitratorStatus)
 	for n, v := range t.Arbitrators {
+		if v.Lease != nil {
+			lease := *v.Lease
+			v.Lease = &lease
+		}
 		newArbitrator[n] = v
 	}
 	result.Arbitrators = newArbitrator
@@ -307,6 +307,7 @@ func (t *actor) ConfigureResources() {
 	}
 	t.resources = t._resources
 	t._resources = nil
+	t.registerActionResourceDeps()
 	dur := time.Now().Sub(begin)
 	t.log.Attr("duration", dur).Debugf("all resources configured in %s", dur)
 	return
@@ -462,14 +463,23 @@ func (t *actor) configureResource(r resource.Driver, rid string) error {
 			return err
 		}
 	}
-	if i, ok := r.(resource.ActionResourceDepser); ok {
-		deps := i.ActionResourceDeps()
-		t.actionResourceDeps.RegisterSlice(deps)
-	}
 	//r.Log().Debug().Msgf("configured resource: %+v", r)
 	return nil
 }
 
+// registerActionResourceDeps registers the action dependencies of the
+// configured resources. It runs after all the resources are configured,
+// so the dependencies of a resource can be computed from the resources
+// defined after it in the configuration.
+func (t *actor) registerActionResourceDeps() {
+	for _, r := range t.resources {
+		if i, ok := r.(resource.ActionResourceDepser); ok {
+			deps := i.ActionResourceDeps()
+			t.actionResourceDeps.RegisterSlice(deps)
+		}
+	}
+}
+
 func (t *actor) GetActionResDeps() *actionresdeps.Store {
 	return t.actionResourceDeps
 }
@@ -14,7 +14,14 @@ import (
 
 // PrintSchedule display the object scheduling table
 func (t *actor) PrintSchedule() schedule.Table {
-	return t.Schedules()
+	table := t.Schedules()
+	if s := t.config.GetString(key.New("DEFAULT", "maintenance_window")); s != "" {
+		e := schedule.NewWindowEntry(time.Now(), "maintenance_window", s)
+		e.Node = hostname.Hostname()
+		e.Path = t.path
+		table = table.Add(e)
+	}
+	return table
 }
 
 func (t *actor) lastRunFile(action, rid, desc string) string {
@@ -98,6 +98,9 @@ func getClusterConfig() (*cluster.Config, error) {
 	var (
 		keyID         = key.New("cluster", "id")
 		keySecret     = key.New("cluster", "secret")
+		keyNextSecret = key.New("cluster", "next_secret")
+		keyPrevSecret = key.New("cluster", "previous_secret")
+		keyGrace      = key.New("cluster", "secret_grace_period")
 		keyName       = key.New("cluster", "name")
 		keyNodes      = key.New("cluster", "nodes")
 		keyDNS        = key.New("cluster", "dns")
@@ -124,6 +127,11 @@ func getClusterConfig() (*cluster.Config, error) {
 	cfg.Name = c.GetString(keyName)
 	cfg.CASecPaths = c.GetStrings(keyCASecPaths)
 	cfg.SetSecret(c.GetString(keySecret))
+	cfg.SetNextSecret(c.GetString(keyNextSecret))
+	cfg.SetPreviousSecret(c.GetString(keyPrevSecret))
+	if d := c.GetDuration(keyGrace); d != nil {
+		cfg.SetSecretGracePeriod(*d)
+	}
 	cfg.Quorum = c.GetBool(keyQuorum)
 	var errs error
 	if vip, err := getVip(c, cfg.Nodes); err != nil {
@@ -70,6 +70,16 @@ var keywordStore = keywords.Store{
 		Option: "comment",
 		Text:   keywords.NewText(fs, "text/kw/core/comment"),
 	},
+	{
+		Option:  "template",
+		Section: "DEFAULT",
+		Text:    keywords.NewText(fs, "text/kw/core/template"),
+	},
+	{
+		Option:  "template_params",
+		Section: "DEFAULT",
+		Text:    keywords.NewText(fs, "text/kw/core/template_params"),
+	},
 	{
 		Converter: converters.Bool,
 		Kind:      naming.NewKinds(naming.KindSvc, naming.KindVol),
@@ -273,6 +283,24 @@ var keywordStore = keywords.Store{
 		Section:    "DEFAULT",
 		Text:       keywords.NewText(fs, "text/kw/core/placement"),
 	},
+	{
+		Converter: converters.Size,
+		Example:   "2g",
+		Inherit:   keywords.InheritHead,
+		Kind:      naming.NewKinds(naming.KindSvc, naming.KindVol),
+		Option:    "mem_reqs",
+		Section:   "DEFAULT",
+		Text:      keywords.NewText(fs, "text/kw/core/mem_reqs"),
+	},
+	{
+		Converter: converters.Float64,
+		Example:   "1.5",
+		Inherit:   keywords.InheritHead,
+		Kind:      naming.NewKinds(naming.KindSvc, naming.KindVol),
+		Option:    "cpu_reqs",
+		Section:   "DEFAULT",
+		Text:      keywords.NewText(fs, "text/kw/core/cpu_reqs"),
+	},
 	{
 		Aliases:    []string{"cluster_type"},
 		Candidates: []string{"failover", "flex"},
@@ -513,6 +541,15 @@ var keywordStore = keywords.Store{
 		Section:  "DEFAULT",
 		Text:     keywords.NewText(fs, "text/kw/core/resinfo_schedule"),
 	},
+	{
+		Example:  "01:00-03:00 sun",
+		Inherit:  keywords.InheritHead,
+		Kind:     naming.NewKinds(naming.KindSvc, naming.KindVol),
+		Option:   "maintenance_window",
+		Scopable: true,
+		Section:  "DEFAULT",
+		Text:     keywords.NewText(fs, "text/kw/core/maintenance_window"),
+	},
 	{
 		Default:  "@10m",
 		Kind:     naming.NewKinds(naming.KindSvc, naming.KindVol),
@@ -49,6 +49,7 @@ type (
 	SecureKeystore interface {
 		GenCert() error
 		PKCS() ([]byte, error)
+		ReencryptKeys() (int, error)
 	}
 )
 
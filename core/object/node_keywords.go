@@ -3,6 +3,7 @@ package object
 import (
 	"fmt"
 
+	"github.com/opensvc/om3/core/confighistory"
 	"github.com/opensvc/om3/core/keywords"
 	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/core/rawconfig"
@@ -210,6 +211,13 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Section: "node",
 		Text:    keywords.NewText(fs, "text/kw/node/node.env"),
 	},
+	{
+		Converter: converters.Int,
+		Default:   fmt.Sprintf("%d", confighistory.DefaultMax),
+		Option:    "config_history_size",
+		Section:   "node",
+		Text:      keywords.NewText(fs, "text/kw/node/node.config_history_size"),
+	},
 	{
 		Converter: converters.Int,
 		Default:   fmt.Sprintf("%d", DefaultNodeMaxParallel),
@@ -360,6 +368,12 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Section:   "node",
 		Text:      keywords.NewText(fs, "text/kw/node/node.maintenance_grace_period"),
 	},
+	{
+		Example: "01:00-03:00 sun",
+		Option:  "maintenance_window",
+		Section: "node",
+		Text:    keywords.NewText(fs, "text/kw/node/node.maintenance_window"),
+	},
 	{
 		Converter: converters.Duration,
 		Default:   "90s",
@@ -543,6 +557,23 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Section:     "cluster",
 		Text:        keywords.NewText(fs, "text/kw/node/cluster.secret"),
 	},
+	{
+		Option:  "next_secret",
+		Section: "cluster",
+		Text:    keywords.NewText(fs, "text/kw/node/cluster.next_secret"),
+	},
+	{
+		Option:  "previous_secret",
+		Section: "cluster",
+		Text:    keywords.NewText(fs, "text/kw/node/cluster.previous_secret"),
+	},
+	{
+		Converter: converters.Duration,
+		Default:   "10m",
+		Option:    "secret_grace_period",
+		Section:   "cluster",
+		Text:      keywords.NewText(fs, "text/kw/node/cluster.secret_grace_period"),
+	},
 	{
 		Converter: converters.List,
 		Option:    "nodes",
@@ -577,6 +608,13 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Section:    "node",
 		Text:       keywords.NewText(fs, "text/kw/node/node.split_action"),
 	},
+	{
+		Candidates: []string{"uri", "qdisk"},
+		Default:    "uri",
+		Option:     "type",
+		Section:    "arbitrator",
+		Text:       keywords.NewText(fs, "text/kw/node/arbitrator.type"),
+	},
 	{
 		Aliases:  []string{"name"},
 		Example:  "http://www.opensvc.com",
@@ -584,6 +622,7 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Required: true,
 		Section:  "arbitrator",
 		Text:     keywords.NewText(fs, "text/kw/node/arbitrator.uri"),
+		Types:    []string{"uri"},
 	},
 	{
 		Converter: converters.Bool,
@@ -591,6 +630,39 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Option:    "insecure",
 		Section:   "arbitrator",
 		Text:      keywords.NewText(fs, "text/kw/node/arbitrator.insecure"),
+		Types:     []string{"uri"},
+	},
+	{
+		Example:  "/dev/mapper/36589cfc000000e03957c51dabab8373a",
+		Option:   "dev",
+		Required: true,
+		Scopable: true,
+		Section:  "arbitrator",
+		Text:     keywords.NewText(fs, "text/kw/node/arbitrator.qdisk.dev"),
+		Types:    []string{"qdisk"},
+	},
+	{
+		Converter: converters.Duration,
+		Default:   "10s",
+		Option:    "lease",
+		Section:   "arbitrator",
+		Text:      keywords.NewText(fs, "text/kw/node/arbitrator.qdisk.lease"),
+		Types:     []string{"qdisk"},
+	},
+	{
+		Candidates: []string{"cmd", "ipmi", "redfish"},
+		Default:    "cmd",
+		Option:     "type",
+		Section:    "stonith",
+		Text:       keywords.NewText(fs, "text/kw/node/stonith.type"),
+	},
+	{
+		Converter: converters.Duration,
+		Default:   "1m",
+		Option:    "timeout",
+		Scopable:  true,
+		Section:   "stonith",
+		Text:      keywords.NewText(fs, "text/kw/node/stonith.timeout"),
 	},
 	{
 		Converter: converters.Shlex,
@@ -600,9 +672,76 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Scopable:  true,
 		Section:   "stonith",
 		Text:      keywords.NewText(fs, "text/kw/node/stonith.cmd"),
+		Types:     []string{"cmd"},
+	},
+	{
+		Example:  "https://bmc1.acme.com",
+		Option:   "url",
+		Required: true,
+		Scopable: true,
+		Section:  "stonith",
+		Text:     keywords.NewText(fs, "text/kw/node/stonith.redfish.url"),
+		Types:    []string{"redfish"},
+	},
+	{
+		Example:  "/redfish/v1/Systems/1",
+		Option:   "system",
+		Scopable: true,
+		Section:  "stonith",
+		Text:     keywords.NewText(fs, "text/kw/node/stonith.redfish.system"),
+		Types:    []string{"redfish"},
+	},
+	{
+		Candidates: []string{"ForceOff", "GracefulShutdown", "PushPowerButton"},
+		Default:    "ForceOff",
+		Option:     "reset_type",
+		Section:    "stonith",
+		Text:       keywords.NewText(fs, "text/kw/node/stonith.redfish.reset_type"),
+		Types:      []string{"redfish"},
+	},
+	{
+		Converter: converters.Bool,
+		Default:   "false",
+		Option:    "insecure",
+		Section:   "stonith",
+		Text:      keywords.NewText(fs, "text/kw/node/stonith.redfish.insecure"),
+		Types:     []string{"redfish"},
+	},
+	{
+		Example:  "bmc1.acme.com",
+		Option:   "addr",
+		Required: true,
+		Scopable: true,
+		Section:  "stonith",
+		Text:     keywords.NewText(fs, "text/kw/node/stonith.ipmi.addr"),
+		Types:    []string{"ipmi"},
+	},
+	{
+		Candidates: []string{"lanplus", "lan"},
+		Default:    "lanplus",
+		Option:     "interface",
+		Section:    "stonith",
+		Text:       keywords.NewText(fs, "text/kw/node/stonith.ipmi.interface"),
+		Types:      []string{"ipmi"},
+	},
+	{
+		Example:  "admin",
+		Option:   "username",
+		Scopable: true,
+		Section:  "stonith",
+		Text:     keywords.NewText(fs, "text/kw/node/stonith.username"),
+		Types:    []string{"ipmi", "redfish"},
+	},
+	{
+		Example:  "system/sec/bmc1",
+		Option:   "password",
+		Scopable: true,
+		Section:  "stonith",
+		Text:     keywords.NewText(fs, "text/kw/node/stonith.password"),
+		Types:    []string{"ipmi", "redfish"},
 	},
 	{
-		Candidates: []string{"unicast", "multicast", "disk", "relay"},
+		Candidates: []string{"unicast", "multicast", "disk", "relay", "tls"},
 		Option:     "type",
 		Required:   true,
 		Section:    "hb",
@@ -615,7 +754,7 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Scopable:    true,
 		Section:     "hb",
 		Text:        keywords.NewText(fs, "text/kw/node/hb.unicast.addr"),
-		Types:       []string{"unicast"},
+		Types:       []string{"unicast", "tls"},
 	},
 	{
 		DefaultText: keywords.NewText(fs, "text/kw/node/hb.unicast.intf.default"),
@@ -651,6 +790,13 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Section:   "hb",
 		Text:      keywords.NewText(fs, "text/kw/node/hb.interval"),
 	},
+	{
+		Candidates: []string{"zlib", "gzip", "zstd", "none"},
+		Default:    "zlib",
+		Option:     "compression",
+		Section:    "hb",
+		Text:       keywords.NewText(fs, "text/kw/node/hb.compression"),
+	},
 	{
 		Default:  "224.3.29.71",
 		Option:   "addr",
@@ -684,7 +830,33 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Scopable:    true,
 		Section:     "hb",
 		Text:        keywords.NewText(fs, "text/kw/node/hb.unicast.nodes"),
-		Types:       []string{"unicast"},
+		Types:       []string{"unicast", "tls"},
+	},
+	{
+		Converter: converters.Int,
+		Default:   "10010",
+		Option:    "port",
+		Scopable:  true,
+		Section:   "hb",
+		Text:      keywords.NewText(fs, "text/kw/node/hb.tls.port"),
+		Types:     []string{"tls"},
+	},
+	{
+		Default: "system/sec/cert",
+		Example: "system/sec/hb",
+		Option:  "cert",
+		Section: "hb",
+		Text:    keywords.NewText(fs, "text/kw/node/hb.tls.cert"),
+		Types:   []string{"tls"},
+	},
+	{
+		Converter: converters.List,
+		Example:   "sha256:5d41402abc4b2a76b9719d911017c592ae6b4f1e2b0b3f2a7c7e9a3d1f0e4b6c",
+		Option:    "pin",
+		Scopable:  true,
+		Section:   "hb",
+		Text:      keywords.NewText(fs, "text/kw/node/hb.tls.pin"),
+		Types:     []string{"tls"},
 	},
 	{
 		Example:  "/dev/mapper/36589cfc000000e03957c51dabab8373a",
@@ -889,6 +1061,14 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Text:     keywords.NewText(fs, "text/kw/node/pool.vg.name"),
 		Types:    []string{"vg"},
 	},
+	{
+		Default: "20%ORIGIN",
+		Example: "10G",
+		Option:  "snap_size",
+		Section: "pool",
+		Text:    keywords.NewText(fs, "text/kw/node/pool.vg.snap_size"),
+		Types:   []string{"vg"},
+	},
 	{
 		Option:  "vg",
 		Section: "pool",
@@ -1003,6 +1183,106 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Section:   "hook",
 		Text:      keywords.NewText(fs, "text/kw/node/hook.command"),
 	},
+	{
+		Candidates: []string{"webhook", "command"},
+		Option:     "type",
+		Required:   true,
+		Section:    "notify",
+		Text:       keywords.NewText(fs, "text/kw/node/notify.type"),
+	},
+	{
+		Converter: converters.List,
+		Example:   "ObjectStatusUpdated,.object_status.avail=down NodeSplitAction",
+		Option:    "events",
+		Required:  true,
+		Section:   "notify",
+		Text:      keywords.NewText(fs, "text/kw/node/notify.events"),
+	},
+	{
+		Example: "*/svc/*",
+		Option:  "selector",
+		Section: "notify",
+		Text:    keywords.NewText(fs, "text/kw/node/notify.selector"),
+	},
+	{
+		Example: "https://hooks.example.com/opensvc",
+		Option:  "url",
+		Section: "notify",
+		Text:    keywords.NewText(fs, "text/kw/node/notify.webhook.url"),
+		Types:   []string{"webhook"},
+	},
+	{
+		Converter: converters.List,
+		Example:   "Authorization=Bearer_xxx",
+		Option:    "headers",
+		Section:   "notify",
+		Text:      keywords.NewText(fs, "text/kw/node/notify.webhook.headers"),
+		Types:     []string{"webhook"},
+	},
+	{
+		Converter: converters.Bool,
+		Default:   "false",
+		Option:    "insecure",
+		Section:   "notify",
+		Text:      keywords.NewText(fs, "text/kw/node/notify.webhook.insecure"),
+		Types:     []string{"webhook"},
+	},
+	{
+		Default: "application/json",
+		Option:  "content_type",
+		Section: "notify",
+		Text:    keywords.NewText(fs, "text/kw/node/notify.content_type"),
+		Types:   []string{"webhook"},
+	},
+	{
+		Converter: converters.Shlex,
+		Example:   "/usr/local/bin/notify.sh",
+		Option:    "command",
+		Section:   "notify",
+		Text:      keywords.NewText(fs, "text/kw/node/notify.command.command"),
+		Types:     []string{"command"},
+	},
+	{
+		Example: "{\"text\": \"{{.Kind}} {{.Path}} on {{.Node}}\"}",
+		Option:  "template",
+		Section: "notify",
+		Text:    keywords.NewText(fs, "text/kw/node/notify.template"),
+	},
+	{
+		Converter: converters.Duration,
+		Default:   "10s",
+		Option:    "timeout",
+		Section:   "notify",
+		Text:      keywords.NewText(fs, "text/kw/node/notify.timeout"),
+	},
+	{
+		Converter: converters.Int,
+		Default:   "3",
+		Option:    "retries",
+		Section:   "notify",
+		Text:      keywords.NewText(fs, "text/kw/node/notify.retries"),
+	},
+	{
+		Converter: converters.Duration,
+		Default:   "1s",
+		Option:    "retry_delay",
+		Section:   "notify",
+		Text:      keywords.NewText(fs, "text/kw/node/notify.retry_delay"),
+	},
+	{
+		Converter: converters.Duration,
+		Default:   "5m",
+		Option:    "dedup_window",
+		Section:   "notify",
+		Text:      keywords.NewText(fs, "text/kw/node/notify.dedup_window"),
+	},
+	{
+		Converter: converters.Bool,
+		Default:   "true",
+		Option:    "leader_only",
+		Section:   "notify",
+		Text:      keywords.NewText(fs, "text/kw/node/notify.leader_only"),
+	},
 	{
 		Candidates: []string{"bridge", "routed_bridge"},
 		Default:    "bridge",
@@ -16,7 +16,13 @@ import (
 
 // PrintSchedule display the object scheduling table
 func (t *Node) PrintSchedule() schedule.Table {
-	return t.Schedules()
+	table := t.Schedules()
+	if s := t.config.GetString(key.New("node", "maintenance_window")); s != "" {
+		e := schedule.NewWindowEntry(time.Now(), "node.maintenance_window", s)
+		e.Node = hostname.Hostname()
+		table = table.Add(e)
+	}
+	return table
 }
 
 func (t *Node) lastRunFile(action, rid, base string) string {
@@ -3,7 +3,9 @@ package object
 import (
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 
@@ -51,21 +53,19 @@ type (
 
 var (
 	secEncryptDecrypterMutex sync.Mutex
-	secEncryptDecrypterCache encryptDecrypter
+	secEncryptDecrypterCache *omcrypto.Factory
 )
 
+// GetSecEncryptDecrypter returns the cached encryptDecrypter of the sec
+// and usr values. The cache is refreshed when the cluster secrets change.
 func GetSecEncryptDecrypter() (encryptDecrypter, error) {
 	secEncryptDecrypterMutex.Lock()
 	defer secEncryptDecrypterMutex.Unlock()
-	if secEncryptDecrypterCache != nil {
-		return secEncryptDecrypterCache, nil
-	}
 	clusterConfig := cluster.ConfigData.Get()
-	secEncryptDecrypterCache = &omcrypto.Factory{
-		NodeName:    hostname.Hostname(),
-		ClusterName: clusterConfig.Name,
-		Key:         clusterConfig.Secret(),
+	if c := secEncryptDecrypterCache; c != nil && c.Key == clusterConfig.Secret() && slices.Equal(c.AltKeys, clusterConfig.AltSecrets()) {
+		return c, nil
 	}
+	secEncryptDecrypterCache = omcrypto.NewClusterFactory()
 	return secEncryptDecrypterCache, nil
 }
 
@@ -92,6 +92,56 @@ func (t *sec) KeywordLookup(k key.T, sectionType string) keywords.Keyword {
 	return keywordLookup(keywordStore, k, t.path.Kind, sectionType)
 }
 
+// ReencryptKeys encrypts with the current cluster secret the key values
+// still encrypted with the next or previous cluster secret, so they stay
+// decodable after the previous cluster secret is retired. It returns the
+// number of re-encrypted keys, and the errors of the keys it could not
+// re-encrypt.
+func (t *sec) ReencryptKeys() (int, error) {
+	clusterConfig := cluster.ConfigData.Get()
+	current := &secEncodeDecode{
+		encryptDecrypter: &omcrypto.Factory{
+			NodeName:    hostname.Hostname(),
+			ClusterName: clusterConfig.Name,
+			Key:         clusterConfig.Secret(),
+		},
+	}
+	names, err := t.AllKeys()
+	if err != nil {
+		return 0, err
+	}
+	var (
+		n    int
+		errs error
+	)
+	for _, name := range names {
+		s, err := t.config.GetStrict(keyFromName(name))
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("key %s: %w", name, err))
+			continue
+		}
+		if _, err := current.Decode(s); err == nil {
+			continue
+		}
+		b, err := t.encodeDecoder.Decode(s)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("decode key %s: %w", name, err))
+			continue
+		}
+		if err := t.TransactionChangeKey(name, b); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("encode key %s: %w", name, err))
+			continue
+		}
+		n++
+	}
+	if n > 0 {
+		if err := t.config.Commit(); err != nil {
+			return 0, errors.Join(errs, err)
+		}
+	}
+	return n, errs
+}
+
 func (t *secEncodeDecode) Encode(b []byte) (string, error) {
 	b, err := t.encryptDecrypter.Encrypt(b)
 	if err != nil {
@@ -0,0 +1,47 @@
+package object_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/cluster"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/testhelper"
+)
+
+func TestSecReencryptKeys(t *testing.T) {
+	testhelper.Setup(t)
+	oldSecret := "0123456789abcdef0123456789abcdef"
+	newSecret := "fedcba9876543210fedcba9876543210"
+	setSecrets := func(secret, previous string) {
+		cfg := &cluster.Config{Name: "test"}
+		cfg.SetSecret(secret)
+		cfg.SetPreviousSecret(previous)
+		cluster.ConfigData.Set(cfg)
+	}
+	p := naming.Path{Name: "sec1", Namespace: "test", Kind: naming.KindSec}
+
+	setSecrets(oldSecret, "")
+	s, err := object.NewSec(p)
+	require.NoError(t, err)
+	require.NoError(t, s.AddKey("foo", []byte("bar")))
+
+	setSecrets(newSecret, oldSecret)
+	s, err = object.NewSec(p)
+	require.NoError(t, err)
+	n, err := s.ReencryptKeys()
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	n, err = s.ReencryptKeys()
+	require.NoError(t, err)
+	require.Equal(t, 0, n, "keys encrypted with the current secret must not be re-encrypted")
+
+	setSecrets(newSecret, "")
+	s, err = object.NewSec(p)
+	require.NoError(t, err)
+	b, err := s.DecodeKey("foo")
+	require.NoError(t, err)
+	require.Equal(t, "bar", string(b))
+}
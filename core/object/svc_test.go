@@ -144,3 +144,29 @@ func TestWithConfigData(t *testing.T) {
 
 	})
 }
+
+// TestActionResourceDepsConfigOrder verifies the action dependencies of a
+// bind mount on the filesystem hosting its source do not depend on the
+// order of the resource sections.
+func TestActionResourceDepsConfigOrder(t *testing.T) {
+	testhelper.Setup(t)
+	conf := []byte(`
+[fs#2]
+type = bind
+dev = /srv/deps/data
+mnt = /mnt/deps
+
+[fs#3]
+type = ext4
+dev = /dev/loop9
+mnt = /srv/deps
+`)
+	p, err := naming.ParsePath("deps")
+	require.NoError(t, err)
+	s, err := object.NewSvc(p, object.WithConfigData(conf))
+	require.NoError(t, err)
+	s.ConfigureResources()
+	deps := s.GetActionResDeps()
+	assert.Equal(t, []string{"fs#3"}, deps.Dependencies("start", "fs#2"))
+	assert.Equal(t, []string{"fs#2"}, deps.Dependencies("stop", "fs#3"))
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/opensvc/om3/core/driver"
 	"github.com/opensvc/om3/core/keywords"
 	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/pool"
 	"github.com/opensvc/om3/core/status"
 	"github.com/opensvc/om3/util/device"
 	"github.com/opensvc/om3/util/funcopt"
@@ -32,6 +33,11 @@ type (
 		Head() string
 		Device() *device.T
 		HoldersExcept(ctx context.Context, p naming.Path) naming.Paths
+		Snapshots(ctx context.Context) (pool.Snapshots, error)
+		CreateSnapshot(ctx context.Context, name string) (string, error)
+		DeleteSnapshot(ctx context.Context, name string) error
+		RollbackSnapshot(ctx context.Context, name string) error
+		Clone(ctx context.Context, to naming.Path, snapshot string) error
 	}
 )
 
@@ -0,0 +1,167 @@
+package object
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opensvc/om3/core/actioncontext"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/pool"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/core/statusbus"
+	"github.com/opensvc/om3/util/key"
+)
+
+// pooler returns the pool allocating the volume data.
+func (t *vol) pooler() (pool.Pooler, error) {
+	name := t.config.GetString(key.New("DEFAULT", "pool"))
+	if name == "" {
+		return nil, fmt.Errorf("%s: the pool keyword is not set", t.path)
+	}
+	n, err := NewNode(WithVolatile(true))
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range n.Pools() {
+		if p.Name() == name {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("%s: pool %s not found", t.path, name)
+}
+
+// snapshotter returns the pool allocating the volume data, if it supports
+// snapshots.
+func (t *vol) snapshotter() (pool.Snapshotter, error) {
+	p, err := t.pooler()
+	if err != nil {
+		return nil, err
+	}
+	s, ok := p.(pool.Snapshotter)
+	if !ok {
+		return nil, fmt.Errorf("%s: pool %s does not support snapshots", t.path, p.Name())
+	}
+	return s, nil
+}
+
+// Snapshots returns the snapshots of the volume data, oldest first.
+func (t *vol) Snapshots(ctx context.Context) (pool.Snapshots, error) {
+	s, err := t.snapshotter()
+	if err != nil {
+		return nil, err
+	}
+	return s.Snapshots(t)
+}
+
+// CreateSnapshot creates the snapshot <name> of the volume data. A name
+// derived from the current time is used if <name> is empty. The name of
+// the created snapshot is returned.
+func (t *vol) CreateSnapshot(ctx context.Context, name string) (string, error) {
+	ctx = actioncontext.WithProps(ctx, actioncontext.SnapshotCreate)
+	if name == "" {
+		name = pool.NewSnapshotName("")
+	} else if err := pool.ValidateSnapshotName(name); err != nil {
+		return "", err
+	}
+	s, err := t.snapshotter()
+	if err != nil {
+		return "", err
+	}
+	unlock, err := t.lockAction(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
+	t.log.Infof("create snapshot %s", name)
+	if err := s.CreateSnapshot(t, name); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// DeleteSnapshot deletes the snapshot <name> of the volume data.
+func (t *vol) DeleteSnapshot(ctx context.Context, name string) error {
+	ctx = actioncontext.WithProps(ctx, actioncontext.SnapshotDelete)
+	s, err := t.snapshotter()
+	if err != nil {
+		return err
+	}
+	unlock, err := t.lockAction(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	t.log.Infof("delete snapshot %s", name)
+	return s.DeleteSnapshot(t, name)
+}
+
+// RollbackSnapshot restores the volume data to the content of the snapshot
+// <name>. The local volume instance must be down, unless forced.
+func (t *vol) RollbackSnapshot(ctx context.Context, name string) error {
+	ctx = actioncontext.WithProps(ctx, actioncontext.SnapshotRollback)
+	ctx, stop := statusbus.WithContext(ctx, t.path)
+	defer stop()
+	defer t.postActionStatusEval(ctx)
+	s, err := t.snapshotter()
+	if err != nil {
+		return err
+	}
+	unlock, err := t.lockAction(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if !actioncontext.IsForce(ctx) {
+		instStatus, err := t.statusEval(ctx)
+		if err != nil {
+			return err
+		}
+		if instStatus.Avail.Is(status.Up, status.Warn) {
+			return fmt.Errorf("%s: refuse to rollback an instance with avail status %s: stop the instance or use --force", t.path, instStatus.Avail)
+		}
+	}
+	t.log.Infof("rollback to snapshot %s", name)
+	return s.RollbackSnapshot(t, name)
+}
+
+// Clone creates the <to> volume object, configured like the volume, with
+// data cloned from the snapshot <snapshot> of the volume data. If
+// <snapshot> is empty, a new snapshot is created for the clone.
+func (t *vol) Clone(ctx context.Context, to naming.Path, snapshot string) error {
+	ctx = actioncontext.WithProps(ctx, actioncontext.Clone)
+	if to.Kind != naming.KindVol {
+		return fmt.Errorf("%s: the clone must be a vol object", to)
+	}
+	if to.Exists() {
+		return fmt.Errorf("%s: already exists", to)
+	}
+	p, err := t.pooler()
+	if err != nil {
+		return err
+	}
+	if !pool.HasCapability(p, "snap") {
+		return fmt.Errorf("%s: pool %s does not support snapshots", t.path, p.Name())
+	}
+	if snapshot == "" {
+		if snapshot, err = t.CreateSnapshot(ctx, pool.NewSnapshotName("clone-")); err != nil {
+			return err
+		}
+	}
+	unlock, err := t.lockAction(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	clone, err := NewVol(to)
+	if err != nil {
+		return err
+	}
+	t.log.Infof("clone snapshot %s to %s", snapshot, to)
+	if err := pool.CloneVolume(p, t, snapshot, clone); err != nil {
+		if err := clone.deleteInstance(); err != nil {
+			t.log.Warnf("delete %s after clone failure: %s", to, err)
+		}
+		return err
+	}
+	return nil
+}
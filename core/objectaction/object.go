@@ -9,12 +9,15 @@ import (
 	"net/http"
 	"os"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/mattn/go-isatty"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/opensvc/om3/core/actioncontext"
 	"github.com/opensvc/om3/core/actionrouter"
@@ -35,6 +38,7 @@ import (
 	"github.com/opensvc/om3/util/progress"
 	"github.com/opensvc/om3/util/pubsub"
 	"github.com/opensvc/om3/util/render/tree"
+	"github.com/opensvc/om3/util/tracing"
 	"github.com/opensvc/om3/util/xsession"
 )
 
@@ -326,6 +330,13 @@ func (t T) HasLocal() bool {
 }
 
 func (t T) DoLocal() error {
+	ctx, span := t.startSpan("objectaction local")
+	err := t.doLocal(ctx)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (t T) doLocal(ctx context.Context) error {
 	if t.LocalFunc == nil {
 		return fmt.Errorf("local mode is not available (use 'om' on a cluster node or 'ox --node ...')")
 	}
@@ -357,7 +368,6 @@ func (t T) DoLocal() error {
 	if todo == 0 {
 		return nil
 	}
-	ctx := context.Background()
 	ctx = actioncontext.WithRID(ctx, t.RID)
 	ctx = actioncontext.WithTag(ctx, t.Tag)
 	ctx = actioncontext.WithSubset(ctx, t.Subset)
@@ -398,6 +408,13 @@ func (t T) DoLocal() error {
 // DoAsync uses the agent API to submit a target state to reach via an
 // orchestration.
 func (t T) DoAsync() error {
+	ctx, span := t.startSpan("objectaction async")
+	err := t.doAsync(ctx)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (t T) doAsync(parent context.Context) error {
 	target, ok := instance.MonitorGlobalExpectValues[t.Target]
 	if !ok {
 		return fmt.Errorf("unexpected action: %s", t.Target)
@@ -422,10 +439,10 @@ func (t T) DoAsync() error {
 		toWait int
 	)
 	if t.WaitDuration > 0 {
-		ctx, cancel = context.WithTimeout(context.Background(), t.WaitDuration)
+		ctx, cancel = context.WithTimeout(parent, t.WaitDuration)
 		defer cancel()
 	} else {
-		ctx, cancel = context.WithCancel(context.Background())
+		ctx, cancel = context.WithCancel(parent)
 		defer cancel()
 	}
 	rs := make(asyncResults, 0)
@@ -783,6 +800,13 @@ func (t T) DoAsync() error {
 // DoRemote posts the action to a peer node agent API, for synchronous
 // execution.
 func (t T) DoRemote() error {
+	ctx, span := t.startSpan("objectaction remote")
+	err := t.doRemote(ctx)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (t T) doRemote(parent context.Context) error {
 	if t.RemoteFunc == nil {
 		return fmt.Errorf("no remote function defined")
 	}
@@ -792,7 +816,7 @@ func (t T) DoRemote() error {
 		return err
 	}
 	params := api.GetObjectsParams{Path: &t.ObjectSelector}
-	resp, err := c.GetObjectsWithResponse(context.Background(), &params)
+	resp, err := c.GetObjectsWithResponse(parent, &params)
 	if err != nil {
 		return fmt.Errorf("api: %w", err)
 	}
@@ -822,13 +846,13 @@ func (t T) DoRemote() error {
 		count  int
 	)
 
-	ctx := context.Background()
+	var ctx context.Context
 
 	if t.WaitDuration > 0 {
-		ctx, cancel = context.WithTimeout(context.Background(), t.WaitDuration)
+		ctx, cancel = context.WithTimeout(parent, t.WaitDuration)
 		defer cancel()
 	} else {
-		ctx, cancel = context.WithCancel(context.Background())
+		ctx, cancel = context.WithCancel(parent)
 		defer cancel()
 	}
 
@@ -908,6 +932,19 @@ func (t T) DoRemote() error {
 	return errs
 }
 
+// startSpan starts the span of the action, child of the trace context
+// passed in the environment by the daemon, if any.
+func (t T) startSpan(name string) (context.Context, trace.Span) {
+	ctx := tracing.ContextFromEnv(context.Background())
+	return tracing.StartSpan(ctx, name,
+		attribute.String("command", strings.Join(os.Args, " ")),
+		attribute.String("selector", t.ObjectSelector),
+		attribute.String("node_selector", t.NodeSelector),
+		attribute.String("target", t.Target),
+		attribute.String("sid", xsession.ID.String()),
+	)
+}
+
 func (t T) Do() error {
 	return actionrouter.Do(t)
 }
@@ -931,7 +968,12 @@ func (t T) instanceDo(ctx context.Context, resultQ chan actionrouter.Result, nod
 				}
 			}()
 		*/
+		ctx, span := tracing.StartSpan(ctx, "objectaction instance",
+			attribute.String("path", p.String()),
+			attribute.String("node", n),
+		)
 		data, err := fn(ctx, n, p)
+		tracing.EndSpan(span, err)
 		result.Data = data
 		result.Error = err
 		result.HumanRenderer = func() string { return actionrouter.DefaultHumanRenderer(data) }
@@ -0,0 +1,472 @@
+// Package objecttemplate renders object configurations from parameterized
+// templates.
+//
+// A template is an object configuration, in ini, json or yaml format,
+// with parameter declaration sections and parameter references in the
+// keyword values:
+//
+//	[param#port]
+//	type = int
+//	default = 8080
+//	min = 1
+//	max = 65535
+//
+//	[param#size]
+//	type = size
+//	required = true
+//
+//	[DEFAULT]
+//	nodes = *
+//
+//	[volume#1]
+//	size = {param.size}
+//
+//	[app#1]
+//	start = /usr/bin/web --port {param.port}
+//
+// The templates are stored as files under <var>/template/, named
+// <name>.conf, <name>.json, <name>.yaml or <name>.yml, or as keys of the
+// system/cfg/templates cfg object.
+//
+// The rendered configuration records the template name and the parameters
+// set by the user in the DEFAULT.template and DEFAULT.template_params
+// keywords, so the object configuration can be rendered again from an
+// updated template.
+package objecttemplate
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/iancoleman/orderedmap"
+	"github.com/kballard/go-shellquote"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/core/xconfig"
+	"github.com/opensvc/om3/util/converters"
+)
+
+type (
+	// T is a parsed object configuration template.
+	T struct {
+		Name   string  `json:"name"`
+		Params []Param `json:"params"`
+
+		body rawconfig.T
+	}
+
+	// Param is a template parameter declaration.
+	Param struct {
+		Name       string   `json:"name"`
+		Type       string   `json:"type"`
+		Default    string   `json:"default,omitempty"`
+		Required   bool     `json:"required"`
+		Candidates []string `json:"candidates,omitempty"`
+		Pattern    string   `json:"pattern,omitempty"`
+		Min        string   `json:"min,omitempty"`
+		Max        string   `json:"max,omitempty"`
+		Desc       string   `json:"desc,omitempty"`
+	}
+)
+
+const (
+	// KeywordTemplate is the DEFAULT keyword recording the name of the
+	// template the object configuration was rendered from.
+	KeywordTemplate = "template"
+
+	// KeywordTemplateParams is the DEFAULT keyword recording the
+	// parameters set by the user when rendering the object configuration.
+	KeywordTemplateParams = "template_params"
+
+	paramSectionPrefix = "param#"
+)
+
+var (
+	// CfgPath is the path of the cfg object storing templates as keys.
+	CfgPath = naming.Path{Namespace: "system", Kind: naming.KindCfg, Name: "templates"}
+
+	// ErrNotFound is returned when a template is not found in the
+	// template files nor in the templates cfg object keys.
+	ErrNotFound = errors.New("template not found")
+
+	// extensions are the template file extensions, in lookup order.
+	extensions = []string{".conf", ".json", ".yaml", ".yml"}
+
+	paramTypes = map[string]xconfig.Converter{
+		"string":   nil,
+		"int":      converters.Int.Convert,
+		"bool":     converters.Bool.Convert,
+		"size":     converters.Size.Convert,
+		"duration": converters.Duration.Convert,
+		"list":     converters.List.Convert,
+	}
+
+	regexpName      = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+	regexpReference = regexp.MustCompile(`{param\.([^}]*)}`)
+)
+
+// Dir returns the directory of the template files.
+func Dir() string {
+	return filepath.Join(rawconfig.Paths.Var, "template")
+}
+
+// ReadFile returns the content of the template file of the template name.
+func ReadFile(name string) ([]byte, error) {
+	if !regexpName.MatchString(name) {
+		return nil, fmt.Errorf("invalid template name %s", name)
+	}
+	for _, ext := range extensions {
+		b, err := os.ReadFile(filepath.Join(Dir(), name+ext))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		return b, err
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+}
+
+// Load returns the template name, read from the template files or from the
+// keys of a local instance of the templates cfg object.
+func Load(name string) (*T, error) {
+	b, err := ReadFile(name)
+	if errors.Is(err, ErrNotFound) && CfgPath.Exists() {
+		var ks object.Keystore
+		if ks, err = object.NewKeystore(CfgPath, object.WithVolatile(true)); err != nil {
+			return nil, err
+		}
+		if b, err = ks.DecodeKey(name); errors.Is(err, object.KeystoreErrNotExist) {
+			err = fmt.Errorf("%w: %s", ErrNotFound, name)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return Parse(name, b)
+}
+
+// Parse returns the template name parsed from b. The ini format is
+// expected if the first line that is not empty nor a comment is a section
+// header, else the json or yaml format.
+func Parse(name string, b []byte) (*T, error) {
+	var (
+		raw rawconfig.T
+		err error
+	)
+	if isINI(b) {
+		var c *xconfig.T
+		if c, err = xconfig.NewObject("", b); err != nil {
+			return nil, err
+		}
+		raw = c.Raw()
+	} else if raw, err = xconfig.ParseDocument(b); err != nil {
+		return nil, err
+	}
+	t := &T{
+		Name:   name,
+		Params: make([]Param, 0),
+		body:   rawconfig.New(),
+	}
+	for _, section := range raw.Data.Keys() {
+		i, _ := raw.Data.Get(section)
+		m, ok := i.(orderedmap.OrderedMap)
+		if !ok {
+			return nil, fmt.Errorf("invalid section %s", section)
+		}
+		if !strings.HasPrefix(section, paramSectionPrefix) {
+			t.body.Data.Set(section, m)
+			continue
+		}
+		param, err := newParam(strings.TrimPrefix(section, paramSectionPrefix), m)
+		if err != nil {
+			return nil, fmt.Errorf("section %s: %w", section, err)
+		}
+		t.Params = append(t.Params, param)
+	}
+	// verify all references are declared
+	if err := t.walk(func(section, option, s string) (string, error) {
+		return t.substitute(s, nil)
+	}); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func isINI(b []byte) bool {
+	for _, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		return strings.HasPrefix(line, "[")
+	}
+	return true
+}
+
+func newParam(name string, m orderedmap.OrderedMap) (Param, error) {
+	param := Param{Name: name, Type: "string"}
+	if !regexpName.MatchString(name) {
+		return param, fmt.Errorf("invalid parameter name %s", name)
+	}
+	for _, k := range m.Keys() {
+		i, _ := m.Get(k)
+		v, _ := i.(string)
+		switch k {
+		case "type":
+			param.Type = v
+		case "default":
+			param.Default = v
+		case "required":
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return param, fmt.Errorf("required: %w", err)
+			}
+			param.Required = b
+		case "candidates":
+			param.Candidates = strings.Fields(v)
+		case "pattern":
+			param.Pattern = v
+		case "min":
+			param.Min = v
+		case "max":
+			param.Max = v
+		case "desc":
+			param.Desc = v
+		default:
+			return param, fmt.Errorf("unknown keyword %s", k)
+		}
+	}
+	if _, ok := paramTypes[param.Type]; !ok {
+		return param, fmt.Errorf("unsupported type %s", param.Type)
+	}
+	if param.Pattern != "" {
+		if _, err := regexp.Compile(param.Pattern); err != nil {
+			return param, fmt.Errorf("pattern: %w", err)
+		}
+	}
+	for _, s := range []string{param.Min, param.Max} {
+		if s == "" {
+			continue
+		}
+		if _, err := param.number(s); err != nil {
+			return param, fmt.Errorf("min/max: %w", err)
+		}
+	}
+	if param.Default != "" {
+		if _, err := param.normalize(param.Default); err != nil {
+			return param, fmt.Errorf("default: %w", err)
+		}
+	}
+	return param, nil
+}
+
+// number returns the numeric value of s, for the parameter types
+// supporting the min and max constraints.
+func (t Param) number(s string) (int64, error) {
+	convert := paramTypes[t.Type]
+	if convert == nil {
+		return 0, fmt.Errorf("min and max are not supported by the %s type", t.Type)
+	}
+	i, err := convert(s)
+	if err != nil {
+		return 0, err
+	}
+	switch v := i.(type) {
+	case int:
+		return int64(v), nil
+	case *int64:
+		if v != nil {
+			return *v, nil
+		}
+	case *time.Duration:
+		if v != nil {
+			return int64(*v), nil
+		}
+	}
+	return 0, fmt.Errorf("min and max are not supported by the %s type, nor by the value %s", t.Type, s)
+}
+
+// normalize validates the value s against the parameter declaration, and
+// returns its string representation in the rendered configuration.
+func (t Param) normalize(s string) (string, error) {
+	convert := paramTypes[t.Type]
+	if convert != nil {
+		i, err := convert(s)
+		if err != nil {
+			return "", fmt.Errorf("invalid %s value %s", t.Type, s)
+		}
+		switch v := i.(type) {
+		case bool:
+			s = strconv.FormatBool(v)
+		case []string:
+			s = strings.Join(v, " ")
+		}
+	}
+	values := []string{s}
+	if t.Type == "list" {
+		values = strings.Fields(s)
+	}
+	for _, v := range values {
+		if len(t.Candidates) > 0 && !slices.Contains(t.Candidates, v) {
+			return "", fmt.Errorf("value %s is not in the candidates %s", v, strings.Join(t.Candidates, " "))
+		}
+		if t.Pattern != "" {
+			if matched, _ := regexp.MatchString("^(?:"+t.Pattern+")$", v); !matched {
+				return "", fmt.Errorf("value %s does not match the pattern %s", v, t.Pattern)
+			}
+		}
+	}
+	if t.Min != "" || t.Max != "" {
+		n, err := t.number(s)
+		if err != nil {
+			return "", err
+		}
+		if min, _ := t.number(t.Min); t.Min != "" && n < min {
+			return "", fmt.Errorf("value %s is lower than %s", s, t.Min)
+		}
+		if max, _ := t.number(t.Max); t.Max != "" && n > max {
+			return "", fmt.Errorf("value %s is greater than %s", s, t.Max)
+		}
+	}
+	return s, nil
+}
+
+// Render returns the object configuration rendered with the parameters
+// params, which must be declared by the template. The parameters not set
+// in params take their declared default value.
+//
+// The template name and params are recorded in the DEFAULT.template and
+// DEFAULT.template_params keywords of the rendered configuration.
+func (t *T) Render(params map[string]string) (rawconfig.T, error) {
+	values := make(map[string]string)
+	for k := range params {
+		if !slices.ContainsFunc(t.Params, func(p Param) bool { return p.Name == k }) {
+			return rawconfig.T{}, fmt.Errorf("template %s has no parameter %s", t.Name, k)
+		}
+	}
+	for _, param := range t.Params {
+		v, ok := params[param.Name]
+		if !ok {
+			if param.Required {
+				return rawconfig.T{}, fmt.Errorf("parameter %s is required", param.Name)
+			}
+			v = param.Default
+		}
+		if v == "" {
+			values[param.Name] = ""
+			continue
+		}
+		normalized, err := param.normalize(v)
+		if err != nil {
+			return rawconfig.T{}, fmt.Errorf("parameter %s: %w", param.Name, err)
+		}
+		values[param.Name] = normalized
+	}
+
+	raw := rawconfig.New()
+	for _, section := range t.body.Data.Keys() {
+		raw.Data.Set(section, *orderedmap.New())
+	}
+	if err := t.walk(func(section, option, s string) (string, error) {
+		v, err := t.substitute(s, values)
+		if err != nil {
+			return "", err
+		}
+		i, _ := raw.Data.Get(section)
+		m := i.(orderedmap.OrderedMap)
+		m.Set(option, v)
+		raw.Data.Set(section, m)
+		return v, nil
+	}); err != nil {
+		return rawconfig.T{}, err
+	}
+
+	var defaultSection orderedmap.OrderedMap
+	if i, ok := raw.Data.Get("DEFAULT"); ok {
+		defaultSection = i.(orderedmap.OrderedMap)
+	} else {
+		defaultSection = *orderedmap.New()
+	}
+	defaultSection.Set(KeywordTemplate, t.Name)
+	defaultSection.Set(KeywordTemplateParams, FormatParams(params))
+	raw.Data.Set("DEFAULT", defaultSection)
+	return raw, nil
+}
+
+// walk calls fn for each keyword of the template body.
+func (t *T) walk(fn func(section, option, value string) (string, error)) error {
+	for _, section := range t.body.Data.Keys() {
+		i, _ := t.body.Data.Get(section)
+		m := i.(orderedmap.OrderedMap)
+		for _, option := range m.Keys() {
+			v, _ := m.Get(option)
+			s, _ := v.(string)
+			if _, err := fn(section, option, s); err != nil {
+				return fmt.Errorf("%s.%s: %w", section, option, err)
+			}
+		}
+	}
+	return nil
+}
+
+// substitute returns s with the parameter references replaced by their
+// value in values. With nil values, it only verifies the references.
+func (t *T) substitute(s string, values map[string]string) (string, error) {
+	var errs error
+	s = regexpReference.ReplaceAllStringFunc(s, func(ref string) string {
+		name := regexpReference.FindStringSubmatch(ref)[1]
+		if !slices.ContainsFunc(t.Params, func(p Param) bool { return p.Name == name }) {
+			errs = errors.Join(errs, fmt.Errorf("undeclared parameter %s", name))
+			return ref
+		}
+		if values == nil {
+			return ref
+		}
+		return values[name]
+	})
+	return s, errs
+}
+
+// ParseParams returns the parameters map of a list of <name>=<value>
+// strings.
+func ParseParams(l []string) (map[string]string, error) {
+	m := make(map[string]string)
+	for _, s := range l {
+		name, value, ok := strings.Cut(s, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid parameter %s: expected <name>=<value>", s)
+		}
+		m[name] = value
+	}
+	return m, nil
+}
+
+// FormatParams returns the DEFAULT.template_params keyword value recording
+// params: a shell quoted list of <name>=<value>, sorted by name.
+func FormatParams(params map[string]string) string {
+	l := make([]string, 0, len(params))
+	for k, v := range params {
+		l = append(l, k+"="+v)
+	}
+	sort.Strings(l)
+	return shellquote.Join(l...)
+}
+
+// ParseRecordedParams returns the parameters recorded in the
+// DEFAULT.template_params keyword value s.
+func ParseRecordedParams(s string) (map[string]string, error) {
+	l, err := shellquote.Split(s)
+	if err != nil {
+		return nil, err
+	}
+	return ParseParams(l)
+}
@@ -0,0 +1,127 @@
+package objecttemplate
+
+import (
+	"testing"
+
+	"github.com/iancoleman/orderedmap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/rawconfig"
+)
+
+const webINI = `
+# a web server
+[param#port]
+type = int
+default = 8080
+min = 1
+max = 65535
+
+[param#size]
+type = size
+required = true
+
+[param#env]
+candidates = dev prd
+default = dev
+
+[DEFAULT]
+nodes = *
+env = {param.env}
+
+[volume#1]
+size = {param.size}
+
+[app#1]
+start = /usr/bin/web --port {param.port}
+`
+
+const webYAML = `
+param#port:
+  type: int
+  default: 8080
+param#size:
+  type: size
+  required: true
+DEFAULT:
+  nodes: "*"
+app#1:
+  start: "/usr/bin/web --port {param.port} --size {param.size}"
+`
+
+func get(t *testing.T, raw rawconfig.T, section, option string) string {
+	t.Helper()
+	i, ok := raw.Data.Get(section)
+	require.True(t, ok, "section %s", section)
+	m := i.(orderedmap.OrderedMap)
+	v, _ := m.Get(option)
+	s, _ := v.(string)
+	return s
+}
+
+func TestParse(t *testing.T) {
+	tpl, err := Parse("web", []byte(webINI))
+	require.NoError(t, err)
+	assert.Equal(t, []Param{
+		{Name: "port", Type: "int", Default: "8080", Min: "1", Max: "65535"},
+		{Name: "size", Type: "size", Required: true},
+		{Name: "env", Type: "string", Default: "dev", Candidates: []string{"dev", "prd"}},
+	}, tpl.Params)
+	assert.Equal(t, []string{"DEFAULT", "volume#1", "app#1"}, tpl.body.Data.Keys())
+
+	tpl, err = Parse("web", []byte(webYAML))
+	require.NoError(t, err)
+	assert.Len(t, tpl.Params, 2)
+
+	for name, s := range map[string]string{
+		"undeclared reference": "[DEFAULT]\nnodes = {param.nodes}\n",
+		"unsupported type":     "[param#a]\ntype = float\n",
+		"unknown keyword":      "[param#a]\nfoo = bar\n",
+		"invalid default":      "[param#a]\ntype = int\ndefault = a\n",
+		"invalid min":          "[param#a]\ntype = string\nmin = 1\n",
+		"invalid pattern":      "[param#a]\npattern = (\n",
+		"invalid name":         "[param#a.b]\n",
+	} {
+		_, err := Parse("web", []byte(s))
+		assert.Error(t, err, name)
+	}
+}
+
+func TestRender(t *testing.T) {
+	tpl, err := Parse("web", []byte(webINI))
+	require.NoError(t, err)
+
+	raw, err := tpl.Render(map[string]string{"size": "10g", "env": "prd"})
+	require.NoError(t, err)
+	assert.Equal(t, "/usr/bin/web --port 8080", get(t, raw, "app#1", "start"))
+	assert.Equal(t, "10g", get(t, raw, "volume#1", "size"))
+	assert.Equal(t, "prd", get(t, raw, "DEFAULT", "env"))
+	assert.Equal(t, "web", get(t, raw, "DEFAULT", KeywordTemplate))
+	assert.Equal(t, "env=prd size=10g", get(t, raw, "DEFAULT", KeywordTemplateParams))
+
+	for name, params := range map[string]map[string]string{
+		"missing required": {},
+		"unknown param":    {"size": "1g", "foo": "bar"},
+		"invalid int":      {"size": "1g", "port": "a"},
+		"invalid size":     {"size": "1x"},
+		"lower than min":   {"size": "1g", "port": "0"},
+		"greater than max": {"size": "1g", "port": "65536"},
+		"not a candidate":  {"size": "1g", "env": "tst"},
+	} {
+		_, err := tpl.Render(params)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestRecordedParams(t *testing.T) {
+	params := map[string]string{"owner": "john doe", "port": "80", "empty": ""}
+	s := FormatParams(params)
+	assert.Equal(t, `empty= 'owner=john doe' port=80`, s)
+	parsed, err := ParseRecordedParams(s)
+	require.NoError(t, err)
+	assert.Equal(t, params, parsed)
+
+	_, err = ParseParams([]string{"port"})
+	assert.Error(t, err)
+}
@@ -4,16 +4,19 @@ func init() {
 	kind := "ccfg"
 
 	cmdObject := newCmdCcfg()
+	cmdObjectConfig := newCmdObjectConfig(kind)
 	cmdObjectEdit := newCmdObjectEdit(kind)
 	cmdObjectSet := newCmdObjectSet(kind)
 	cmdObjectPrint := newCmdObjectPrint(kind)
 	cmdObjectPrintConfig := newCmdObjectPrintConfig(kind)
 	cmdObjectValidate := newCmdObjectValidate(kind)
+	cmdClusterSecret := newCmdClusterSecret()
 
 	root.AddCommand(
 		cmdObject,
 	)
 	cmdObject.AddCommand(
+		cmdObjectConfig,
 		cmdObjectEdit,
 		cmdObjectSet,
 		cmdObjectPrint,
@@ -21,6 +24,8 @@ func init() {
 		newCmdClusterAbort(),
 		newCmdClusterFreeze(),
 		newCmdClusterLogs(),
+		newCmdClusterRebalance(),
+		cmdClusterSecret,
 		newCmdClusterThaw(),
 		newCmdClusterUnfreeze(),
 		newCmdObjectCreate(kind),
@@ -34,6 +39,14 @@ func init() {
 		newCmdObjectUnset(kind),
 		newCmdObjectUpdate(kind),
 	)
+	cmdClusterSecret.AddCommand(
+		newCmdClusterSecretRotate(),
+	)
+	cmdObjectConfig.AddCommand(
+		newCmdObjectConfigDiff(kind),
+		newCmdObjectConfigHistory(kind),
+		newCmdObjectConfigRevert(kind),
+	)
 	cmdObjectEdit.AddCommand(
 		newCmdObjectEditConfig(kind),
 	)
@@ -101,7 +101,7 @@ func addFlagCPUProfile(flagSet *pflag.FlagSet, p *string) {
 }
 
 func addFlagCreateConfig(flagSet *pflag.FlagSet, p *string) {
-	flagSet.StringVar(p, "config", "", "The configuration to use as template when creating or installing a service. The value can be `-` or `/dev/stdin` to read the json-formatted configuration from stdin, or a file path, or uri pointing to a ini-formatted configuration, or a service selector expression (ATTENTION with cloning existing live services that include more than containers, volumes and backend ip addresses ... this could cause disruption on the cloned service), or a template numeric id, or template://<name>.")
+	flagSet.StringVar(p, "config", "", "The configuration to use as template when creating or installing a service. The value can be `-` or `/dev/stdin` to read the json-formatted configuration from stdin, or a file path, or uri pointing to a ini-formatted configuration, or to a json or yaml formatted configuration if the path has a .json, .yaml or .yml extension, or a service selector expression (ATTENTION with cloning existing live services that include more than containers, volumes and backend ip addresses ... this could cause disruption on the cloned service), or a template numeric id, or template://<name>.")
 }
 
 func addFlagConfirm(flagSet *pflag.FlagSet, p *bool) {
@@ -120,6 +120,10 @@ func addFlagCreateRestore(flagSet *pflag.FlagSet, p *bool) {
 	flagSet.BoolVar(p, "restore", false, "Keep the object id defined in the source config.")
 }
 
+func addFlagCreateTemplate(flagSet *pflag.FlagSet, p *string) {
+	flagSet.StringVar(p, "template", "", "The name of the template to render the object configuration from. The templates are files under <var>/template/, or keys of the system/cfg/templates cfg object.")
+}
+
 func addFlagCron(flagSet *pflag.FlagSet, p *bool) {
 	flagSet.BoolVar(p, "cron", false, "Run the action as if executed by the daemon. For example, the run action requirements error message are disabled.")
 }
@@ -277,6 +281,10 @@ func addFlagEventTemplate(flagSet *pflag.FlagSet, p *string) {
 	flagSet.StringVar(p, "template", "", usageFlagEventTemplate)
 }
 
+func addFlagTemplateParams(flagSet *pflag.FlagSet, p *[]string) {
+	flagSet.StringArrayVar(p, "param", nil, "A template parameter value, <name>=<value>. Can be repeated.")
+}
+
 func addFlagTime(flagSet *pflag.FlagSet, p *time.Duration) {
 	flagSet.DurationVar(p, "time", 5*time.Minute, "Stop waiting for the object to reach the target state after a duration.")
 }
@@ -1,6 +1,7 @@
 package om
 
 import (
+	"context"
 	// Necessary to use go:embed
 	_ "embed"
 	"fmt"
@@ -20,6 +21,7 @@ import (
 	"github.com/opensvc/om3/core/rawconfig"
 	"github.com/opensvc/om3/util/hostname"
 	"github.com/opensvc/om3/util/logging"
+	"github.com/opensvc/om3/util/tracing"
 	"github.com/opensvc/om3/util/version"
 	"github.com/opensvc/om3/util/xsession"
 )
@@ -171,7 +173,12 @@ func setExecuteArgs(args []string) {
 //	ExecuteArgs([]string{"mysvc*", "ls"})
 func ExecuteArgs(args []string) {
 	setExecuteArgs(args)
-	if err := root.Execute(); err != nil {
+	if err := tracing.Start(root.Use); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	err := root.Execute()
+	_ = tracing.Stop(context.Background())
+	if err != nil {
 		os.Exit(1)
 	}
 }
@@ -4,9 +4,11 @@ func init() {
 	kind := "sec"
 
 	cmdObject := newCmdSec()
+	cmdObjectConfig := newCmdObjectConfig(kind)
 	cmdObjectEdit := newCmdObjectEdit(kind)
 	cmdObjectInstance := newCmdObjectInstance(kind)
 	cmdObjectSet := newCmdObjectSet(kind)
+	cmdObjectTemplate := newCmdObjectTemplate(kind)
 	cmdObjectPrint := newCmdObjectPrint(kind)
 	cmdObjectPrintConfig := newCmdObjectPrintConfig(kind)
 	cmdObjectValidate := newCmdObjectValidate(kind)
@@ -15,10 +17,12 @@ func init() {
 		cmdObject,
 	)
 	cmdObject.AddCommand(
+		cmdObjectConfig,
 		cmdObjectEdit,
 		cmdObjectInstance,
 		cmdObjectPrint,
 		cmdObjectSet,
+		cmdObjectTemplate,
 		cmdObjectValidate,
 		newCmdKeystoreAdd(kind),
 		newCmdKeystoreChange(kind),
@@ -42,6 +46,14 @@ func init() {
 		newCmdSecGenCert(kind),
 		newCmdSecPKCS(kind),
 	)
+	cmdObjectTemplate.AddCommand(
+		newCmdObjectTemplateUpgrade(kind),
+	)
+	cmdObjectConfig.AddCommand(
+		newCmdObjectConfigDiff(kind),
+		newCmdObjectConfigHistory(kind),
+		newCmdObjectConfigRevert(kind),
+	)
 	cmdObjectEdit.AddCommand(
 		newCmdObjectEditConfig(kind),
 	)
@@ -0,0 +1,43 @@
+//go:build linux
+
+package om
+
+import (
+	"context"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/drivers/resipvrrp"
+)
+
+var (
+	vrrpPath string
+	vrrpRID  string
+
+	vrrpCmd = &cobra.Command{
+		Use:    "vrrp",
+		Short:  "Run the vrrp speaker of an ip.vrrp resource",
+		Hidden: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			p, err := naming.ParsePath(vrrpPath)
+			if err != nil {
+				return err
+			}
+			ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+			defer cancel()
+			return resipvrrp.Run(ctx, p, vrrpRID)
+		},
+	}
+)
+
+func init() {
+	root.AddCommand(vrrpCmd)
+	flags := vrrpCmd.Flags()
+	flags.StringVar(&vrrpPath, "path", "", "the path of the object hosting the ip.vrrp resource")
+	flags.StringVar(&vrrpRID, "rid", "", "the id of the ip.vrrp resource")
+	_ = vrrpCmd.MarkFlagRequired("path")
+	_ = vrrpCmd.MarkFlagRequired("rid")
+}
@@ -0,0 +1,64 @@
+package omcmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+type CmdClusterRebalance struct {
+	OptsGlobal
+	DryRun      bool
+	Apply       bool
+	MaxParallel int
+}
+
+func (t *CmdClusterRebalance) Run() error {
+	if t.DryRun == t.Apply {
+		return fmt.Errorf("one of --dry-run or --apply is required")
+	}
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	body := api.PostClusterActionRebalance{
+		DryRun:      &t.DryRun,
+		MaxParallel: &t.MaxParallel,
+	}
+	resp, err := c.PostClusterActionRebalanceWithResponse(context.Background(), body)
+	if err != nil {
+		return err
+	}
+	var pb api.Problem
+	switch resp.StatusCode() {
+	case 200:
+		if !resp.JSON200.DryRun {
+			fmt.Printf("rebalance %s started\n", resp.JSON200.ID)
+		}
+		output.Renderer{
+			DefaultOutput: "tab=WAVE:wave,OBJECT:path,PRIORITY:priority,FROM:from[*],TO:to[*]",
+			Output:        t.Output,
+			Color:         t.Color,
+			Data:          resp.JSON200.Moves,
+			Colorize:      rawconfig.Colorize,
+		}.Print()
+		return nil
+	case 400:
+		pb = *resp.JSON400
+	case 401:
+		pb = *resp.JSON401
+	case 403:
+		pb = *resp.JSON403
+	case 408:
+		pb = *resp.JSON408
+	case 409:
+		pb = *resp.JSON409
+	case 500:
+		pb = *resp.JSON500
+	}
+	return fmt.Errorf("%s", pb)
+}
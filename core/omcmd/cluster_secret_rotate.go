@@ -0,0 +1,41 @@
+package omcmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/core/keyop"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/util/key"
+)
+
+type CmdClusterSecretRotate struct {
+	OptsGlobal
+}
+
+// Run sets a new random cluster next secret. The daemons distribute it,
+// switch to it when all nodes have accepted it, then retire the replaced
+// secret.
+func (t *CmdClusterSecretRotate) Run() error {
+	var (
+		keyNextSecret     = key.New("cluster", "next_secret")
+		keyPreviousSecret = key.New("cluster", "previous_secret")
+	)
+	ccfg, err := object.NewCluster(object.WithVolatile(false))
+	if err != nil {
+		return err
+	}
+	c := ccfg.Config()
+	if c.GetString(keyNextSecret) != "" || c.GetString(keyPreviousSecret) != "" {
+		return errors.New("a cluster secret rotation is already in progress")
+	}
+	secret := strings.ReplaceAll(uuid.New().String(), "-", "")
+	if err := c.Set(*keyop.New(keyNextSecret, keyop.Set, secret, 0)); err != nil {
+		return err
+	}
+	fmt.Println("cluster secret rotation started, follow its progress with 'om daemon status'")
+	return nil
+}
@@ -50,7 +50,7 @@ func (t *CmdNodePrintSchedule) extractLocal() (api.ScheduleItems, error) {
 		return items, err
 	}
 
-	for _, e := range n.Schedules() {
+	for _, e := range n.PrintSchedule() {
 		item := api.ScheduleItem{
 			Kind: "ScheduleItem",
 			Meta: api.InstanceMeta{
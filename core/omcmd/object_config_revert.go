@@ -0,0 +1,50 @@
+package omcmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/objectselector"
+)
+
+type (
+	CmdObjectConfigRevert struct {
+		OptsGlobal
+		Revision int
+	}
+)
+
+func (t *CmdObjectConfigRevert) Run(selector, kind string) error {
+	mergedSelector := mergeSelector(selector, t.ObjectSelector, kind, "")
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	paths, err := objectselector.New(mergedSelector, objectselector.WithClient(c)).Expand()
+	if err != nil {
+		return err
+	}
+	for _, p := range paths {
+		response, err := c.PostObjectConfigHistoryRevertWithResponse(context.Background(), p.Namespace, p.Kind, p.Name, t.Revision)
+		if err != nil {
+			return err
+		}
+		switch response.StatusCode() {
+		case 204:
+		case 400:
+			return fmt.Errorf("%s: %s", p, *response.JSON400)
+		case 401:
+			return fmt.Errorf("%s: %s", p, *response.JSON401)
+		case 403:
+			return fmt.Errorf("%s: %s", p, *response.JSON403)
+		case 404:
+			return fmt.Errorf("%s: %s", p, *response.JSON404)
+		case 500:
+			return fmt.Errorf("%s: %s", p, *response.JSON500)
+		default:
+			return fmt.Errorf("%s: unexpected response: %s", p, response.Status())
+		}
+	}
+	return nil
+}
@@ -21,8 +21,10 @@ import (
 	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/core/object"
 	"github.com/opensvc/om3/core/objectselector"
+	"github.com/opensvc/om3/core/objecttemplate"
 	"github.com/opensvc/om3/core/rawconfig"
 	"github.com/opensvc/om3/core/xconfig"
+	"github.com/opensvc/om3/daemon/api"
 	"github.com/opensvc/om3/util/file"
 	"github.com/opensvc/om3/util/key"
 	"github.com/opensvc/om3/util/uri"
@@ -41,6 +43,8 @@ type (
 		Restore     bool
 		Force       bool
 		Namespace   string
+		Template    string
+		Params      []string
 
 		client *client.T
 		path   naming.Path
@@ -122,6 +126,9 @@ func (t *CmdObjectCreate) parseSelector(selector, kind string) (naming.Path, err
 }
 
 func (t *CmdObjectCreate) getTemplate() string {
+	if t.Template != "" {
+		return t.Template
+	}
 	if strings.HasPrefix(t.Config, schemeTemplate) {
 		return t.Config[len(schemeTemplate):]
 	}
@@ -142,14 +149,18 @@ func (t *CmdObjectCreate) getSourcePaths() naming.Paths {
 
 func (t *CmdObjectCreate) do() error {
 	template := t.getTemplate()
+	if len(t.Params) > 0 && template == "" {
+		return fmt.Errorf("--param requires --template")
+	}
+	if template != "" {
+		return t.fromTemplate(template)
+	}
 	paths := t.getSourcePaths()
 	switch {
 	case t.Config == "":
 		return t.fromScratch()
 	case t.Config == "-" || t.Config == "/dev/stdin" || t.Config == "stdin":
 		return t.fromStdin()
-	case template != "":
-		return t.fromTemplate(template)
 	case len(paths) > 0:
 		return t.fromPaths(paths)
 	default:
@@ -286,7 +297,69 @@ func (t CmdObjectCreate) fromData(pivot Pivot) error {
 }
 
 func (t CmdObjectCreate) rawFromTemplate(template string) (Pivot, error) {
-	return nil, fmt.Errorf("todo: collector requester")
+	if _, err := strconv.Atoi(template); err == nil {
+		return nil, fmt.Errorf("todo: collector requester")
+	}
+	if t.path.IsZero() {
+		return nil, fmt.Errorf("need a target object path")
+	}
+	params, err := objecttemplate.ParseParams(t.Params)
+	if err != nil {
+		return nil, err
+	}
+	var raw rawconfig.T
+	if clientcontext.IsSet() {
+		raw, err = t.rawFromRemoteTemplate(template, params)
+	} else {
+		raw, err = rawFromLocalTemplate(template, params)
+	}
+	if err != nil {
+		return nil, err
+	}
+	pivot := make(Pivot)
+	pivot[t.path.String()] = raw
+	return pivot, nil
+}
+
+func rawFromLocalTemplate(template string, params map[string]string) (rawconfig.T, error) {
+	tpl, err := objecttemplate.Load(template)
+	if err != nil {
+		return rawconfig.T{}, err
+	}
+	return tpl.Render(params)
+}
+
+func (t CmdObjectCreate) rawFromRemoteTemplate(template string, params map[string]string) (rawconfig.T, error) {
+	body := api.PostObjectTemplateRender{
+		Template: template,
+		Params:   &params,
+	}
+	resp, err := t.client.PostObjectTemplateRenderWithResponse(context.Background(), t.path.Namespace, t.path.Kind, t.path.Name, body)
+	if err != nil {
+		return rawconfig.T{}, err
+	}
+	var pb api.Problem
+	switch resp.StatusCode() {
+	case 200:
+		c, err := xconfig.NewObject("", []byte(resp.JSON200.Data))
+		if err != nil {
+			return rawconfig.T{}, err
+		}
+		return c.Raw(), nil
+	case 400:
+		pb = *resp.JSON400
+	case 401:
+		pb = *resp.JSON401
+	case 403:
+		pb = *resp.JSON403
+	case 404:
+		pb = *resp.JSON404
+	case 500:
+		pb = *resp.JSON500
+	default:
+		return rawconfig.T{}, fmt.Errorf("unexpected response: %s", resp.Status())
+	}
+	return rawconfig.T{}, fmt.Errorf("%s: %s", pb.Title, pb.Detail)
 }
 
 func (t CmdObjectCreate) rawFromConfig() (Pivot, error) {
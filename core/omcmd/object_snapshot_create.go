@@ -0,0 +1,75 @@
+package omcmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opensvc/om3/core/actioncontext"
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/objectaction"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+type (
+	CmdObjectSnapshotCreate struct {
+		OptsGlobal
+		OptsLock
+		NodeSelector string
+		Name         string
+	}
+)
+
+func (t *CmdObjectSnapshotCreate) Run(selector, kind string) error {
+	mergedSelector := mergeSelector(selector, t.ObjectSelector, kind, "")
+	return objectaction.New(
+		objectaction.WithLocal(t.Local),
+		objectaction.WithObjectSelector(mergedSelector),
+		objectaction.WithOutput(t.Output),
+		objectaction.WithColor(t.Color),
+		objectaction.WithServer(t.Server),
+		objectaction.WithRemoteNodes(t.NodeSelector),
+		objectaction.WithRemoteFunc(func(ctx context.Context, p naming.Path, nodename string) (interface{}, error) {
+			c, err := client.New(client.WithURL(t.Server))
+			if err != nil {
+				return nil, err
+			}
+			params := api.PostInstanceSnapshotParams{}
+			if t.Name != "" {
+				params.Name = &t.Name
+			}
+			response, err := c.PostInstanceSnapshotWithResponse(ctx, nodename, p.Namespace, p.Kind, p.Name, &params)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case response.JSON200 != nil:
+				return *response.JSON200, nil
+			case response.JSON400 != nil:
+				return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON400)
+			case response.JSON401 != nil:
+				return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON401)
+			case response.JSON403 != nil:
+				return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON403)
+			case response.JSON404 != nil:
+				return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON404)
+			case response.JSON409 != nil:
+				return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON409)
+			case response.JSON500 != nil:
+				return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON500)
+			default:
+				return nil, fmt.Errorf("%s: node %s: unexpected response: %s", p, nodename, response.Status())
+			}
+		}),
+		objectaction.WithLocalFunc(func(ctx context.Context, p naming.Path) (interface{}, error) {
+			o, err := object.NewVol(p)
+			if err != nil {
+				return nil, err
+			}
+			ctx = actioncontext.WithLockDisabled(ctx, t.Disable)
+			ctx = actioncontext.WithLockTimeout(ctx, t.Timeout)
+			return o.CreateSnapshot(ctx, t.Name)
+		}),
+	).Do()
+}
@@ -0,0 +1,139 @@
+package omcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/clientcontext"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/nodeselector"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/objectselector"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/util/hostname"
+)
+
+type (
+	CmdObjectSnapshotLs struct {
+		OptsGlobal
+		NodeSelector string
+	}
+)
+
+func (t *CmdObjectSnapshotLs) extractLocal(selector string) (api.SnapshotItems, error) {
+	items := make(api.SnapshotItems, 0)
+	sel := objectselector.New(
+		selector,
+		objectselector.WithLocal(true),
+	)
+	paths, err := sel.Expand()
+	if err != nil {
+		return items, err
+	}
+	var errs error
+	ctx := context.Background()
+	for _, p := range paths {
+		o, err := object.NewVol(p)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", p, err))
+			continue
+		}
+		snaps, err := o.Snapshots(ctx)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", p, err))
+			continue
+		}
+		for _, snap := range snaps {
+			items = append(items, api.SnapshotItem{
+				Node:    hostname.Hostname(),
+				Path:    p.String(),
+				Name:    snap.Name,
+				Created: snap.Created,
+				Used:    snap.Used,
+			})
+		}
+	}
+	return items, errs
+}
+
+func (t *CmdObjectSnapshotLs) extractFromDaemons(selector string) (api.SnapshotItems, error) {
+	items := make(api.SnapshotItems, 0)
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return items, err
+	}
+	paths, err := objectselector.New(selector, objectselector.WithClient(c)).Expand()
+	if err != nil {
+		return items, err
+	}
+	nodeSelector := t.NodeSelector
+	if nodeSelector == "" {
+		nodeSelector = "*"
+	}
+	nodenames, err := nodeselector.New(nodeSelector, nodeselector.WithClient(c)).Expand()
+	if err != nil {
+		return items, err
+	}
+	var errs error
+	ctx := context.Background()
+	for _, p := range paths {
+		for _, nodename := range nodenames {
+			more, err := getInstanceSnapshots(ctx, c, nodename, p)
+			if err != nil {
+				errs = errors.Join(errs, err)
+				continue
+			}
+			items = append(items, more...)
+		}
+	}
+	return items, errs
+}
+
+func getInstanceSnapshots(ctx context.Context, c *client.T, nodename string, p naming.Path) (api.SnapshotItems, error) {
+	response, err := c.GetInstanceSnapshotsWithResponse(ctx, nodename, p.Namespace, p.Kind, p.Name)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case response.JSON200 != nil:
+		return response.JSON200.Items, nil
+	case response.JSON404 != nil:
+		// no instance on this node
+		return nil, nil
+	case response.JSON400 != nil:
+		return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON400)
+	case response.JSON401 != nil:
+		return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON401)
+	case response.JSON403 != nil:
+		return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON403)
+	case response.JSON500 != nil:
+		return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON500)
+	default:
+		return nil, fmt.Errorf("%s: node %s: unexpected response: %s", p, nodename, response.Status())
+	}
+}
+
+func (t *CmdObjectSnapshotLs) Run(selector, kind string) error {
+	var (
+		items api.SnapshotItems
+		err   error
+	)
+	mergedSelector := mergeSelector(selector, t.ObjectSelector, kind, "")
+	if t.Local || (!clientcontext.IsSet() && t.NodeSelector == "") {
+		items, err = t.extractLocal(mergedSelector)
+	} else {
+		items, err = t.extractFromDaemons(mergedSelector)
+	}
+	output.Renderer{
+		DefaultOutput: "tab=OBJECT:path,NODE:node,NAME:name,CREATED:created,USED:used",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          api.SnapshotList{Items: items, Kind: "SnapshotList"},
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return err
+}
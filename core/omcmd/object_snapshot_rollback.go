@@ -0,0 +1,77 @@
+package omcmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opensvc/om3/core/actioncontext"
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/objectaction"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+type (
+	CmdObjectSnapshotRollback struct {
+		OptsGlobal
+		OptsLock
+		NodeSelector string
+		Name         string
+		Force        bool
+	}
+)
+
+func (t *CmdObjectSnapshotRollback) Run(selector, kind string) error {
+	mergedSelector := mergeSelector(selector, t.ObjectSelector, kind, "")
+	return objectaction.New(
+		objectaction.WithLocal(t.Local),
+		objectaction.WithObjectSelector(mergedSelector),
+		objectaction.WithOutput(t.Output),
+		objectaction.WithColor(t.Color),
+		objectaction.WithServer(t.Server),
+		objectaction.WithRemoteNodes(t.NodeSelector),
+		objectaction.WithRemoteFunc(func(ctx context.Context, p naming.Path, nodename string) (interface{}, error) {
+			c, err := client.New(client.WithURL(t.Server))
+			if err != nil {
+				return nil, err
+			}
+			params := api.PostInstanceSnapshotRollbackParams{
+				Name:  &t.Name,
+				Force: &t.Force,
+			}
+			response, err := c.PostInstanceSnapshotRollbackWithResponse(ctx, nodename, p.Namespace, p.Kind, p.Name, &params)
+			if err != nil {
+				return nil, err
+			}
+			switch response.StatusCode() {
+			case 204:
+				return nil, nil
+			case 400:
+				return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON400)
+			case 401:
+				return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON401)
+			case 403:
+				return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON403)
+			case 404:
+				return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON404)
+			case 409:
+				return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON409)
+			case 500:
+				return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON500)
+			default:
+				return nil, fmt.Errorf("%s: node %s: unexpected response: %s", p, nodename, response.Status())
+			}
+		}),
+		objectaction.WithLocalFunc(func(ctx context.Context, p naming.Path) (interface{}, error) {
+			o, err := object.NewVol(p)
+			if err != nil {
+				return nil, err
+			}
+			ctx = actioncontext.WithLockDisabled(ctx, t.Disable)
+			ctx = actioncontext.WithLockTimeout(ctx, t.Timeout)
+			ctx = actioncontext.WithForce(ctx, t.Force)
+			return nil, o.RollbackSnapshot(ctx, t.Name)
+		}),
+	).Do()
+}
@@ -0,0 +1,83 @@
+package omcmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/objectselector"
+	"github.com/opensvc/om3/core/objecttemplate"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+type (
+	CmdObjectTemplateUpgrade struct {
+		OptsGlobal
+		Params []string
+		DryRun bool
+	}
+)
+
+func (t *CmdObjectTemplateUpgrade) Run(selector, kind string) error {
+	params, err := objecttemplate.ParseParams(t.Params)
+	if err != nil {
+		return err
+	}
+	mergedSelector := mergeSelector(selector, t.ObjectSelector, kind, "")
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	paths, err := objectselector.New(mergedSelector, objectselector.WithClient(c)).Expand()
+	if err != nil {
+		return err
+	}
+	body := api.PostObjectTemplateUpgrade{
+		DryRun: &t.DryRun,
+		Params: &params,
+	}
+	result := api.ObjectApplyResult{
+		DryRun: t.DryRun,
+		Items:  make([]api.ObjectApplyItem, 0, len(paths)),
+	}
+	var errs error
+	for _, p := range paths {
+		resp, err := c.PostObjectTemplateUpgradeWithResponse(context.Background(), p.Namespace, p.Kind, p.Name, body)
+		if err != nil {
+			return err
+		}
+		var pb *api.Problem
+		switch resp.StatusCode() {
+		case 200:
+			result.Items = append(result.Items, *resp.JSON200)
+			continue
+		case 400:
+			pb = resp.JSON400
+		case 401:
+			pb = resp.JSON401
+		case 403:
+			pb = resp.JSON403
+		case 404:
+			pb = resp.JSON404
+		case 500:
+			pb = resp.JSON500
+		default:
+			return fmt.Errorf("%s: unexpected response: %s", p, resp.Status())
+		}
+		s := pb.Detail
+		result.Items = append(result.Items, api.ObjectApplyItem{Path: p.String(), Action: api.Update, Error: &s})
+		errs = fmt.Errorf("some objects were not upgraded")
+	}
+	output.Renderer{
+		Output: t.Output,
+		Color:  t.Color,
+		Data:   result,
+		HumanRenderer: func() string {
+			return applyResultRender(result)
+		},
+		Colorize: rawconfig.Colorize,
+	}.Print()
+	return errs
+}
@@ -0,0 +1,185 @@
+package omcrypto
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+type (
+	// Codec is the interface of the compression algorithms applied to the
+	// message data before encryption.
+	Codec interface {
+		Name() string
+		Compress([]byte) ([]byte, error)
+		Decompress([]byte) ([]byte, error)
+	}
+
+	zlibCodec struct{}
+	gzipCodec struct{}
+	zstdCodec struct{}
+	noneCodec struct{}
+)
+
+const (
+	// DefaultCodec is the codec used when none is specified. The messages
+	// compressed with DefaultCodec don't announce their codec, so they
+	// can be decoded by the nodes not supporting codec selection.
+	DefaultCodec = "zlib"
+)
+
+var (
+	codecs   = make(map[string]Codec)
+	codecsMu sync.RWMutex
+
+	// zstdMaxMemory is the maximum size of a zstd decompressed message.
+	zstdMaxMemory uint64 = 64 * 1024 * 1024
+
+	// zstdEncoder and zstdDecoder are shared by the zstd codec users, as
+	// their creation is expensive and their EncodeAll and DecodeAll
+	// methods are safe for concurrent use.
+	zstdEncoder = sync.OnceValues(func() (*zstd.Encoder, error) {
+		return zstd.NewWriter(nil)
+	})
+	zstdDecoder = sync.OnceValues(func() (*zstd.Decoder, error) {
+		return zstd.NewReader(nil, zstd.WithDecoderMaxMemory(zstdMaxMemory))
+	})
+)
+
+func init() {
+	RegisterCodec(zlibCodec{})
+	RegisterCodec(gzipCodec{})
+	RegisterCodec(zstdCodec{})
+	RegisterCodec(noneCodec{})
+}
+
+// RegisterCodec makes the codec <c> available for encryption and
+// decryption, replacing a previously registered codec with the same name.
+func RegisterCodec(c Codec) {
+	codecsMu.Lock()
+	defer codecsMu.Unlock()
+	codecs[c.Name()] = c
+}
+
+// Codecs returns the sorted names of the registered codecs.
+func Codecs() []string {
+	codecsMu.RLock()
+	defer codecsMu.RUnlock()
+	l := make([]string, 0, len(codecs))
+	for name := range codecs {
+		l = append(l, name)
+	}
+	sort.Strings(l)
+	return l
+}
+
+// getCodec returns the registered codec named <name>, or the DefaultCodec
+// if <name> is empty.
+func getCodec(name string) (Codec, error) {
+	if name == "" {
+		name = DefaultCodec
+	}
+	codecsMu.RLock()
+	defer codecsMu.RUnlock()
+	if c, ok := codecs[name]; ok {
+		return c, nil
+	}
+	return nil, fmt.Errorf("unsupported compression %s", name)
+}
+
+func (zlibCodec) Name() string {
+	return "zlib"
+}
+
+func (zlibCodec) Compress(b []byte) ([]byte, error) {
+	var bb bytes.Buffer
+	w := zlib.NewWriter(&bb)
+	if _, err := w.Write(b); err != nil {
+		return nil, err
+	}
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return bb.Bytes(), nil
+}
+
+func (zlibCodec) Decompress(b []byte) ([]byte, error) {
+	r, err := zlib.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+func (gzipCodec) Name() string {
+	return "gzip"
+}
+
+// Compress uses the best compression level, trading cpu for size.
+func (gzipCodec) Compress(b []byte) ([]byte, error) {
+	var bb bytes.Buffer
+	w, err := gzip.NewWriterLevel(&bb, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(b); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return bb.Bytes(), nil
+}
+
+func (gzipCodec) Decompress(b []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+func (zstdCodec) Name() string {
+	return "zstd"
+}
+
+// Compress produces messages about as small as gzip at a cpu cost lower
+// than zlib.
+func (zstdCodec) Compress(b []byte) ([]byte, error) {
+	w, err := zstdEncoder()
+	if err != nil {
+		return nil, err
+	}
+	return w.EncodeAll(b, nil), nil
+}
+
+func (zstdCodec) Decompress(b []byte) ([]byte, error) {
+	r, err := zstdDecoder()
+	if err != nil {
+		return nil, err
+	}
+	return r.DecodeAll(b, nil)
+}
+
+func (noneCodec) Name() string {
+	return "none"
+}
+
+func (noneCodec) Compress(b []byte) ([]byte, error) {
+	return b, nil
+}
+
+func (noneCodec) Decompress(b []byte) ([]byte, error) {
+	return b, nil
+}
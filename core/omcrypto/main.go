@@ -3,14 +3,25 @@ package omcrypto
 
 import (
 	"bytes"
-	"compress/zlib"
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+
+	"github.com/opensvc/om3/core/cluster"
+	"github.com/opensvc/om3/util/hostname"
+)
+
+var (
+	// ErrMAC is returned when no key verifies the message authentication
+	// code.
+	ErrMAC = errors.New("message authentication failed")
 )
 
 type (
@@ -19,15 +30,51 @@ type (
 		NodeName    string `json:"nodename"`
 		IV          string `json:"iv"`
 		Data        string `json:"data"`
+
+		// Compression is the name of the codec used to compress the data
+		// before encryption. It is empty for the DefaultCodec.
+		Compression string `json:"compression,omitempty"`
+
+		// MAC is the HMAC-SHA256 of IV and Data, keyed by the encryption
+		// key. It selects the decryption key among Key and AltKeys, as a
+		// decryption with a wrong key is not always detected by the
+		// codec. It is empty in the messages of the older agents.
+		MAC string `json:"mac,omitempty"`
 	}
 
 	Factory struct {
 		NodeName    string
 		ClusterName string
 		Key         string
+
+		// AltKeys are the keys tried to decrypt the messages Key can not
+		// decrypt, like the next and previous cluster secrets during a
+		// cluster secret rotation. Messages are always encrypted with Key.
+		AltKeys []string
+
+		// Codec is the name of the codec used to compress the encrypted
+		// messages. The DefaultCodec is used if empty. The decryption
+		// uses the codec announced by the message.
+		Codec string
 	}
 )
 
+// NewClusterFactory returns a Factory encrypting with the current cluster
+// secret, and also decrypting with the next and previous cluster secrets
+// during a cluster secret rotation.
+//
+// The cluster secrets change on rotation, so long running users should
+// not keep the Factory.
+func NewClusterFactory() *Factory {
+	clusterConfig := cluster.ConfigData.Get()
+	return &Factory{
+		NodeName:    hostname.Hostname(),
+		ClusterName: clusterConfig.Name,
+		Key:         clusterConfig.Secret(),
+		AltKeys:     clusterConfig.AltSecrets(),
+	}
+}
+
 func (m *Factory) assertValid() {
 	if m.ClusterName == "" {
 		panic("NewMessage: unexpected empty cluster name")
@@ -46,15 +93,13 @@ func (m *Factory) DecryptWithNode(data []byte) ([]byte, string, error) {
 		// fast return, Unmarshal will fail
 		return nil, "", io.EOF
 	}
-	var b []byte
-	key := []byte(m.Key)
 	msg := &encryptedMessage{}
 	err := json.Unmarshal(data, msg)
 	if err != nil {
 		return nil, "", fmt.Errorf("analyse message unmarshal failure: %w", err)
 	}
 	// TODO: test nodename and clustername, plug blacklist
-	b, err = decode(msg.Data, msg.IV, key)
+	b, err := m.decodeMessage(msg)
 	if err != nil {
 		return b, "", fmt.Errorf("analyse message decode failure: %w", err)
 	}
@@ -72,15 +117,22 @@ func (m *Factory) Decrypt(data []byte) ([]byte, error) {
 // Encrypt encrypts the message and returns a json with head keys describing
 // the sender, and embedding the AES-encypted + Base64-encoded data.
 func (m *Factory) Encrypt(data []byte) ([]byte, error) {
+	b, _, err := m.EncryptWithSize(data)
+	return b, err
+}
+
+// EncryptWithSize is like Encrypt, and also returns the size of the
+// compressed data, before encryption and encoding.
+func (m *Factory) EncryptWithSize(data []byte) ([]byte, int, error) {
 	m.assertValid()
-	var (
-		encoded   string
-		encodedIV string
-		err       error
-	)
+	codec, err := getCodec(m.Codec)
+	if err != nil {
+		return nil, 0, err
+	}
 	key := []byte(m.Key)
-	if encoded, encodedIV, err = encode(data, key); err != nil {
-		return nil, err
+	encoded, encodedIV, size, err := encode(data, key, codec)
+	if err != nil {
+		return nil, 0, err
 	}
 	msg := &encryptedMessage{
 		ClusterName: m.ClusterName,
@@ -88,10 +140,59 @@ func (m *Factory) Encrypt(data []byte) ([]byte, error) {
 		IV:          encodedIV,
 		Data:        encoded,
 	}
-	return json.Marshal(msg)
+	if codec.Name() != DefaultCodec {
+		msg.Compression = codec.Name()
+	}
+	msg.MAC = newMAC(key, msg)
+	b, err := json.Marshal(msg)
+	return b, size, err
+}
+
+// decodeMessage decodes the message data with the key among Key and
+// AltKeys whose MAC matches the message MAC.
+//
+// The messages without MAC, sent by the older agents, are decoded with
+// Key, falling back to AltKeys. The Key decode error is returned if no key
+// can decode the data. Only the DefaultCodec is accepted for these
+// messages, as its checksum detects a decryption with a wrong key.
+func (m *Factory) decodeMessage(msg *encryptedMessage) ([]byte, error) {
+	codec, err := getCodec(msg.Compression)
+	if err != nil {
+		return nil, err
+	}
+	if msg.MAC != "" {
+		for _, key := range append([]string{m.Key}, m.AltKeys...) {
+			if hmac.Equal([]byte(msg.MAC), []byte(newMAC([]byte(key), msg))) {
+				return decode(msg.Data, msg.IV, []byte(key), codec)
+			}
+		}
+		return nil, ErrMAC
+	}
+	if codec.Name() != DefaultCodec {
+		return nil, fmt.Errorf("%w: %s compressed message without mac", ErrMAC, codec.Name())
+	}
+	b, err := decode(msg.Data, msg.IV, []byte(m.Key), codec)
+	if err == nil {
+		return b, nil
+	}
+	for _, altKey := range m.AltKeys {
+		if b, altErr := decode(msg.Data, msg.IV, []byte(altKey), codec); altErr == nil {
+			return b, nil
+		}
+	}
+	return nil, err
 }
 
-func decode(encoded string, iv string, key []byte) ([]byte, error) {
+// newMAC returns the base64 encoded HMAC-SHA256 of the message IV and
+// Data, keyed by <key>.
+func newMAC(key []byte, msg *encryptedMessage) string {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(msg.IV))
+	h.Write([]byte(msg.Data))
+	return base64.URLEncoding.EncodeToString(h.Sum(nil))
+}
+
+func decode(encoded string, iv string, key []byte, codec Codec) ([]byte, error) {
 	var (
 		decodedIV []byte
 		decoded   []byte
@@ -109,26 +210,27 @@ func decode(encoded string, iv string, key []byte) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	return decompress(decoded)
+	return codec.Decompress(decoded)
 }
 
-func encode(data []byte, key []byte) (string, string, error) {
+func encode(data []byte, key []byte, codec Codec) (string, string, int, error) {
 	var (
 		b   []byte
 		iv  []byte
 		err error
 	)
-	b, err = compress(data)
+	b, err = codec.Compress(data)
 	if err != nil {
-		return "", "", err
+		return "", "", 0, err
 	}
+	size := len(b)
 	b, iv, err = encrypt(b, key)
 	if err != nil {
-		return "", "", err
+		return "", "", 0, err
 	}
 	encoded := base64.URLEncoding.EncodeToString(b)
 	encodedIV := base64.URLEncoding.EncodeToString(iv)
-	return encoded, encodedIV, nil
+	return encoded, encodedIV, size, nil
 }
 
 func decrypt(b []byte, key []byte, iv []byte) ([]byte, error) {
@@ -193,28 +295,3 @@ func newIV() []byte {
 	rand.Read(b)
 	return b
 }
-
-func compress(b []byte) ([]byte, error) {
-	var bb bytes.Buffer
-	w := zlib.NewWriter(&bb)
-	if _, err := w.Write(b); err != nil {
-		return nil, err
-	}
-	if err := w.Flush(); err != nil {
-		return nil, err
-	}
-	if err := w.Close(); err != nil {
-		return nil, err
-	}
-	return bb.Bytes(), nil
-}
-
-func decompress(b []byte) ([]byte, error) {
-	bb := bytes.NewReader(b)
-	r, err := zlib.NewReader(bb)
-	if err != nil {
-		return nil, err
-	}
-	defer r.Close()
-	return io.ReadAll(r)
-}
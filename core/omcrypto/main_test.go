@@ -0,0 +1,126 @@
+package omcrypto
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecryptWithAltKeys(t *testing.T) {
+	current := "0123456789abcdef0123456789abcdef"
+	next := "fedcba9876543210fedcba9876543210"
+	other := "00000000000000000000000000000000"
+
+	encrypt := func(key string) []byte {
+		f := &Factory{NodeName: "node1", ClusterName: "test", Key: key}
+		b, err := f.Encrypt([]byte("foo"))
+		require.NoError(t, err)
+		return b
+	}
+
+	t.Run("message encrypted with the key", func(t *testing.T) {
+		f := &Factory{NodeName: "node2", ClusterName: "test", Key: current, AltKeys: []string{next}}
+		b, nodename, err := f.DecryptWithNode(encrypt(current))
+		require.NoError(t, err)
+		require.Equal(t, "foo", string(b))
+		require.Equal(t, "node1", nodename)
+	})
+
+	t.Run("message encrypted with an alt key", func(t *testing.T) {
+		f := &Factory{NodeName: "node2", ClusterName: "test", Key: current, AltKeys: []string{other, next}}
+		b, err := f.Decrypt(encrypt(next))
+		require.NoError(t, err)
+		require.Equal(t, "foo", string(b))
+	})
+
+	t.Run("message encrypted with an unknown key", func(t *testing.T) {
+		f := &Factory{NodeName: "node2", ClusterName: "test", Key: current, AltKeys: []string{next}}
+		_, err := f.Decrypt(encrypt(other))
+		require.ErrorIs(t, err, ErrMAC)
+	})
+
+	t.Run("uncompressed message encrypted with an alt key", func(t *testing.T) {
+		// the none codec can't detect a decryption with a wrong key, so
+		// the key is selected by the message mac.
+		data := []byte(strings.Repeat("foo bar ", 10))
+		encrypter := &Factory{NodeName: "node1", ClusterName: "test", Key: next, Codec: "none"}
+		f := &Factory{NodeName: "node2", ClusterName: "test", Key: current, AltKeys: []string{other, next}}
+		for i := 0; i < 1000; i++ {
+			b, err := encrypter.Encrypt(data)
+			require.NoError(t, err)
+			decoded, err := f.Decrypt(b)
+			require.NoError(t, err)
+			require.Equal(t, data, decoded)
+		}
+	})
+
+	t.Run("message with a bad mac", func(t *testing.T) {
+		f := &Factory{NodeName: "node2", ClusterName: "test", Key: current}
+		msg := encryptedMessage{}
+		require.NoError(t, json.Unmarshal(encrypt(current), &msg))
+		msg.MAC = newMAC([]byte(other), &msg)
+		b, err := json.Marshal(msg)
+		require.NoError(t, err)
+		_, err = f.Decrypt(b)
+		require.ErrorIs(t, err, ErrMAC)
+	})
+
+	t.Run("message without mac", func(t *testing.T) {
+		f := &Factory{NodeName: "node2", ClusterName: "test", Key: current, AltKeys: []string{next}}
+		msg := encryptedMessage{}
+		require.NoError(t, json.Unmarshal(encrypt(next), &msg))
+		msg.MAC = ""
+		b, err := json.Marshal(msg)
+		require.NoError(t, err)
+		decoded, err := f.Decrypt(b)
+		require.NoError(t, err, "the messages of the older agents are decoded")
+		require.Equal(t, "foo", string(decoded))
+
+		encrypter := &Factory{NodeName: "node1", ClusterName: "test", Key: current, Codec: "none"}
+		b, err = encrypter.Encrypt([]byte("foo"))
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(b, &msg))
+		msg.MAC = ""
+		b, err = json.Marshal(msg)
+		require.NoError(t, err)
+		_, err = f.Decrypt(b)
+		require.ErrorIs(t, err, ErrMAC, "a non default codec message needs a mac")
+	})
+}
+
+func TestEncryptWithCodec(t *testing.T) {
+	key := "0123456789abcdef0123456789abcdef"
+	data := []byte(strings.Repeat("foo bar ", 100))
+	for _, codec := range Codecs() {
+		t.Run(codec, func(t *testing.T) {
+			f := &Factory{NodeName: "node1", ClusterName: "test", Key: key, Codec: codec}
+			b, size, err := f.EncryptWithSize(data)
+			require.NoError(t, err)
+			if codec == "none" {
+				require.Equal(t, len(data), size)
+			} else {
+				require.Less(t, size, len(data))
+			}
+			msg := encryptedMessage{}
+			require.NoError(t, json.Unmarshal(b, &msg))
+			if codec == DefaultCodec {
+				require.Empty(t, msg.Compression, "the default codec must not be announced")
+			} else {
+				require.Equal(t, codec, msg.Compression)
+			}
+
+			decrypter := &Factory{NodeName: "node2", ClusterName: "test", Key: key}
+			decoded, err := decrypter.Decrypt(b)
+			require.NoError(t, err)
+			require.Equal(t, data, decoded)
+		})
+	}
+
+	t.Run("unsupported codec", func(t *testing.T) {
+		f := &Factory{NodeName: "node1", ClusterName: "test", Key: key, Codec: "foo"}
+		_, err := f.Encrypt(data)
+		require.ErrorContains(t, err, "unsupported compression foo")
+	})
+}
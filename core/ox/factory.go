@@ -23,6 +23,36 @@ func newCmdAll() *cobra.Command {
 	}
 }
 
+func newCmdApply() *cobra.Command {
+	var options commands.CmdApply
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "make the objects match a set of object configurations",
+		Long: `Create, update and optionally delete objects so they match the object
+configurations of the files and directories passed with --file.
+
+The ini formatted files are named after the object path: <name>.conf,
+<kind>/<name>.conf or <namespace>/<kind>/<name>.conf. The json and yaml
+formatted files contain either a single object configuration with a
+metadata section, or a single object configuration named after the file,
+or a map of object configurations indexed by path.
+
+All the changes are planned and applied by the daemon in a single api
+call. With --dry-run, the changes are only displayed as diffs.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagDryRun(flags, &options.DryRun)
+	addFlagCreateNamespace(flags, &options.Namespace)
+	flags.StringArrayVarP(&options.Files, "file", "f", nil, "An object configuration file or a directory of object configuration files. Use - to read a json formatted configuration from stdin. Can be repeated.")
+	flags.BoolVar(&options.Prune, "prune", false, "Delete the objects of the applied namespaces not defined by the applied configurations.")
+	flags.StringVar(&options.PruneSelector, "prune-selector", "", "Delete the objects selected by this expression and not defined by the applied configurations, instead of the objects of the applied namespaces.")
+	return cmd
+}
+
 func newCmdCcfg() *cobra.Command {
 	return &cobra.Command{
 		Use:   "ccfg",
@@ -165,6 +195,25 @@ func newCmdClusterFreeze() *cobra.Command {
 	return cmd
 }
 
+func newCmdClusterRebalance() *cobra.Command {
+	var options commands.CmdClusterRebalance
+	cmd := &cobra.Command{
+		Use:   "rebalance",
+		Short: "move the non-optimally placed failover objects to their preferred nodes",
+		Long:  "Plan the giveback of the failover objects in non-optimal placement state, parents before children and by ascending priority value, and apply the plan with --apply. Frozen objects are not moved.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagDryRun(flags, &options.DryRun)
+	flags.BoolVar(&options.Apply, "apply", false, "Apply the rebalance plan.")
+	flags.IntVar(&options.MaxParallel, "max-parallel", 1, "The maximum number of concurrent moves.")
+	cmd.MarkFlagsMutuallyExclusive("dry-run", "apply")
+	return cmd
+}
+
 func newCmdClusterLogs() *cobra.Command {
 	var options commands.CmdClusterLogs
 	cmd := &cobra.Command{
@@ -1662,6 +1711,25 @@ func newCmdObjectClear(kind string) *cobra.Command {
 	return cmd
 }
 
+func newCmdObjectClone(kind string) *cobra.Command {
+	var options commands.CmdObjectClone
+	cmd := &cobra.Command{
+		Use:   "clone",
+		Short: "create a vol with data cloned from a snapshot of the selected vol",
+		Long:  "The clone is configured like the source vol. If --snapshot is not set, a new snapshot of the source vol data is created and used as the clone origin.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(selectorFlag, kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagNodeSelector(flags, &options.NodeSelector)
+	flags.StringVar(&options.To, "to", "", "The path of the vol object to create.")
+	flags.StringVar(&options.Snapshot, "snapshot", "", "The name of the snapshot to clone.")
+	cmd.MarkFlagRequired("to")
+	return cmd
+}
+
 func newCmdObjectPrint(kind string) *cobra.Command {
 	return &cobra.Command{
 		Use:     "print",
@@ -1702,6 +1770,14 @@ func newCmdObjectSync(kind string) *cobra.Command {
 	}
 }
 
+func newCmdObjectSnapshot(kind string) *cobra.Command {
+	return &cobra.Command{
+		Use:     "snapshot",
+		Short:   "volume data snapshot command group",
+		Aliases: []string{"snap"},
+	}
+}
+
 func newCmdObjectCollector(kind string) *cobra.Command {
 	return &cobra.Command{
 		Use:     "collector",
@@ -1819,6 +1895,90 @@ func newCmdObjectComplianceShow(kind string) *cobra.Command {
 	}
 }
 
+func newCmdObjectConfig(kind string) *cobra.Command {
+	return &cobra.Command{
+		Use:     "config",
+		Short:   "object configuration history management commands",
+		Aliases: []string{"conf"},
+	}
+}
+
+func newCmdObjectConfigDiff(kind string) *cobra.Command {
+	var options commands.CmdObjectConfigDiff
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "show the changes from a past configuration revision to the installed configuration or to another revision",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(selectorFlag, kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	flags.IntVar(&options.Revision, "revision", 0, "the configuration revision to compare")
+	flags.IntVar(&options.Against, "against", 0, "the configuration revision to compare to, instead of the installed configuration")
+	cmd.MarkFlagRequired("revision")
+	return cmd
+}
+
+func newCmdObjectConfigHistory(kind string) *cobra.Command {
+	var options commands.CmdObjectConfigHistory
+	cmd := &cobra.Command{
+		Use:     "history",
+		Short:   "list the past configuration revisions kept by the daemon",
+		Aliases: []string{"hist"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(selectorFlag, kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	return cmd
+}
+
+func newCmdObjectConfigRevert(kind string) *cobra.Command {
+	var options commands.CmdObjectConfigRevert
+	cmd := &cobra.Command{
+		Use:   "revert",
+		Short: "install a past configuration revision",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(selectorFlag, kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	flags.IntVar(&options.Revision, "revision", 0, "the configuration revision to install")
+	cmd.MarkFlagRequired("revision")
+	return cmd
+}
+
+func newCmdObjectTemplate(kind string) *cobra.Command {
+	return &cobra.Command{
+		Use:     "template",
+		Short:   "object configuration template commands",
+		Aliases: []string{"tpl"},
+	}
+}
+
+func newCmdObjectTemplateUpgrade(kind string) *cobra.Command {
+	var options commands.CmdObjectTemplateUpgrade
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "render again the object configuration from its template",
+		Long: "Render again the object configuration from the template and parameters recorded" +
+			" in its DEFAULT.template and DEFAULT.template_params keywords, and install it." +
+			" The --param values are merged into the recorded parameters." +
+			" The changes made to the object configuration since the last rendering are lost.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(selectorFlag, kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagDryRun(flags, &options.DryRun)
+	addFlagTemplateParams(flags, &options.Params)
+	return cmd
+}
+
 func newCmdObjectEdit(kind string) *cobra.Command {
 	var optionsGlobal commands.OptsGlobal
 	var optionsConfig commands.CmdObjectEditConfig
@@ -2127,6 +2287,8 @@ func newCmdObjectCreate(kind string) *cobra.Command {
 	addFlagCreateForce(flags, &options.Force)
 	addFlagCreateNamespace(flags, &options.Namespace)
 	addFlagCreateRestore(flags, &options.Restore)
+	addFlagCreateTemplate(flags, &options.Template)
+	addFlagTemplateParams(flags, &options.Params)
 	addFlagKeywords(flags, &options.Keywords)
 	addFlagEnv(flags, &options.Env)
 	addFlagInteractive(flags, &options.Interactive)
@@ -2175,6 +2337,8 @@ func newCmdObjectDeploy(kind string) *cobra.Command {
 	addFlagCreateForce(flags, &options.Force)
 	addFlagCreateNamespace(flags, &options.Namespace)
 	addFlagCreateRestore(flags, &options.Restore)
+	addFlagCreateTemplate(flags, &options.Template)
+	addFlagTemplateParams(flags, &options.Params)
 	addFlagKeywords(flags, &options.Keywords)
 	addFlagEnv(flags, &options.Env)
 	addFlagInteractive(flags, &options.Interactive)
@@ -2733,6 +2897,75 @@ func newCmdObjectSetUnprovisioned(kind string) *cobra.Command {
 	return cmd
 }
 
+func newCmdObjectSnapshotCreate(kind string) *cobra.Command {
+	var options commands.CmdObjectSnapshotCreate
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "create a snapshot of the volume data",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(selectorFlag, kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagNodeSelector(flags, &options.NodeSelector)
+	flags.StringVar(&options.Name, "name", "", "The snapshot name. Defaults to a name derived from the current time.")
+	return cmd
+}
+
+func newCmdObjectSnapshotDelete(kind string) *cobra.Command {
+	var options commands.CmdObjectSnapshotDelete
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Short:   "delete a snapshot of the volume data",
+		Aliases: []string{"del", "rm"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(selectorFlag, kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagNodeSelector(flags, &options.NodeSelector)
+	flags.StringVar(&options.Name, "name", "", "The snapshot name.")
+	cmd.MarkFlagRequired("name")
+	return cmd
+}
+
+func newCmdObjectSnapshotLs(kind string) *cobra.Command {
+	var options commands.CmdObjectSnapshotLs
+	cmd := &cobra.Command{
+		Use:     "ls",
+		Short:   "list the snapshots of the volume data",
+		Aliases: []string{"list"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(selectorFlag, kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagNodeSelector(flags, &options.NodeSelector)
+	return cmd
+}
+
+func newCmdObjectSnapshotRollback(kind string) *cobra.Command {
+	var options commands.CmdObjectSnapshotRollback
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "restore the volume data to the content of a snapshot",
+		Long:  "The instance must be stopped, unless --force is set.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(selectorFlag, kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagNodeSelector(flags, &options.NodeSelector)
+	addFlagForce(flags, &options.Force)
+	flags.StringVar(&options.Name, "name", "", "The snapshot name.")
+	cmd.MarkFlagRequired("name")
+	return cmd
+}
+
 func newCmdObjectShutdown(kind string) *cobra.Command {
 	var options commands.CmdObjectShutdown
 	cmd := &cobra.Command{
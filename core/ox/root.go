@@ -4,6 +4,7 @@ import (
 	// Necessary to use go:embed
 	_ "embed"
 
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -14,6 +15,7 @@ import (
 	"github.com/opensvc/om3/core/env"
 	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/util/tracing"
 	"github.com/opensvc/om3/util/version"
 )
 
@@ -117,7 +119,12 @@ func setExecuteArgs(args []string) {
 //	ExecuteArgs([]string{"mysvc*", "ls"})
 func ExecuteArgs(args []string) {
 	setExecuteArgs(args)
-	if err := root.Execute(); err != nil {
+	if err := tracing.Start(root.Use); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	err := root.Execute()
+	_ = tracing.Stop(context.Background())
+	if err != nil {
 		os.Exit(1)
 	}
 }
@@ -6,13 +6,16 @@ func init() {
 	cmdObject := newCmdVol()
 	cmdObjectCollector := newCmdObjectCollector(kind)
 	cmdObjectCollectorTag := newCmdObjectCollectorTag(kind)
+	cmdObjectConfig := newCmdObjectConfig(kind)
 	cmdObjectEdit := newCmdObjectEdit(kind)
 	cmdObjectInstance := newCmdObjectInstance(kind)
 	cmdObjectSet := newCmdObjectSet(kind)
+	cmdObjectTemplate := newCmdObjectTemplate(kind)
 	cmdObjectPrint := newCmdObjectPrint(kind)
 	cmdObjectPrintConfig := newCmdObjectPrintConfig(kind)
 	cmdObjectPush := newCmdObjectPush(kind)
 	cmdObjectResource := newCmdObjectResource(kind)
+	cmdObjectSnapshot := newCmdObjectSnapshot(kind)
 	cmdObjectSync := newCmdObjectSync(kind)
 	cmdObjectValidate := newCmdObjectValidate(kind)
 
@@ -21,17 +24,21 @@ func init() {
 	)
 	cmdObject.AddCommand(
 		cmdObjectCollector,
+		cmdObjectConfig,
 		cmdObjectEdit,
 		cmdObjectInstance,
 		cmdObjectPrint,
 		cmdObjectPush,
 		cmdObjectResource,
 		cmdObjectSet,
+		cmdObjectTemplate,
+		cmdObjectSnapshot,
 		cmdObjectSync,
 		cmdObjectValidate,
 		newCmdObjectAbort(kind),
 		newCmdObjectBoot(kind),
 		newCmdObjectClear(kind),
+		newCmdObjectClone(kind),
 		newCmdObjectCreate(kind),
 		newCmdObjectDelete(kind),
 		newCmdObjectEval(kind),
@@ -69,6 +76,14 @@ func init() {
 		newCmdObjectCollectorTagDetach(kind),
 		newCmdObjectCollectorTagShow(kind),
 	)
+	cmdObjectTemplate.AddCommand(
+		newCmdObjectTemplateUpgrade(kind),
+	)
+	cmdObjectConfig.AddCommand(
+		newCmdObjectConfigDiff(kind),
+		newCmdObjectConfigHistory(kind),
+		newCmdObjectConfigRevert(kind),
+	)
 	cmdObjectEdit.AddCommand(
 		newCmdObjectEditConfig(kind),
 	)
@@ -92,6 +107,12 @@ func init() {
 	cmdObjectPush.AddCommand(
 		newCmdObjectPushResourceInfo(kind),
 	)
+	cmdObjectSnapshot.AddCommand(
+		newCmdObjectSnapshotCreate(kind),
+		newCmdObjectSnapshotDelete(kind),
+		newCmdObjectSnapshotLs(kind),
+		newCmdObjectSnapshotRollback(kind),
+	)
 	cmdObjectSync.AddCommand(
 		newCmdObjectSyncFull(kind),
 		newCmdObjectSyncResync(kind),
@@ -0,0 +1,254 @@
+package oxcmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/core/xconfig"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+type (
+	CmdApply struct {
+		OptsGlobal
+		Files         []string
+		Namespace     string
+		DryRun        bool
+		Prune         bool
+		PruneSelector string
+	}
+)
+
+var (
+	// applyExtensions are the file extensions of the object configurations
+	// loaded from a directory.
+	applyExtensions = map[string]any{
+		".conf": nil,
+		".ini":  nil,
+		".json": nil,
+		".yaml": nil,
+		".yml":  nil,
+	}
+)
+
+func (t *CmdApply) Run() error {
+	if len(t.Files) == 0 {
+		return fmt.Errorf("at least one --file is required")
+	}
+	if t.PruneSelector != "" && !t.Prune {
+		return fmt.Errorf("--prune-selector requires --prune")
+	}
+	m := make(map[string]string)
+	for _, s := range t.Files {
+		if err := t.load(m, s); err != nil {
+			return err
+		}
+	}
+	body := api.PostObjectApply{
+		DryRun:  &t.DryRun,
+		Prune:   &t.Prune,
+		Objects: make([]api.ObjectApplyConfig, 0, len(m)),
+	}
+	if t.PruneSelector != "" {
+		body.PruneSelector = &t.PruneSelector
+	}
+	for p, data := range m {
+		body.Objects = append(body.Objects, api.ObjectApplyConfig{Path: p, Data: data})
+	}
+	sort.Slice(body.Objects, func(i, j int) bool { return body.Objects[i].Path < body.Objects[j].Path })
+
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	resp, err := c.PostObjectApplyWithResponse(context.Background(), body)
+	if err != nil {
+		return err
+	}
+	var pb api.Problem
+	switch resp.StatusCode() {
+	case 200:
+		result := *resp.JSON200
+		output.Renderer{
+			Output: t.Output,
+			Color:  t.Color,
+			Data:   result,
+			HumanRenderer: func() string {
+				return applyResultRender(result)
+			},
+			Colorize: rawconfig.Colorize,
+		}.Print()
+		for _, item := range result.Items {
+			if item.Error != nil {
+				return fmt.Errorf("some changes were not applied")
+			}
+		}
+		return nil
+	case 400:
+		pb = *resp.JSON400
+	case 401:
+		pb = *resp.JSON401
+	case 403:
+		pb = *resp.JSON403
+	case 500:
+		pb = *resp.JSON500
+	default:
+		return fmt.Errorf("unexpected response: %s", resp.Status())
+	}
+	return fmt.Errorf("%s", pb)
+}
+
+func applyResultRender(result api.ObjectApplyResult) string {
+	var s string
+	for _, item := range result.Items {
+		if result.DryRun && item.Diff != nil {
+			s += *item.Diff
+		}
+	}
+	for _, item := range result.Items {
+		action := string(item.Action)
+		if result.DryRun && item.Action != api.Unchanged {
+			action += " (dry run)"
+		}
+		if item.Error != nil {
+			s += fmt.Sprintf("%s: %s: %s\n", item.Path, action, rawconfig.Colorize.Error(*item.Error))
+		} else {
+			s += fmt.Sprintf("%s: %s\n", item.Path, action)
+		}
+	}
+	return s
+}
+
+// load adds to m the object configurations found in the file, directory or
+// stdin designated by s, indexed by object path.
+func (t *CmdApply) load(m map[string]string, s string) error {
+	if s == "-" || s == "/dev/stdin" || s == "stdin" {
+		b, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		return t.loadData(m, "", ".json", b)
+	}
+	info, err := os.Stat(s)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return t.loadFile(m, s, filepath.Base(s))
+	}
+	return filepath.WalkDir(s, func(filename string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if _, ok := applyExtensions[filepath.Ext(filename)]; !ok {
+			return nil
+		}
+		rel, err := filepath.Rel(s, filename)
+		if err != nil {
+			return err
+		}
+		return t.loadFile(m, filename, rel)
+	})
+}
+
+// loadFile adds to m the object configurations of the file filename. The
+// object path of ini formatted files is deduced from the file name rel,
+// relative to the applied directory: <name>.conf, <kind>/<name>.conf or
+// <namespace>/<kind>/<name>.conf.
+func (t *CmdApply) loadFile(m map[string]string, filename, rel string) error {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	ext := filepath.Ext(filename)
+	name := strings.TrimSuffix(filepath.ToSlash(rel), ext)
+	name = strings.TrimPrefix(name, "namespaces/")
+	if err := t.loadData(m, name, ext, b); err != nil {
+		return fmt.Errorf("%s: %w", filename, err)
+	}
+	return nil
+}
+
+// loadData adds to m the object configurations of b.
+//
+// The json and yaml formats are either a single object configuration with
+// a metadata section defining the object path, or a single object
+// configuration named after the file, or a map of object configurations
+// indexed by path.
+func (t *CmdApply) loadData(m map[string]string, name, ext string, b []byte) error {
+	switch ext {
+	case ".conf", ".ini":
+		return t.add(m, name, string(b))
+	case ".yaml", ".yml":
+		var err error
+		if b, err = yaml.YAMLToJSON(b); err != nil {
+			return err
+		}
+	}
+	pivot := make(Pivot)
+	if err := json.Unmarshal(b, &pivot); err != nil {
+		return err
+	}
+	if md, ok := pivot["metadata"]; ok || pivot["DEFAULT"].Data != nil {
+		// single object configuration, decoded again to keep the sections
+		// order.
+		if ok {
+			p, err := pathFromMetadata(md.Data)
+			if err != nil {
+				return err
+			}
+			name = p.String()
+		} else if name == "" {
+			return fmt.Errorf("can not deduce the object path: no metadata section")
+		}
+		c := rawconfig.New()
+		if err := json.Unmarshal(b, &c); err != nil {
+			return err
+		}
+		pivot = Pivot{name: c}
+	}
+	for p, c := range pivot {
+		b, err := xconfig.MarshalDocument(c, xconfig.FormatINI)
+		if err != nil {
+			return fmt.Errorf("%s: %w", p, err)
+		}
+		if err := t.add(m, p, string(b)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// add adds the object configuration data to m, indexed by the object path
+// parsed from s and relocated to the --namespace namespace.
+func (t *CmdApply) add(m map[string]string, s, data string) error {
+	p, err := naming.ParsePath(s)
+	if err != nil {
+		return fmt.Errorf("invalid object path %s: %w", s, err)
+	}
+	if t.Namespace != "" {
+		p.Namespace = t.Namespace
+	}
+	k := p.String()
+	if _, ok := m[k]; ok {
+		return fmt.Errorf("%s is defined more than once", k)
+	}
+	m[k] = data
+	return nil
+}
@@ -0,0 +1,68 @@
+package oxcmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/confighistory"
+	"github.com/opensvc/om3/core/objectselector"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/rawconfig"
+)
+
+type (
+	CmdObjectConfigHistory struct {
+		OptsGlobal
+	}
+
+	configRevisionItem struct {
+		Path string `json:"path"`
+		confighistory.Revision
+	}
+)
+
+func (t *CmdObjectConfigHistory) Run(selector, kind string) error {
+	mergedSelector := mergeSelector(selector, t.ObjectSelector, kind, "")
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	paths, err := objectselector.New(mergedSelector, objectselector.WithClient(c)).Expand()
+	if err != nil {
+		return err
+	}
+	l := make([]configRevisionItem, 0)
+	for _, p := range paths {
+		response, err := c.GetObjectConfigHistoryWithResponse(context.Background(), p.Namespace, p.Kind, p.Name)
+		if err != nil {
+			return err
+		}
+		switch response.StatusCode() {
+		case 200:
+			for _, rev := range response.JSON200.Items {
+				l = append(l, configRevisionItem{Path: p.String(), Revision: rev})
+			}
+		case 400:
+			return fmt.Errorf("%s: %s", p, *response.JSON400)
+		case 401:
+			return fmt.Errorf("%s: %s", p, *response.JSON401)
+		case 403:
+			return fmt.Errorf("%s: %s", p, *response.JSON403)
+		case 404:
+			return fmt.Errorf("%s: %s", p, *response.JSON404)
+		case 500:
+			return fmt.Errorf("%s: %s", p, *response.JSON500)
+		default:
+			return fmt.Errorf("%s: unexpected response: %s", p, response.Status())
+		}
+	}
+	output.Renderer{
+		DefaultOutput: "tab=OBJECT:path,REVISION:id,CREATED_AT:created_at,AUTHOR:author,NODE:node,COMMENT:comment",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          l,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return nil
+}
@@ -0,0 +1,87 @@
+package oxcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/nodeselector"
+	"github.com/opensvc/om3/core/objectselector"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+type (
+	CmdObjectSnapshotLs struct {
+		OptsGlobal
+		NodeSelector string
+	}
+)
+
+func (t *CmdObjectSnapshotLs) Run(selector, kind string) error {
+	mergedSelector := mergeSelector(selector, t.ObjectSelector, kind, "")
+	items := make(api.SnapshotItems, 0)
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	paths, err := objectselector.New(mergedSelector, objectselector.WithClient(c)).Expand()
+	if err != nil {
+		return err
+	}
+	nodeSelector := t.NodeSelector
+	if nodeSelector == "" {
+		nodeSelector = "*"
+	}
+	nodenames, err := nodeselector.New(nodeSelector, nodeselector.WithClient(c)).Expand()
+	if err != nil {
+		return err
+	}
+	var errs error
+	ctx := context.Background()
+	for _, p := range paths {
+		for _, nodename := range nodenames {
+			more, err := getInstanceSnapshots(ctx, c, nodename, p)
+			if err != nil {
+				errs = errors.Join(errs, err)
+				continue
+			}
+			items = append(items, more...)
+		}
+	}
+	output.Renderer{
+		DefaultOutput: "tab=OBJECT:path,NODE:node,NAME:name,CREATED:created,USED:used",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          api.SnapshotList{Items: items, Kind: "SnapshotList"},
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return errs
+}
+
+func getInstanceSnapshots(ctx context.Context, c *client.T, nodename string, p naming.Path) (api.SnapshotItems, error) {
+	response, err := c.GetInstanceSnapshotsWithResponse(ctx, nodename, p.Namespace, p.Kind, p.Name)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case response.JSON200 != nil:
+		return response.JSON200.Items, nil
+	case response.JSON404 != nil:
+		// no instance on this node
+		return nil, nil
+	case response.JSON400 != nil:
+		return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON400)
+	case response.JSON401 != nil:
+		return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON401)
+	case response.JSON403 != nil:
+		return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON403)
+	case response.JSON500 != nil:
+		return nil, fmt.Errorf("%s: node %s: %s", p, nodename, *response.JSON500)
+	default:
+		return nil, fmt.Errorf("%s: node %s: unexpected response: %s", p, nodename, response.Status())
+	}
+}
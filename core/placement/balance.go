@@ -0,0 +1,220 @@
+package placement
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+)
+
+type (
+	// BalanceNode describes the capacity and the current usage of a
+	// candidate node, as known from the node stats.
+	BalanceNode struct {
+		Name        string
+		MemTotalMB  uint64
+		MemAvailPct uint64
+		NumCPU      int
+		Load15M     float64
+	}
+
+	// BalanceInstance describes an instance already placed on a node,
+	// with the resource requirements declared by its object.
+	BalanceInstance struct {
+		Path  string
+		Node  string
+		MemMB uint64
+		CPU   float64
+	}
+
+	// BalanceRequest is the input of the RankBalance function.
+	BalanceRequest struct {
+		// Path is the object to place. Its own instances are not accounted
+		// in the node usages.
+		Path string
+
+		// MemMB and CPU are the resource requirements of one instance of
+		// the object to place.
+		MemMB uint64
+		CPU   float64
+
+		// Target is the number of instances of the object wanted, like
+		// the flex target. If set, the eligible candidates are capped to
+		// Target.
+		Target int
+
+		// Nodes is the list of candidate nodes, in nodes order.
+		Nodes []BalanceNode
+
+		// Placed is the list of instances already placed in the cluster.
+		Placed []BalanceInstance
+
+		// HardAffinity is the list of objects that must have an instance
+		// placed on a node for this node to be eligible.
+		HardAffinity []string
+
+		// HardAntiAffinity is the list of objects that must not have an
+		// instance placed on a node for this node to be eligible.
+		HardAntiAffinity []string
+	}
+
+	// Candidate is a node ranked by the balance policy, with the
+	// explanation of its rank.
+	Candidate struct {
+		Node string `json:"node"`
+
+		// Rank is the 1-based position of the node in the ranking. It is
+		// zero for ineligible nodes.
+		Rank int `json:"rank"`
+
+		// Eligible is false if the node violates a hard affinity rule or
+		// has not enough capacity left for the object requirements.
+		Eligible bool `json:"eligible"`
+
+		// MemPct and CPUPct are the projected node usages in percent, if
+		// an instance of the object was placed on the node. They are rounded
+		// to avoid ranking flaps on small usage variations.
+		MemPct float64 `json:"mem_pct"`
+		CPUPct float64 `json:"cpu_pct"`
+
+		// Reason is a human readable explanation of the rank.
+		Reason string `json:"reason"`
+	}
+
+	Candidates []Candidate
+)
+
+// RankBalance ranks the request candidate nodes so the cluster stays evenly
+// loaded if an instance of the object is placed on the first ones.
+//
+// The cost of a node is its projected dominant usage, ie the max of its
+// memory and cpu usages ratios after placing the object instance. The
+// usage accounts for the larger of the live usage reported by the node
+// stats and the sum of the requirements declared by the objects
+// already placed on the node.
+//
+// Nodes violating the hard affinity and hard anti affinity rules, or
+// lacking the capacity to honor the declared requirements, are ranked
+// last and flagged ineligible. So are the nodes ranked beyond the request
+// Target.
+func RankBalance(req BalanceRequest) Candidates {
+	hosted := make(map[string]map[string]bool)
+	reservedMem := make(map[string]uint64)
+	reservedCPU := make(map[string]float64)
+	for _, inst := range req.Placed {
+		if _, ok := hosted[inst.Node]; !ok {
+			hosted[inst.Node] = make(map[string]bool)
+		}
+		hosted[inst.Node][inst.Path] = true
+		if inst.Path == req.Path {
+			continue
+		}
+		reservedMem[inst.Node] += inst.MemMB
+		reservedCPU[inst.Node] += inst.CPU
+	}
+
+	cost := make(map[string]float64)
+	l := make(Candidates, len(req.Nodes))
+	for i, n := range req.Nodes {
+		c := Candidate{
+			Node:     n.Name,
+			Eligible: true,
+		}
+		reasons := make([]string, 0)
+
+		for _, p := range req.HardAffinity {
+			if !hosted[n.Name][p] {
+				c.Eligible = false
+				reasons = append(reasons, fmt.Sprintf("hard affinity with %s not satisfied", p))
+			}
+		}
+		for _, p := range req.HardAntiAffinity {
+			if hosted[n.Name][p] {
+				c.Eligible = false
+				reasons = append(reasons, fmt.Sprintf("hard anti affinity with %s not satisfied", p))
+			}
+		}
+
+		if n.MemTotalMB > 0 {
+			liveMem := n.MemTotalMB * (100 - min(n.MemAvailPct, 100)) / 100
+			usedMem := max(liveMem, reservedMem[n.Name])
+			c.MemPct = math.Round(100 * float64(usedMem+req.MemMB) / float64(n.MemTotalMB))
+			if req.MemMB > 0 && reservedMem[n.Name]+req.MemMB > n.MemTotalMB {
+				c.Eligible = false
+				reasons = append(reasons, fmt.Sprintf("not enough memory (%d/%d MB reserved)", reservedMem[n.Name], n.MemTotalMB))
+			}
+		} else if req.MemMB > 0 {
+			c.Eligible = false
+			reasons = append(reasons, "unknown memory capacity")
+		}
+
+		if n.NumCPU > 0 {
+			usedCPU := max(n.Load15M, reservedCPU[n.Name])
+			c.CPUPct = math.Round(100 * (usedCPU + req.CPU) / float64(n.NumCPU))
+			if req.CPU > 0 && reservedCPU[n.Name]+req.CPU > float64(n.NumCPU) {
+				c.Eligible = false
+				reasons = append(reasons, fmt.Sprintf("not enough cpu (%.2f/%d reserved)", reservedCPU[n.Name], n.NumCPU))
+			}
+		} else if req.CPU > 0 {
+			c.Eligible = false
+			reasons = append(reasons, "unknown cpu capacity")
+		}
+
+		cost[n.Name] = math.Max(c.MemPct, c.CPUPct)
+		if c.Eligible {
+			reasons = append(reasons, fmt.Sprintf("projected usage mem %.0f%% cpu %.0f%%", c.MemPct, c.CPUPct))
+		}
+		c.Reason = strings.Join(reasons, ", ")
+		l[i] = c
+	}
+
+	sort.SliceStable(l, func(i, j int) bool {
+		if l[i].Eligible != l[j].Eligible {
+			return l[i].Eligible
+		}
+		return cost[l[i].Node] < cost[l[j].Node]
+	})
+
+	for i := range l {
+		if !l[i].Eligible {
+			continue
+		}
+		if req.Target > 0 && i >= req.Target {
+			l[i].Eligible = false
+			l[i].Reason = fmt.Sprintf("beyond the target of %d instances, %s", req.Target, l[i].Reason)
+			continue
+		}
+		l[i].Rank = i + 1
+	}
+	return l
+}
+
+// Get returns the candidate of the node, and false if the node is not
+// in the list.
+func (t Candidates) Get(node string) (Candidate, bool) {
+	i := slices.IndexFunc(t, func(c Candidate) bool { return c.Node == node })
+	if i < 0 {
+		return Candidate{}, false
+	}
+	return t[i], true
+}
+
+// Eligible returns the names of the eligible candidate nodes, in rank order.
+func (t Candidates) Eligible() []string {
+	l := make([]string, 0, len(t))
+	for _, c := range t {
+		if c.Eligible {
+			l = append(l, c.Node)
+		}
+	}
+	return l
+}
+
+// String returns a human readable explanation of the candidate rank.
+func (t Candidate) String() string {
+	if !t.Eligible {
+		return "ineligible: " + t.Reason
+	}
+	return fmt.Sprintf("rank %d: %s", t.Rank, t.Reason)
+}
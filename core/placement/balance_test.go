@@ -0,0 +1,94 @@
+package placement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRankBalance(t *testing.T) {
+	nodes := []BalanceNode{
+		{Name: "n1", MemTotalMB: 8192, MemAvailPct: 90, NumCPU: 4},
+		{Name: "n2", MemTotalMB: 8192, MemAvailPct: 90, NumCPU: 4},
+		{Name: "n3", MemTotalMB: 8192, MemAvailPct: 90, NumCPU: 4},
+	}
+
+	t.Run("prefers the node with the lowest reservations", func(t *testing.T) {
+		l := RankBalance(BalanceRequest{
+			Path:  "svc1",
+			MemMB: 1024,
+			Nodes: nodes,
+			Placed: []BalanceInstance{
+				{Path: "svc2", Node: "n1", MemMB: 4096},
+				{Path: "svc3", Node: "n2", MemMB: 2048},
+			},
+		})
+		assert.Equal(t, []string{"n3", "n2", "n1"}, l.Eligible())
+		c, ok := l.Get("n1")
+		assert.True(t, ok)
+		assert.Equal(t, 3, c.Rank)
+		assert.Equal(t, float64(63), c.MemPct)
+	})
+
+	t.Run("ignores the reservations of the object to place", func(t *testing.T) {
+		l := RankBalance(BalanceRequest{
+			Path:  "svc1",
+			MemMB: 1024,
+			Nodes: nodes,
+			Placed: []BalanceInstance{
+				{Path: "svc1", Node: "n1", MemMB: 4096},
+			},
+		})
+		assert.Equal(t, []string{"n1", "n2", "n3"}, l.Eligible())
+	})
+
+	t.Run("excludes nodes without enough capacity", func(t *testing.T) {
+		l := RankBalance(BalanceRequest{
+			Path:  "svc1",
+			CPU:   2,
+			Nodes: nodes,
+			Placed: []BalanceInstance{
+				{Path: "svc2", Node: "n2", CPU: 3},
+			},
+		})
+		assert.Equal(t, []string{"n1", "n3"}, l.Eligible())
+		c, _ := l.Get("n2")
+		assert.False(t, c.Eligible)
+		assert.Equal(t, 0, c.Rank)
+		assert.Contains(t, c.Reason, "not enough cpu")
+	})
+
+	t.Run("caps the eligible nodes to the target", func(t *testing.T) {
+		l := RankBalance(BalanceRequest{
+			Path:   "svc1",
+			MemMB:  1024,
+			Target: 2,
+			Nodes:  nodes,
+			Placed: []BalanceInstance{
+				{Path: "svc2", Node: "n1", MemMB: 4096},
+			},
+		})
+		assert.Equal(t, []string{"n2", "n3"}, l.Eligible())
+		c, _ := l.Get("n1")
+		assert.False(t, c.Eligible)
+		assert.Equal(t, 0, c.Rank)
+		assert.Contains(t, c.Reason, "beyond the target of 2 instances")
+	})
+
+	t.Run("honors hard affinity and hard anti affinity", func(t *testing.T) {
+		l := RankBalance(BalanceRequest{
+			Path:             "svc1",
+			Nodes:            nodes,
+			HardAffinity:     []string{"db"},
+			HardAntiAffinity: []string{"web"},
+			Placed: []BalanceInstance{
+				{Path: "db", Node: "n2"},
+				{Path: "db", Node: "n3"},
+				{Path: "web", Node: "n3"},
+			},
+		})
+		assert.Equal(t, []string{"n2"}, l.Eligible())
+		c, _ := l.Get("n1")
+		assert.Equal(t, "ineligible: hard affinity with db not satisfied", c.String())
+	})
+}
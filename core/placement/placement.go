@@ -32,6 +32,8 @@ const (
 	Spread
 	// Score is the policy where node priorities are assigned to nodes based on score. The higher the score, the higher the priority.
 	Score
+	// Balance is the policy where node priorities are assigned to nodes based on their projected memory and cpu usages, accounting the requirements of all placed objects. The lower the usage, the higher the priority.
+	Balance
 )
 
 const (
@@ -51,6 +53,7 @@ var (
 		Shift:      "shift",
 		Spread:     "spread",
 		Score:      "score",
+		Balance:    "balance",
 	}
 
 	policyToID = map[string]Policy{
@@ -62,6 +65,7 @@ var (
 		"shift":       Shift,
 		"spread":      Spread,
 		"score":       Score,
+		"balance":     Balance,
 	}
 
 	stateToString = map[State]string{
@@ -0,0 +1,169 @@
+package pool
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/opensvc/om3/core/volaccess"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/key"
+	"github.com/opensvc/om3/util/plog"
+)
+
+type (
+	// Snapshot is a point-in-time copy of a pool volume data.
+	Snapshot struct {
+		Name    string    `json:"name"`
+		Created time.Time `json:"created"`
+
+		// Used is the space consumed by the snapshot, in bytes.
+		Used int64 `json:"used"`
+	}
+	Snapshots []Snapshot
+
+	// Snapshotter is implemented by the pool drivers able to snapshot and
+	// clone the volumes they allocate. The volume is identified by the
+	// same DiskName() used to allocate its data.
+	Snapshotter interface {
+		// CreateSnapshot creates the snapshot <name> of the volume data.
+		CreateSnapshot(vol Volumer, name string) error
+
+		// DeleteSnapshot deletes the snapshot <name> of the volume data.
+		DeleteSnapshot(vol Volumer, name string) error
+
+		// RollbackSnapshot restores the volume data to the content of the
+		// snapshot <name>. The volume must not be in use.
+		RollbackSnapshot(vol Volumer, name string) error
+
+		// Snapshots returns the snapshots of the volume data, oldest first.
+		Snapshots(vol Volumer) (Snapshots, error)
+
+		// CloneSnapshot creates the data of the clone volume from the
+		// snapshot <name> of the volume data. The clone volume is
+		// already configured when this function is called.
+		CloneSnapshot(vol Volumer, name string, clone Volumer) error
+	}
+
+	logger interface {
+		Log() *plog.Logger
+	}
+)
+
+var (
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+	ErrSnapshotExists   = errors.New("snapshot already exists")
+
+	snapshotNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+)
+
+// ValidateSnapshotName returns an error if the snapshot name is not usable
+// by all the snapshotter drivers.
+func ValidateSnapshotName(name string) error {
+	if !snapshotNameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid snapshot name '%s': expected alphanumeric characters, '_', '.' or '-'", name)
+	}
+	return nil
+}
+
+// NewSnapshotName returns a snapshot name derived from the current time.
+func NewSnapshotName(prefix string) string {
+	return prefix + time.Now().Format("20060102150405")
+}
+
+// VolumeLogger returns the logger of the volume if it has one, or the
+// default logger.
+func VolumeLogger(vol Volumer) *plog.Logger {
+	if i, ok := vol.(logger); ok {
+		if l := i.Log(); l != nil {
+			return l
+		}
+	}
+	return plog.NewDefaultLogger()
+}
+
+// VolumeDiskName returns the name of the array disk allocated by the
+// volume disk#0 resource. Like the resource does, the name of a disk not
+// shared by the volume nodes is suffixed by the local hostname.
+func VolumeDiskName(p Pooler, vol Volumer) string {
+	config := vol.Config()
+	name := config.GetString(key.New("disk#0", "name"))
+	if config.GetBool(key.New("disk#0", "shared")) {
+		return name
+	}
+	return name + p.Separator() + hostname.Hostname()
+}
+
+// Has returns true if the list contains a snapshot named <name>.
+func (t Snapshots) Has(name string) bool {
+	for _, s := range t {
+		if s.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (t Snapshots) Len() int {
+	return len(t)
+}
+
+func (t Snapshots) Less(i, j int) bool {
+	if t[i].Created.Equal(t[j].Created) {
+		return t[i].Name < t[j].Name
+	}
+	return t[i].Created.Before(t[j].Created)
+}
+
+func (t Snapshots) Swap(i, j int) {
+	t[i], t[j] = t[j], t[i]
+}
+
+// CloneVolume configures the clone volume like the volume, and creates its
+// data from the snapshot <name> of the volume data.
+//
+// Unless the pool is shared, the clone data is only available on the local
+// node, so the clone volume nodes are restricted to the local node.
+func CloneVolume(p Pooler, vol Volumer, name string, clone Volumer) error {
+	s, ok := p.(Snapshotter)
+	if !ok {
+		return fmt.Errorf("pool %s does not support snapshots", p.Name())
+	}
+	config := vol.Config()
+	var size int64
+	if i := config.GetSize(key.New("DEFAULT", "size")); i != nil {
+		size = *i
+	}
+	acs, err := volaccess.Parse(config.GetString(key.New("DEFAULT", "access")))
+	if err != nil {
+		return err
+	}
+	shared := config.GetBool(key.New("DEFAULT", "shared"))
+	format := false
+	for _, section := range config.SectionStrings() {
+		if strings.HasPrefix(section, "fs#") {
+			format = true
+			break
+		}
+	}
+	var nodes []string
+	if HasCapability(p, "shared") {
+		nodes = config.GetStrings(key.New("DEFAULT", "nodes"))
+	} else {
+		nodes = []string{hostname.Hostname()}
+	}
+	env := make([]string, 0)
+	for _, k := range p.Mappings() {
+		if v := config.GetString(key.Parse(k)); v != "" {
+			env = append(env, k+"="+v)
+		}
+	}
+	sort.Strings(env)
+	if err := ConfigureVolume(p, clone, size, format, acs, shared, nodes, env); err != nil {
+		return err
+	}
+	return s.CloneSnapshot(vol, name, clone)
+}
@@ -0,0 +1,31 @@
+package pool
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSnapshotName(t *testing.T) {
+	for _, name := range []string{"a", "daily", "20240102150405", "clone-20240102150405", "v1.2_rc"} {
+		require.NoErrorf(t, ValidateSnapshotName(name), "name %q", name)
+	}
+	for _, name := range []string{"", "-a", ".a", "a b", "a/b", "a@b", "a:b"} {
+		require.Errorf(t, ValidateSnapshotName(name), "name %q", name)
+	}
+}
+
+func TestSnapshotsSort(t *testing.T) {
+	now := time.Now()
+	l := Snapshots{
+		{Name: "c", Created: now},
+		{Name: "b", Created: now},
+		{Name: "a", Created: now.Add(time.Second)},
+	}
+	sort.Sort(l)
+	require.Equal(t, []string{"b", "c", "a"}, []string{l[0].Name, l[1].Name, l[2].Name})
+	require.True(t, l.Has("a"))
+	require.False(t, l.Has("d"))
+}
@@ -65,13 +65,16 @@ func (t T) render(colorize bool) string {
 		if section == "metadata" {
 			continue
 		}
+		data, _ := t.Data.Get(section)
+		omap, ok := data.(orderedmap.OrderedMap)
+		if !ok {
+			continue
+		}
 		s := fmt.Sprintf("[%s]\n", section)
 		if colorize {
 			s = Colorize.Primary(s)
 		}
 		buff += s
-		data, _ := t.Data.Get(section)
-		omap := data.(orderedmap.OrderedMap)
 		for _, k := range omap.Keys() {
 			v, _ := omap.Get(k)
 			if k == "comment" {
@@ -118,7 +121,7 @@ func renderKey(k string, v any, colorize bool) string {
 	case []string:
 		vs = strings.Join(o, " ")
 	case float64:
-		vs = fmt.Sprintf("%f", o)
+		vs = strconv.FormatFloat(o, 'f', -1, 64)
 	case int, uint, int8, uint8, int64, uint64:
 		vs = fmt.Sprintf("%d", o)
 	case bool:
@@ -0,0 +1,241 @@
+// Package rebalance computes the plan of moves needed to restore the
+// optimal placement of the cluster failover objects.
+//
+// A move is a giveback orchestration of a non-optimally placed failover
+// object: its instance is stopped on the node where it runs and started on
+// the node elected by its placement policy.
+//
+// The moves are grouped in waves. The moves of a wave can run in parallel,
+// and a wave starts when all the moves of the previous wave are ended. An
+// object is planned in a wave after the waves of its parents, so parents
+// are moved first, and before the waves of its children. A relation can be
+// declared by the parent, with children, or by the child, with parents.
+// In a wave, the moves are sorted by ascending priority value.
+package rebalance
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/placement"
+	"github.com/opensvc/om3/core/priority"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/core/topology"
+)
+
+type (
+	// Move describes the relocation of a failover object from the nodes
+	// where it is running to the nodes where its placement policy wants
+	// it to run.
+	Move struct {
+		Path     naming.Path `json:"path"`
+		From     []string    `json:"from"`
+		To       []string    `json:"to"`
+		Priority priority.T  `json:"priority"`
+		Wave     int         `json:"wave"`
+	}
+
+	// Plan is the ordered list of moves of a rebalance.
+	Plan struct {
+		ID          uuid.UUID `json:"id"`
+		MaxParallel int       `json:"max_parallel"`
+		Moves       []Move    `json:"moves"`
+	}
+
+	// Candidate describes a non-optimally placed object, as input of the
+	// plan computation.
+	Candidate struct {
+		Path     naming.Path
+		Parents  naming.Paths
+		Children naming.Paths
+		Priority priority.T
+		From     []string
+		To       []string
+	}
+)
+
+// NewPlan returns the plan of moves needed to rebalance the non-optimally
+// placed failover objects known by the object, instance status and
+// instance monitor data holders.
+func NewPlan(maxParallel int) Plan {
+	return NewPlanFromCandidates(Candidates(), maxParallel)
+}
+
+// Candidates returns the non-optimally placed failover objects that can
+// be moved.
+//
+// Frozen objects and objects without known destination are ignored.
+func Candidates() []Candidate {
+	l := make([]Candidate, 0)
+	for _, e := range object.StatusData.GetAll() {
+		objStatus := e.Value
+		if objStatus == nil {
+			continue
+		}
+		if objStatus.Topology != topology.Failover {
+			continue
+		}
+		if objStatus.PlacementState != placement.NonOptimal {
+			continue
+		}
+		if objStatus.Frozen != "thawed" {
+			continue
+		}
+		c := Candidate{
+			Path:     e.Path,
+			Priority: objStatus.Priority,
+		}
+		instStatuses := instance.StatusData.GetByPath(e.Path)
+		for nodename, instMonitor := range instance.MonitorData.GetByPath(e.Path) {
+			instStatus, ok := instStatuses[nodename]
+			if !ok || instMonitor == nil || instStatus == nil {
+				continue
+			}
+			switch {
+			case instMonitor.IsHALeader && !instStatus.Avail.Is(status.Up, status.NotApplicable):
+				c.To = append(c.To, nodename)
+			case !instMonitor.IsHALeader && instStatus.Avail.Is(status.Up, status.Warn):
+				c.From = append(c.From, nodename)
+			}
+		}
+		if len(c.To) == 0 {
+			continue
+		}
+		for _, instConfig := range instance.ConfigData.GetByPath(e.Path) {
+			if instConfig == nil {
+				continue
+			}
+			for _, relation := range instConfig.Parents {
+				if p, err := relation.Path(); err == nil {
+					c.Parents = append(c.Parents, p)
+				}
+			}
+			for _, relation := range instConfig.Children {
+				if p, err := relation.Path(); err == nil {
+					c.Children = append(c.Children, p)
+				}
+			}
+			break
+		}
+		sort.Strings(c.From)
+		sort.Strings(c.To)
+		l = append(l, c)
+	}
+	return l
+}
+
+// NewPlanFromCandidates returns the plan of moves of the candidates, with
+// the parents of an object moved in earlier waves than the object, and
+// its children moved in later waves.
+func NewPlanFromCandidates(candidates []Candidate, maxParallel int) Plan {
+	if maxParallel < 1 {
+		maxParallel = 1
+	}
+	plan := Plan{
+		ID:          uuid.New(),
+		MaxParallel: maxParallel,
+		Moves:       make([]Move, 0, len(candidates)),
+	}
+	byPath := make(map[naming.Path]Candidate)
+	for _, c := range candidates {
+		byPath[c.Path] = c
+	}
+
+	// after[p] is the set of the moved objects p must be moved after: its
+	// parents, and the objects declaring p as a child. A relation declared
+	// on both sides is a single edge.
+	after := make(map[naming.Path]map[naming.Path]bool)
+	addEdge := func(child, parent naming.Path) {
+		if _, ok := byPath[parent]; !ok {
+			// relation not moved
+			return
+		}
+		if _, ok := byPath[child]; !ok {
+			return
+		}
+		if after[child] == nil {
+			after[child] = make(map[naming.Path]bool)
+		}
+		after[child][parent] = true
+	}
+	for _, c := range candidates {
+		for _, parent := range c.Parents {
+			addEdge(c.Path, parent)
+		}
+		for _, child := range c.Children {
+			addEdge(child, c.Path)
+		}
+	}
+
+	waves := make(map[naming.Path]int)
+	var waveOf func(p naming.Path, visiting map[naming.Path]bool) int
+	waveOf = func(p naming.Path, visiting map[naming.Path]bool) int {
+		if wave, ok := waves[p]; ok {
+			return wave
+		}
+		if visiting[p] {
+			// relation loop: break it here
+			return 0
+		}
+		visiting[p] = true
+		defer delete(visiting, p)
+		wave := 1
+		deps := make([]naming.Path, 0, len(after[p]))
+		for dep := range after[p] {
+			deps = append(deps, dep)
+		}
+		sort.Slice(deps, func(i, j int) bool { return deps[i].String() < deps[j].String() })
+		for _, dep := range deps {
+			wave = max(wave, waveOf(dep, visiting)+1)
+		}
+		waves[p] = wave
+		return wave
+	}
+
+	sorted := append([]Candidate{}, candidates...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Path.String() < sorted[j].Path.String() })
+	for _, c := range sorted {
+		plan.Moves = append(plan.Moves, Move{
+			Path:     c.Path,
+			From:     append([]string{}, c.From...),
+			To:       append([]string{}, c.To...),
+			Priority: c.Priority,
+			Wave:     waveOf(c.Path, make(map[naming.Path]bool)),
+		})
+	}
+	sort.SliceStable(plan.Moves, func(i, j int) bool {
+		a, b := plan.Moves[i], plan.Moves[j]
+		switch {
+		case a.Wave != b.Wave:
+			return a.Wave < b.Wave
+		case a.Priority != b.Priority:
+			return a.Priority < b.Priority
+		default:
+			return a.Path.String() < b.Path.String()
+		}
+	})
+	return plan
+}
+
+// Waves returns the plan moves grouped by wave, in wave order.
+func (t Plan) Waves() [][]Move {
+	l := make([][]Move, 0)
+	for _, move := range t.Moves {
+		if n := len(l); n > 0 && l[n-1][0].Wave == move.Wave {
+			l[n-1] = append(l[n-1], move)
+		} else {
+			l = append(l, []Move{move})
+		}
+	}
+	return l
+}
+
+// Has returns true if the plan has a move for the object path p.
+func (t Plan) Has(p naming.Path) bool {
+	return slices.ContainsFunc(t.Moves, func(move Move) bool { return move.Path == p })
+}
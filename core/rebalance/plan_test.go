@@ -0,0 +1,88 @@
+package rebalance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opensvc/om3/core/naming"
+)
+
+func TestNewPlanFromCandidates(t *testing.T) {
+	parse := func(s string) naming.Path {
+		p, err := naming.ParsePath(s)
+		assert.NoError(t, err)
+		return p
+	}
+	db, app, web, other := parse("db"), parse("app"), parse("web"), parse("other")
+	candidates := []Candidate{
+		{Path: web, Parents: naming.Paths{app}, Priority: 10, From: []string{"n1"}, To: []string{"n2"}},
+		{Path: other, Priority: 50, From: []string{"n1"}, To: []string{"n2"}},
+		{Path: app, Parents: naming.Paths{db, parse("notmoved")}, Priority: 50, From: []string{"n1"}, To: []string{"n2"}},
+		{Path: db, Priority: 60, From: []string{"n1"}, To: []string{"n3"}},
+	}
+
+	plan := NewPlanFromCandidates(candidates, 0)
+	assert.Equal(t, 1, plan.MaxParallel)
+
+	got := make([]string, 0)
+	for _, move := range plan.Moves {
+		got = append(got, move.Path.String())
+	}
+	assert.Equal(t, []string{"other", "db", "app", "web"}, got)
+
+	waves := plan.Waves()
+	assert.Len(t, waves, 3)
+	assert.Len(t, waves[0], 2)
+	assert.Equal(t, 3, waves[2][0].Wave)
+	assert.True(t, plan.Has(app))
+	assert.False(t, plan.Has(parse("notmoved")))
+}
+
+func TestNewPlanFromCandidatesWithChildren(t *testing.T) {
+	parse := func(s string) naming.Path {
+		p, err := naming.ParsePath(s)
+		assert.NoError(t, err)
+		return p
+	}
+	db, app, web := parse("db"), parse("app"), parse("web")
+	plan := NewPlanFromCandidates([]Candidate{
+		{Path: db, Children: naming.Paths{app}, Priority: 10},
+		{Path: app, Children: naming.Paths{web}, Priority: 20},
+		{Path: web, Priority: 30},
+	}, 2)
+
+	got := make([]string, 0)
+	for _, move := range plan.Moves {
+		got = append(got, move.Path.String())
+	}
+	assert.Equal(t, []string{"db", "app", "web"}, got, "children are moved after their parent")
+	assert.Len(t, plan.Waves(), 3)
+}
+
+func TestNewPlanFromCandidatesWithRelationDeclaredOnBothSides(t *testing.T) {
+	db, _ := naming.ParsePath("db")
+	app, _ := naming.ParsePath("app")
+	for i := 0; i < 20; i++ {
+		plan := NewPlanFromCandidates([]Candidate{
+			{Path: app, Parents: naming.Paths{db}},
+			{Path: db, Children: naming.Paths{app}},
+		}, 2)
+		got := make([]string, 0)
+		for _, move := range plan.Moves {
+			got = append(got, fmt.Sprintf("%s:%d", move.Path, move.Wave))
+		}
+		assert.Equal(t, []string{"db:1", "app:2"}, got)
+	}
+}
+
+func TestNewPlanFromCandidatesWithRelationLoop(t *testing.T) {
+	a, _ := naming.ParsePath("a")
+	b, _ := naming.ParsePath("b")
+	plan := NewPlanFromCandidates([]Candidate{
+		{Path: a, Parents: naming.Paths{b}},
+		{Path: b, Parents: naming.Paths{a}},
+	}, 2)
+	assert.Len(t, plan.Moves, 2)
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/opensvc/fcntllock"
 	"github.com/opensvc/flock"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/opensvc/om3/core/actioncontext"
 	"github.com/opensvc/om3/core/colorstatus"
@@ -34,6 +36,7 @@ import (
 	"github.com/opensvc/om3/util/plog"
 	"github.com/opensvc/om3/util/progress"
 	"github.com/opensvc/om3/util/scsi"
+	"github.com/opensvc/om3/util/tracing"
 	"github.com/opensvc/om3/util/xsession"
 )
 
@@ -794,7 +797,9 @@ func PRStart(ctx context.Context, r Driver) error {
 }
 
 // StartStandby activates a resource interfacer
-func StartStandby(ctx context.Context, r Driver) error {
+func StartStandby(ctx context.Context, r Driver) (err error) {
+	ctx, span := startSpan(ctx, r, "start standby")
+	defer func() { endSpan(span, err) }()
 	var (
 		i  any = r
 		fn func(context.Context) error
@@ -840,7 +845,9 @@ func StartStandby(ctx context.Context, r Driver) error {
 }
 
 // Start activates a resource interfacer
-func Start(ctx context.Context, r Driver) error {
+func Start(ctx context.Context, r Driver) (err error) {
+	ctx, span := startSpan(ctx, r, "start")
+	defer func() { endSpan(span, err) }()
 	var i any = r
 	s, ok := i.(starter)
 	if !ok {
@@ -934,17 +941,40 @@ func Update(ctx context.Context, r Driver) error {
 }
 
 // Shutdown deactivates a resource even if standby is true
-func Shutdown(ctx context.Context, r Driver) error {
+func Shutdown(ctx context.Context, r Driver) (err error) {
 	defer EvalStatus(ctx, r)
+	ctx, span := startSpan(ctx, r, "shutdown")
+	defer func() { endSpan(span, err) }()
 	return shutdown(ctx, r)
 }
 
 // Stop deactivates a resource
-func Stop(ctx context.Context, r Driver) error {
+func Stop(ctx context.Context, r Driver) (err error) {
 	defer EvalStatus(ctx, r)
+	ctx, span := startSpan(ctx, r, "stop")
+	defer func() { endSpan(span, err) }()
 	return stop(ctx, r)
 }
 
+// startSpan starts the span of the resource action.
+func startSpan(ctx context.Context, r Driver, action string) (context.Context, trace.Span) {
+	return tracing.StartSpan(ctx, "resource "+action,
+		attribute.String("rid", r.RID()),
+		attribute.String("driver", r.Manifest().DriverID.String()),
+		attribute.String("label", r.Label()),
+	)
+}
+
+// endSpan ends the span of the resource action. The disabled and
+// unsupported actions are not errors, only flagged as skipped.
+func endSpan(span trace.Span, err error) {
+	if errors.Is(err, ErrDisabled) || errors.Is(err, ErrActionNotSupported) {
+		span.SetAttributes(attribute.String("skipped", err.Error()))
+		err = nil
+	}
+	tracing.EndSpan(span, err)
+}
+
 // boot turns the resource to a state ready for a start after node reboot.
 func boot(ctx context.Context, r Driver) error {
 	var (
@@ -97,6 +97,12 @@ func (t Entry) GetNext() (time.Time, time.Duration, error) {
 	return sc.Next(usched.NextWithLast(t.LastRunAt))
 }
 
+// GetNextAfter returns the next run time of the entry not before tm.
+func (t Entry) GetNextAfter(tm time.Time) (time.Time, time.Duration, error) {
+	sc := usched.New(t.Schedule)
+	return sc.Next(usched.NextWithLast(t.LastRunAt), usched.NextWithTime(tm))
+}
+
 func (t Entry) RID() string {
 	k := key.Parse(t.Key)
 	return k.Section
@@ -0,0 +1,137 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	usched "github.com/opensvc/om3/util/schedule"
+)
+
+type (
+	// Window is a time range during which the orchestrations and the
+	// scheduled jobs are deferred.
+	Window struct {
+		Begin time.Time `json:"begin"`
+		End   time.Time `json:"end"`
+
+		// Key is the configuration keyword defining the window.
+		Key string `json:"key"`
+
+		// Schedule is the window definition, using the scheduler
+		// expression syntax.
+		Schedule string `json:"schedule"`
+	}
+)
+
+const (
+	// ActionMaintenanceWindow is the schedule table entry action of the
+	// maintenance windows. Such entries are informational, not jobs.
+	ActionMaintenanceWindow = "maintenance_window"
+)
+
+var (
+	// windowMaxLength caps the window end lookup, so a definition
+	// including all times does not loop forever.
+	windowMaxLength = 8 * 24 * time.Hour
+
+	// windowMaxLookahead caps the next window begin lookup.
+	windowMaxLookahead = 366 * 24 * time.Hour
+)
+
+// IsOpen returns true if tm is in the window.
+func (t Window) IsOpen(tm time.Time) bool {
+	return !t.Begin.IsZero() && !tm.Before(t.Begin) && tm.Before(t.End)
+}
+
+// String returns a human readable representation of the window.
+func (t Window) String() string {
+	if t.IsOpen(time.Now()) {
+		return fmt.Sprintf("open until %s", t.End.Format(time.RFC3339))
+	}
+	return fmt.Sprintf("next from %s to %s", t.Begin.Format(time.RFC3339), t.End.Format(time.RFC3339))
+}
+
+// NextWindow returns the window of the schedule expression s that is open
+// at tm, or the next one if none is open at tm.
+//
+// It returns a zero Window and no error if s is empty or never opens.
+func NextWindow(tm time.Time, key, s string) (Window, error) {
+	w := Window{Key: key, Schedule: s}
+	if s == "" {
+		return w, nil
+	}
+	expr := usched.New(s)
+	isOpen := func(tm time.Time) bool {
+		_, err := expr.Test(tm)
+		return err == nil
+	}
+	begin := tm
+	if !isOpen(tm) {
+		next, _, err := expr.Next(usched.NextWithTime(tm))
+		if err != nil {
+			return Window{}, err
+		}
+		if next.IsZero() || next.Sub(tm) > windowMaxLookahead || !isOpen(next) {
+			return Window{}, nil
+		}
+		begin = next
+	}
+	end, err := expr.WindowEnd(begin, begin.Add(windowMaxLength))
+	if err != nil {
+		return Window{}, err
+	}
+	w.Begin = begin
+	w.End = end
+	return w, nil
+}
+
+// NextWindowOf returns the earliest window returned by NextWindow for the
+// schedule expressions indexed by their keyword. Invalid expressions are
+// ignored and reported in the returned error.
+func NextWindowOf(tm time.Time, m map[string]string) (Window, error) {
+	var (
+		found Window
+		errs  error
+	)
+	for key, s := range m {
+		w, err := NextWindow(tm, key, s)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", key, err))
+			continue
+		}
+		switch {
+		case w.Begin.IsZero():
+		case found.Begin.IsZero(), w.Begin.Before(found.Begin):
+			found = w
+		case w.Begin.Equal(found.Begin) && w.End.After(found.End):
+			found = w
+		}
+	}
+	return found, errs
+}
+
+// Jobs returns the table entries that are schedulable jobs.
+func (t Table) Jobs() Table {
+	l := make(Table, 0, len(t))
+	for _, e := range t {
+		if e.Action == ActionMaintenanceWindow {
+			continue
+		}
+		l = append(l, e)
+	}
+	return l
+}
+
+// NewWindowEntry returns the informational schedule table entry of the
+// maintenance window defined by the keyword k with value s. The entry
+// NextRunAt is the begin of the window open at tm, or of the next one.
+func NewWindowEntry(tm time.Time, k, s string) Entry {
+	w, _ := NextWindow(tm, k, s)
+	return Entry{
+		Action:    ActionMaintenanceWindow,
+		Key:       k,
+		Schedule:  s,
+		NextRunAt: w.Begin,
+	}
+}
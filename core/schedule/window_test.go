@@ -0,0 +1,66 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextWindow(t *testing.T) {
+	// a saturday
+	tm := time.Date(2024, 1, 6, 1, 30, 0, 0, time.Local)
+
+	t.Run("open window", func(t *testing.T) {
+		w, err := NextWindow(tm, "maintenance_window", "00:00-02:00 sat")
+		require.NoError(t, err)
+		assert.True(t, w.IsOpen(tm))
+		assert.Equal(t, tm, w.Begin)
+		assert.Equal(t, time.Date(2024, 1, 6, 2, 0, 1, 0, time.Local), w.End)
+	})
+
+	t.Run("window over midnight", func(t *testing.T) {
+		w, err := NextWindow(tm, "maintenance_window", "22:00-02:00")
+		require.NoError(t, err)
+		assert.True(t, w.IsOpen(tm))
+		assert.Equal(t, time.Date(2024, 1, 6, 2, 0, 1, 0, time.Local), w.End)
+	})
+
+	t.Run("window limited to a day", func(t *testing.T) {
+		w, err := NextWindow(tm, "maintenance_window", "21:00-23:00 sat")
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 1, 6, 21, 0, 0, 0, time.Local), w.Begin)
+		assert.Equal(t, time.Date(2024, 1, 6, 23, 0, 1, 0, time.Local), w.End)
+	})
+
+	t.Run("window always open", func(t *testing.T) {
+		w, err := NextWindow(tm, "maintenance_window", "00:00-23:59:59")
+		require.NoError(t, err)
+		assert.Equal(t, tm.Add(windowMaxLength), w.End)
+	})
+
+	t.Run("next window", func(t *testing.T) {
+		w, err := NextWindow(tm, "maintenance_window", "03:00-04:00")
+		require.NoError(t, err)
+		assert.False(t, w.IsOpen(tm))
+		assert.Equal(t, time.Date(2024, 1, 6, 3, 0, 0, 0, time.Local), w.Begin)
+		assert.True(t, w.IsOpen(w.Begin.Add(30*time.Minute)))
+	})
+
+	t.Run("undefined window", func(t *testing.T) {
+		w, err := NextWindow(tm, "maintenance_window", "")
+		require.NoError(t, err)
+		assert.True(t, w.Begin.IsZero())
+		assert.False(t, w.IsOpen(tm))
+	})
+
+	t.Run("earliest of many", func(t *testing.T) {
+		w, err := NextWindowOf(tm, map[string]string{
+			"node.maintenance_window": "03:00-04:00",
+			"maintenance_window":      "22:00-23:00",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "node.maintenance_window", w.Key)
+	})
+}
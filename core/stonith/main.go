@@ -0,0 +1,137 @@
+/*
+Package stonith implements the node fencing driver group.
+
+A stonith driver is configured in a node.conf stonith#<peer> section, and
+is used to power off the <peer> node when it is declared lost by a node
+that still holds the quorum, so the failover objects it was running can be
+safely taken over.
+*/
+package stonith
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/core/xconfig"
+	"github.com/opensvc/om3/util/key"
+	"github.com/opensvc/om3/util/plog"
+)
+
+type (
+	Driver interface {
+		Name() string
+		SetName(string)
+		SetConfig(*xconfig.T)
+		Config() *xconfig.T
+
+		// Fence powers off the peer node. It returns nil only when the
+		// peer is confirmed fenced.
+		Fence(context.Context) error
+	}
+
+	// T is the base struct of the stonith drivers. Its name is the fenced
+	// peer node name.
+	T struct {
+		name   string
+		config *xconfig.T
+	}
+)
+
+var (
+	// ErrNotConfigured is returned by New when the node.conf has no
+	// stonith section for the peer.
+	ErrNotConfigured = errors.New("no stonith configured")
+
+	// DefaultTimeout is the fencing timeout used when the stonith timeout
+	// keyword is not set.
+	DefaultTimeout = time.Minute
+)
+
+// GetDriver returns a new driver of type <s>, or nil if the driver is not
+// registered.
+func GetDriver(s string) Driver {
+	drvID := driver.NewID(driver.GroupStonith, s)
+	i := driver.Get(drvID)
+	if i == nil {
+		return nil
+	}
+	if a, ok := i.(func() Driver); ok {
+		return a()
+	}
+	return nil
+}
+
+// SectionName returns the name of the node.conf section configuring the
+// fencing of the <peer> node.
+func SectionName(peer string) string {
+	return "stonith#" + peer
+}
+
+// New returns the driver configured in <config> to fence the <peer> node.
+func New(config *xconfig.T, peer string) (Driver, error) {
+	section := SectionName(peer)
+	if !config.HasSectionString(section) {
+		return nil, fmt.Errorf("%w for node %s", ErrNotConfigured, peer)
+	}
+	typ := config.GetString(key.New(section, "type"))
+	drv := GetDriver(typ)
+	if drv == nil {
+		return nil, fmt.Errorf("%s: stonith driver %s is not registered", section, typ)
+	}
+	drv.SetName(peer)
+	drv.SetConfig(config)
+	return drv, nil
+}
+
+func (t T) Name() string {
+	return t.name
+}
+
+func (t T) Config() *xconfig.T {
+	return t.config
+}
+
+func (t *T) SetConfig(c *xconfig.T) {
+	t.config = c
+}
+
+func (t *T) SetName(s string) {
+	t.name = strings.TrimPrefix(s, "stonith#")
+}
+
+// Key returns the key of the <s> option in the stonith section of the
+// driver.
+func (t T) Key(s string) key.T {
+	if t.name == "" {
+		panic("stonith has no name")
+	}
+	return key.New(SectionName(t.name), s)
+}
+
+func (t T) GetString(s string) string {
+	return t.config.GetString(t.Key(s))
+}
+
+func (t T) GetBool(s string) bool {
+	return t.config.GetBool(t.Key(s))
+}
+
+// Timeout returns the fencing timeout.
+func (t T) Timeout() time.Duration {
+	if d := t.config.GetDuration(t.Key("timeout")); d != nil && *d > 0 {
+		return *d
+	}
+	return DefaultTimeout
+}
+
+// Log returns the logger of the driver.
+func (t T) Log() *plog.Logger {
+	return plog.NewDefaultLogger().
+		Attr("pkg", "core/stonith").
+		Attr("peer", t.name).
+		WithPrefix(fmt.Sprintf("stonith: %s: ", t.name))
+}
@@ -0,0 +1,46 @@
+package stonith
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/core/xconfig"
+)
+
+type (
+	testDriver struct {
+		T
+	}
+)
+
+func (t *testDriver) Fence(ctx context.Context) error {
+	return nil
+}
+
+func init() {
+	driver.Register(driver.NewID(driver.GroupStonith, "test"), func() Driver { return &testDriver{} })
+}
+
+func TestGetDriver(t *testing.T) {
+	require.IsType(t, &testDriver{}, GetDriver("test"))
+	require.Nil(t, GetDriver("unknown"))
+}
+
+func TestSetName(t *testing.T) {
+	drv := GetDriver("test")
+	drv.SetName("stonith#node2")
+	require.Equal(t, "node2", drv.Name())
+	drv.SetName("node3")
+	require.Equal(t, "node3", drv.Name())
+	require.Equal(t, "stonith#node3.timeout", drv.(*testDriver).Key("timeout").String())
+}
+
+func TestNewNotConfigured(t *testing.T) {
+	config, err := xconfig.NewObject("", []byte("[stonith#node2]\ntype = test\n"))
+	require.NoError(t, err)
+	_, err = New(config, "node1")
+	require.ErrorIs(t, err, ErrNotConfigured)
+}
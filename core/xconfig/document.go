@@ -0,0 +1,281 @@
+package xconfig
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/cvaroqui/ini"
+	"github.com/iancoleman/orderedmap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/opensvc/om3/core/rawconfig"
+)
+
+type (
+	// Format is a configuration document format.
+	Format string
+)
+
+const (
+	FormatINI  Format = "ini"
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+)
+
+// FormatFromPath returns the configuration document format of the file p,
+// deduced from its extension. Files without a json or yaml extension are
+// ini formatted.
+func FormatFromPath(p string) Format {
+	switch strings.ToLower(filepath.Ext(p)) {
+	case ".json":
+		return FormatJSON
+	case ".yaml", ".yml":
+		return FormatYAML
+	default:
+		return FormatINI
+	}
+}
+
+// ParseDocument returns the raw configuration of the json or yaml
+// formatted document b.
+//
+// The document is a map of sections, each section being a map of keywords.
+// The keyword names are the same as in the ini format, including the
+// scope suffix, like "nodes@node1", "size@nodes" or "dev@drpnodes". The
+// sections and keywords order is preserved.
+//
+// The keyword values can be strings, numbers, booleans, null or lists of
+// those. Numbers and booleans are converted to their literal
+// representation, null to an empty string, and lists to their space
+// separated elements.
+func ParseDocument(b []byte) (rawconfig.T, error) {
+	raw := rawconfig.New()
+	var doc yaml.Node
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return raw, err
+	}
+	if len(doc.Content) == 0 {
+		// empty document
+		return raw, nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return raw, fmt.Errorf("line %d: the document must be a map of sections", root.Line)
+	}
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		sectionNode, valueNode := root.Content[i], root.Content[i+1]
+		section := sectionNode.Value
+		if _, ok := raw.Data.Get(section); ok {
+			return raw, fmt.Errorf("line %d: duplicate section %s", sectionNode.Line, section)
+		}
+		sectionMap := *orderedmap.New()
+		switch {
+		case valueNode.Kind == yaml.ScalarNode && valueNode.Tag == "!!null":
+			// empty section
+		case valueNode.Kind != yaml.MappingNode:
+			return raw, fmt.Errorf("line %d: section %s must be a map of keywords", valueNode.Line, section)
+		}
+		for j := 0; j+1 < len(valueNode.Content); j += 2 {
+			optionNode, optionValueNode := valueNode.Content[j], valueNode.Content[j+1]
+			option := optionNode.Value
+			if _, ok := sectionMap.Get(option); ok {
+				return raw, fmt.Errorf("line %d: duplicate keyword %s.%s", optionNode.Line, section, option)
+			}
+			v, err := documentNodeValue(optionValueNode)
+			if err != nil {
+				return raw, fmt.Errorf("line %d: keyword %s.%s: %w", optionValueNode.Line, section, option, err)
+			}
+			sectionMap.Set(option, v)
+		}
+		raw.Data.Set(section, sectionMap)
+	}
+	return raw, nil
+}
+
+func documentNodeValue(node *yaml.Node) (string, error) {
+	switch node.Kind {
+	case yaml.AliasNode:
+		return documentNodeValue(node.Alias)
+	case yaml.ScalarNode:
+		if node.Tag == "!!null" {
+			return "", nil
+		}
+		return node.Value, nil
+	case yaml.SequenceNode:
+		l := make([]string, len(node.Content))
+		for i, e := range node.Content {
+			if e.Kind != yaml.ScalarNode && e.Kind != yaml.AliasNode {
+				return "", fmt.Errorf("unsupported nested list or map value")
+			}
+			s, err := documentNodeValue(e)
+			if err != nil {
+				return "", err
+			}
+			l[i] = s
+		}
+		return strings.Join(l, " "), nil
+	default:
+		return "", fmt.Errorf("unsupported map value")
+	}
+}
+
+// rawValueString returns the ini representation of the raw config value v,
+// as decoded from a json document.
+func rawValueString(v any) (string, error) {
+	switch o := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return o, nil
+	case bool:
+		return strconv.FormatBool(o), nil
+	case float64:
+		return strconv.FormatFloat(o, 'f', -1, 64), nil
+	case json.Number:
+		return o.String(), nil
+	case []any:
+		l := make([]string, len(o))
+		for i, e := range o {
+			switch e.(type) {
+			case []any, map[string]any, orderedmap.OrderedMap:
+				return "", fmt.Errorf("unsupported nested list or map value")
+			}
+			s, err := rawValueString(e)
+			if err != nil {
+				return "", err
+			}
+			l[i] = s
+		}
+		return strings.Join(l, " "), nil
+	default:
+		return "", fmt.Errorf("unsupported value type %T", v)
+	}
+}
+
+// rawToIniFile returns the ini file of the raw configuration.
+func rawToIniFile(raw rawconfig.T) (*ini.File, error) {
+	file := ini.Empty()
+	if raw.Data == nil {
+		return file, nil
+	}
+	for _, section := range raw.Data.Keys() {
+		m, _ := raw.Data.Get(section)
+		omap, ok := m.(orderedmap.OrderedMap)
+		if !ok {
+			return nil, fmt.Errorf("invalid section in raw config format: %+v", m)
+		}
+		for _, option := range omap.Keys() {
+			value, _ := omap.Get(option)
+			v, err := rawValueString(value)
+			if err != nil {
+				return nil, fmt.Errorf("keyword %s.%s: %w", section, option, err)
+			}
+			file.Section(section).Key(option).SetValue(v)
+		}
+	}
+	return file, nil
+}
+
+// MarshalDocument returns the raw configuration formatted as a document of
+// the format f. The json and yaml documents can be loaded back by
+// ParseDocument. The metadata section is not included in ini documents.
+func MarshalDocument(raw rawconfig.T, f Format) ([]byte, error) {
+	switch f {
+	case FormatINI:
+		file, err := rawToIniFile(raw)
+		if err != nil {
+			return nil, err
+		}
+		file.DeleteSection("metadata")
+		setIniWriteOptions()
+		var b bytes.Buffer
+		if _, err := file.WriteTo(&b); err != nil {
+			return nil, err
+		}
+		return b.Bytes(), nil
+	case FormatJSON:
+		if raw.Data == nil {
+			raw = rawconfig.New()
+		}
+		return json.MarshalIndent(raw, "", "  ")
+	case FormatYAML:
+		root := &yaml.Node{Kind: yaml.MappingNode}
+		if raw.Data != nil {
+			for _, section := range raw.Data.Keys() {
+				m, _ := raw.Data.Get(section)
+				omap, ok := m.(orderedmap.OrderedMap)
+				if !ok {
+					return nil, fmt.Errorf("invalid section in raw config format: %+v", m)
+				}
+				sectionNode := &yaml.Node{Kind: yaml.MappingNode}
+				for _, option := range omap.Keys() {
+					value, _ := omap.Get(option)
+					v, err := rawValueString(value)
+					if err != nil {
+						return nil, fmt.Errorf("keyword %s.%s: %w", section, option, err)
+					}
+					sectionNode.Content = append(sectionNode.Content,
+						&yaml.Node{Kind: yaml.ScalarNode, Value: option},
+						&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v},
+					)
+				}
+				root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: section}, sectionNode)
+			}
+		}
+		var b bytes.Buffer
+		enc := yaml.NewEncoder(&b)
+		enc.SetIndent(2)
+		if err := enc.Encode(root); err != nil {
+			return nil, err
+		}
+		if err := enc.Close(); err != nil {
+			return nil, err
+		}
+		return b.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unsupported config format %s", f)
+	}
+}
+
+// Marshal returns the configuration formatted as a document of the format f.
+func (t T) Marshal(f Format) ([]byte, error) {
+	return MarshalDocument(t.Raw(), f)
+}
+
+// LoadDocument replaces the configuration with the json or yaml formatted
+// document b.
+func (t *T) LoadDocument(b []byte) error {
+	raw, err := ParseDocument(b)
+	if err != nil {
+		return err
+	}
+	return t.LoadRaw(raw)
+}
+
+// documentFileToIni returns the ini representation of the json or yaml
+// formatted configuration file p.
+func documentFileToIni(p string) ([]byte, error) {
+	b, err := os.ReadFile(p)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := ParseDocument(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", p, err)
+	}
+	file, err := rawToIniFile(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", p, err)
+	}
+	setIniWriteOptions()
+	var buf bytes.Buffer
+	if _, err := file.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,96 @@
+package xconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/util/key"
+)
+
+func TestParseDocument(t *testing.T) {
+	t.Run("yaml", func(t *testing.T) {
+		b := []byte(`
+DEFAULT:
+  nodes: [n1, n2]
+  orchestrate: ha
+  nodes@drpnodes: n3
+fs#1:
+  type: flag
+  size: 10
+  size@n1: 20
+  size@nodes: 1.5
+  enable: true
+  comment: null
+  script: |-
+    line1
+    line2
+app#1:
+`)
+		raw, err := ParseDocument(b)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"DEFAULT", "fs#1", "app#1"}, raw.Data.Keys(), "sections order is preserved")
+		c := &T{}
+		require.NoError(t, c.LoadRaw(raw))
+		assert.Equal(t, "n1 n2", c.Get(key.New("DEFAULT", "nodes")))
+		assert.Equal(t, "n3", c.Get(key.New("DEFAULT", "nodes@drpnodes")))
+		assert.Equal(t, []string{"type", "size", "size@n1", "size@nodes", "enable", "comment", "script"}, c.Keys("fs#1"), "keywords order is preserved")
+		assert.Equal(t, "10", c.Get(key.New("fs#1", "size")))
+		assert.Equal(t, "20", c.Get(key.New("fs#1", "size@n1")))
+		assert.Equal(t, "1.5", c.Get(key.New("fs#1", "size@nodes")))
+		assert.Equal(t, "true", c.Get(key.New("fs#1", "enable")))
+		assert.Equal(t, "", c.Get(key.New("fs#1", "comment")))
+		assert.Equal(t, "line1\nline2", c.Get(key.New("fs#1", "script")))
+	})
+
+	t.Run("json", func(t *testing.T) {
+		raw, err := ParseDocument([]byte(`{"DEFAULT": {"nodes": ["n1", "n2"], "priority": 10}, "fs#1": {"type": "flag"}}`))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"DEFAULT", "fs#1"}, raw.Data.Keys())
+		c := &T{}
+		require.NoError(t, c.LoadRaw(raw))
+		assert.Equal(t, "n1 n2", c.Get(key.New("DEFAULT", "nodes")))
+		assert.Equal(t, "10", c.Get(key.New("DEFAULT", "priority")))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for name, s := range map[string]string{
+			"not a map":         `[a, b]`,
+			"section not a map": `{"DEFAULT": "foo"}`,
+			"map value":         `{"DEFAULT": {"nodes": {"n1": "n2"}}}`,
+			"nested list":       `{"DEFAULT": {"nodes": [["n1"]]}}`,
+			"duplicate section": "DEFAULT: {}\nDEFAULT: {}\n",
+			"invalid syntax":    `{"DEFAULT": `,
+		} {
+			_, err := ParseDocument([]byte(s))
+			assert.Error(t, err, name)
+		}
+	})
+}
+
+func TestMarshalDocument(t *testing.T) {
+	b := []byte("[DEFAULT]\nnodes = n1 n2\nnodes@drpnodes = n3\n\n[fs#1]\ntype = flag\nsize@n1 = 20\nscript = \"\"\"line1\nline2\"\"\"\n")
+	c, err := NewObject("", b)
+	require.NoError(t, err)
+	for _, format := range []Format{FormatJSON, FormatYAML, FormatINI} {
+		t.Run(string(format), func(t *testing.T) {
+			doc, err := c.Marshal(format)
+			require.NoError(t, err)
+			fpath := filepath.Join(t.TempDir(), "svc1."+string(format))
+			require.NoError(t, os.WriteFile(fpath, doc, 0600))
+			loaded, err := NewObject("", fpath)
+			require.NoError(t, err)
+			assert.Equal(t, c.Raw(), loaded.Raw(), "round trip of:\n%s", doc)
+		})
+	}
+}
+
+func TestFormatFromPath(t *testing.T) {
+	assert.Equal(t, FormatINI, FormatFromPath("/etc/opensvc/svc1.conf"))
+	assert.Equal(t, FormatJSON, FormatFromPath("svc1.json"))
+	assert.Equal(t, FormatYAML, FormatFromPath("svc1.yaml"))
+	assert.Equal(t, FormatYAML, FormatFromPath("svc1.YML"))
+}
@@ -74,7 +74,7 @@ var (
 	ErrNoKeyword    = errors.New("keyword does not exist")
 	ErrType         = errors.New("type error")
 
-	DriverGroups = set.New("ip", "volume", "disk", "fs", "share", "container", "app", "sync", "task")
+	DriverGroups = set.New("ip", "volume", "disk", "fs", "share", "container", "app", "check", "sync", "task")
 )
 
 func (t ErrPostponedRef) Error() string {
@@ -644,9 +644,18 @@ func (t *T) set(op keyop.T) error {
 	return fmt.Errorf("unsupported operator: %d setting key %s", op.Op, op.Key)
 }
 
+// setIniWriteOptions sets the ini package options formatting the written
+// configuration files.
+func setIniWriteOptions() {
+	ini.DefaultHeader = true
+	ini.PrettyEqual = false
+	ini.PrettyFormat = false
+	ini.DefaultFormatLeft = " "
+	ini.DefaultFormatRight = " "
+}
+
 func (t *T) write() (err error) {
 	var f *os.File
-	ini.DefaultHeader = true
 	dir := filepath.Dir(t.ConfigFilePath)
 	if err = os.MkdirAll(dir, os.ModePerm); err != nil {
 		return err
@@ -658,12 +667,17 @@ func (t *T) write() (err error) {
 	fName := f.Name()
 	defer os.Remove(fName)
 
-	ini.PrettyEqual = false
-	ini.PrettyFormat = false
-	ini.DefaultFormatLeft = " "
-	ini.DefaultFormatRight = " "
+	setIniWriteOptions()
 
-	if _, err = t.file.WriteTo(f); err != nil {
+	if format := FormatFromPath(t.ConfigFilePath); format != FormatINI {
+		b, err := t.Marshal(format)
+		if err != nil {
+			return err
+		}
+		if _, err = f.Write(b); err != nil {
+			return err
+		}
+	} else if _, err = t.file.WriteTo(f); err != nil {
 		return err
 	}
 	if err = f.Sync(); err != nil {
@@ -1154,27 +1168,9 @@ func (t T) dereferenceWellKnown(ref string, section string, impersonate string)
 
 func (t *T) LoadRaw(configData rawconfig.T) error {
 	t.changed = true
-	file := ini.Empty()
-	if configData.Data == nil {
-		t.file = file
-		return nil
-	}
-	for _, section := range configData.Data.Keys() {
-		m, _ := configData.Data.Get(section)
-		omap, ok := m.(orderedmap.OrderedMap)
-		if !ok {
-			return fmt.Errorf("invalid section in raw config format: %+v", m)
-		}
-		for _, option := range omap.Keys() {
-			value, _ := omap.Get(option)
-			var v string
-			if value == nil {
-				v = ""
-			} else {
-				v = value.(string)
-			}
-			file.Section(section).Key(option).SetValue(v)
-		}
+	file, err := rawToIniFile(configData)
+	if err != nil {
+		return err
 	}
 	t.file = file
 	return nil
@@ -15,7 +15,8 @@ import (
 //
 // The path must be repeated as one of the following sources to read it.
 // Accepted sources are []byte in ini format or a configuration file path
-// containing ini formatted data.
+// containing ini formatted data, or json or yaml formatted data if the file
+// has a .json, .yaml or .yml extension.
 func NewObject(p string, sources ...any) (*T, error) {
 	t := &T{
 		ConfigFilePath: filepath.FromSlash(p),
@@ -79,6 +80,9 @@ func toIniSource(i any) (any, error) {
 	case []byte:
 		return data, nil
 	case string:
+		if FormatFromPath(data) != FormatINI {
+			return documentFileToIni(data)
+		}
 		return data, nil
 	case map[string]map[string]any:
 		for sectionTitle, sectionIntf := range data {
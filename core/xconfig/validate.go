@@ -290,7 +290,8 @@ func (t T) Validate() (Alerts, error) {
 		sectionType := t.GetString(key.New(section, "type"))
 		if rid, err := resourceid.Parse(section); err == nil {
 			did = driver.NewID(rid.DriverGroup(), sectionType)
-			if did.Name != "" {
+			// sections like arbitrator have a type but no driver group
+			if did.Name != "" && did.Group.IsValid() {
 				if sectionType == "" {
 					sectionType = did.Name
 				}
@@ -33,6 +33,9 @@ type ServerInterface interface {
 	// (POST /cluster/action/freeze)
 	PostClusterActionFreeze(ctx echo.Context) error
 
+	// (POST /cluster/action/rebalance)
+	PostClusterActionRebalance(ctx echo.Context) error
+
 	// (POST /cluster/action/unfreeze)
 	PostClusterActionUnfreeze(ctx echo.Context) error
 
@@ -186,12 +189,30 @@ type ServerInterface interface {
 	// (POST /node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/clear)
 	PostInstanceClear(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName) error
 
+	// (POST /node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/clone)
+	PostInstanceClone(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params PostInstanceCloneParams) error
+
 	// (GET /node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/config/file)
 	GetInstanceConfigFile(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName) error
 
+	// (DELETE /node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/snapshot)
+	DeleteInstanceSnapshot(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params DeleteInstanceSnapshotParams) error
+
+	// (GET /node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/snapshot)
+	GetInstanceSnapshots(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName) error
+
+	// (POST /node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/snapshot)
+	PostInstanceSnapshot(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params PostInstanceSnapshotParams) error
+
+	// (POST /node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/snapshot/rollback)
+	PostInstanceSnapshotRollback(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params PostInstanceSnapshotRollbackParams) error
+
 	// (POST /node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/state/file)
 	PostInstanceStateFile(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName) error
 
+	// (POST /node/name/{nodename}/instance/path/{namespace}/{kind}/{name}/sync/blockdelta)
+	PostInstanceSyncBlockdelta(ctx echo.Context, nodename InPathNodeName, namespace InPathNamespace, kind InPathKind, name InPathName, params PostInstanceSyncBlockdeltaParams) error
+
 	// (GET /node/name/{nodename}/log)
 	GetNodeLogs(ctx echo.Context, nodename InPathNodeName, params GetNodeLogsParams) error
 
@@ -246,6 +267,9 @@ type ServerInterface interface {
 	// (GET /object)
 	GetObjects(ctx echo.Context, params GetObjectsParams) error
 
+	// (POST /object/apply)
+	PostObjectApply(ctx echo.Context) error
+
 	// (GET /object/path)
 	GetObjectPaths(ctx echo.Context, params GetObjectPathsParams) error
 
@@ -309,6 +333,15 @@ type ServerInterface interface {
 	// (GET /object/path/{namespace}/{kind}/{name}/config/get)
 	GetObjectConfigGet(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params GetObjectConfigGetParams) error
 
+	// (GET /object/path/{namespace}/{kind}/{name}/config/history)
+	GetObjectConfigHistory(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
+
+	// (GET /object/path/{namespace}/{kind}/{name}/config/history/{revision}/diff)
+	GetObjectConfigHistoryDiff(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, revision InPathRevision, params GetObjectConfigHistoryDiffParams) error
+
+	// (POST /object/path/{namespace}/{kind}/{name}/config/history/{revision}/revert)
+	PostObjectConfigHistoryRevert(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, revision InPathRevision) error
+
 	// (POST /object/path/{namespace}/{kind}/{name}/config/update)
 	PostObjectConfigUpdate(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params PostObjectConfigUpdateParams) error
 
@@ -339,6 +372,12 @@ type ServerInterface interface {
 	// (GET /object/path/{namespace}/{kind}/{name}/schedule)
 	GetObjectSchedule(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
 
+	// (POST /object/path/{namespace}/{kind}/{name}/template/render)
+	PostObjectTemplateRender(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
+
+	// (POST /object/path/{namespace}/{kind}/{name}/template/upgrade)
+	PostObjectTemplateUpgrade(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
+
 	// (GET /pool)
 	GetPools(ctx echo.Context, params GetPoolsParams) error
 
@@ -433,6 +472,19 @@ func (w *ServerInterfaceWrapper) PostClusterActionFreeze(ctx echo.Context) error
 	return err
 }
 
+// PostClusterActionRebalance converts echo context to params.
+func (w *ServerInterfaceWrapper) PostClusterActionRebalance(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostClusterActionRebalance(ctx)
+	return err
+}
+
 // PostClusterActionUnfreeze converts echo context to params.
 func (w *ServerInterfaceWrapper) PostClusterActionUnfreeze(ctx echo.Context) error {
 	var err error
@@ -2470,8 +2522,8 @@ func (w *ServerInterfaceWrapper) PostInstanceClear(ctx echo.Context) error {
 	return err
 }
 
-// GetInstanceConfigFile converts echo context to params.
-func (w *ServerInterfaceWrapper) GetInstanceConfigFile(ctx echo.Context) error {
+// PostInstanceClone converts echo context to params.
+func (w *ServerInterfaceWrapper) PostInstanceClone(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "nodename" -------------
 	var nodename InPathNodeName
@@ -2509,13 +2561,29 @@ func (w *ServerInterfaceWrapper) GetInstanceConfigFile(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostInstanceCloneParams
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "snapshot" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "snapshot", ctx.QueryParams(), &params.Snapshot)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter snapshot: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetInstanceConfigFile(ctx, nodename, namespace, kind, name)
+	err = w.Handler.PostInstanceClone(ctx, nodename, namespace, kind, name, params)
 	return err
 }
 
-// PostInstanceStateFile converts echo context to params.
-func (w *ServerInterfaceWrapper) PostInstanceStateFile(ctx echo.Context) error {
+// GetInstanceConfigFile converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInstanceConfigFile(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "nodename" -------------
 	var nodename InPathNodeName
@@ -2554,12 +2622,12 @@ func (w *ServerInterfaceWrapper) PostInstanceStateFile(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostInstanceStateFile(ctx, nodename, namespace, kind, name)
+	err = w.Handler.GetInstanceConfigFile(ctx, nodename, namespace, kind, name)
 	return err
 }
 
-// GetNodeLogs converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodeLogs(ctx echo.Context) error {
+// DeleteInstanceSnapshot converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteInstanceSnapshot(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "nodename" -------------
 	var nodename InPathNodeName
@@ -2569,47 +2637,50 @@ func (w *ServerInterfaceWrapper) GetNodeLogs(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
-
-	ctx.Set(BearerAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetNodeLogsParams
-	// ------------- Optional query parameter "filter" -------------
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
 
-	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
 	}
 
-	// ------------- Optional query parameter "follow" -------------
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
 
-	err = runtime.BindQueryParameter("form", true, false, "follow", ctx.QueryParams(), &params.Follow)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kind", runtime.ParamLocationPath, ctx.Param("kind"), &kind)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter follow: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
 	}
 
-	// ------------- Optional query parameter "lines" -------------
+	// ------------- Path parameter "name" -------------
+	var name InPathName
 
-	err = runtime.BindQueryParameter("form", true, false, "lines", ctx.QueryParams(), &params.Lines)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lines: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
-	// ------------- Optional query parameter "paths" -------------
+	ctx.Set(BasicAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "paths", ctx.QueryParams(), &params.Paths)
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteInstanceSnapshotParams
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter paths: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodeLogs(ctx, nodename, params)
+	err = w.Handler.DeleteInstanceSnapshot(ctx, nodename, namespace, kind, name, params)
 	return err
 }
 
-// PostInstanceActionPushResourceInfo converts echo context to params.
-func (w *ServerInterfaceWrapper) PostInstanceActionPushResourceInfo(ctx echo.Context) error {
+// GetInstanceSnapshots converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInstanceSnapshots(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "nodename" -------------
 	var nodename InPathNodeName
@@ -2647,22 +2718,13 @@ func (w *ServerInterfaceWrapper) PostInstanceActionPushResourceInfo(ctx echo.Con
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params PostInstanceActionPushResourceInfoParams
-	// ------------- Optional query parameter "requester_sid" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "requester_sid", ctx.QueryParams(), &params.RequesterSid)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter requester_sid: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostInstanceActionPushResourceInfo(ctx, nodename, namespace, kind, name, params)
+	err = w.Handler.GetInstanceSnapshots(ctx, nodename, namespace, kind, name)
 	return err
 }
 
-// GetInstanceLogs converts echo context to params.
-func (w *ServerInterfaceWrapper) GetInstanceLogs(ctx echo.Context) error {
+// PostInstanceSnapshot converts echo context to params.
+func (w *ServerInterfaceWrapper) PostInstanceSnapshot(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "nodename" -------------
 	var nodename InPathNodeName
@@ -2701,35 +2763,21 @@ func (w *ServerInterfaceWrapper) GetInstanceLogs(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetInstanceLogsParams
-	// ------------- Optional query parameter "filter" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
-	}
-
-	// ------------- Optional query parameter "follow" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "follow", ctx.QueryParams(), &params.Follow)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter follow: %s", err))
-	}
-
-	// ------------- Optional query parameter "lines" -------------
+	var params PostInstanceSnapshotParams
+	// ------------- Optional query parameter "name" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "lines", ctx.QueryParams(), &params.Lines)
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lines: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetInstanceLogs(ctx, nodename, namespace, kind, name, params)
+	err = w.Handler.PostInstanceSnapshot(ctx, nodename, namespace, kind, name, params)
 	return err
 }
 
-// GetInstanceResourceInfo converts echo context to params.
-func (w *ServerInterfaceWrapper) GetInstanceResourceInfo(ctx echo.Context) error {
+// PostInstanceSnapshotRollback converts echo context to params.
+func (w *ServerInterfaceWrapper) PostInstanceSnapshotRollback(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "nodename" -------------
 	var nodename InPathNodeName
@@ -2767,13 +2815,29 @@ func (w *ServerInterfaceWrapper) GetInstanceResourceInfo(ctx echo.Context) error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostInstanceSnapshotRollbackParams
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", ctx.QueryParams(), &params.Force)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter force: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetInstanceResourceInfo(ctx, nodename, namespace, kind, name)
+	err = w.Handler.PostInstanceSnapshotRollback(ctx, nodename, namespace, kind, name, params)
 	return err
 }
 
-// GetInstanceSchedule converts echo context to params.
-func (w *ServerInterfaceWrapper) GetInstanceSchedule(ctx echo.Context) error {
+// PostInstanceStateFile converts echo context to params.
+func (w *ServerInterfaceWrapper) PostInstanceStateFile(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "nodename" -------------
 	var nodename InPathNodeName
@@ -2812,12 +2876,12 @@ func (w *ServerInterfaceWrapper) GetInstanceSchedule(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetInstanceSchedule(ctx, nodename, namespace, kind, name)
+	err = w.Handler.PostInstanceStateFile(ctx, nodename, namespace, kind, name)
 	return err
 }
 
-// GetNodePing converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodePing(ctx echo.Context) error {
+// PostInstanceSyncBlockdelta converts echo context to params.
+func (w *ServerInterfaceWrapper) PostInstanceSyncBlockdelta(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "nodename" -------------
 	var nodename InPathNodeName
@@ -2827,57 +2891,64 @@ func (w *ServerInterfaceWrapper) GetNodePing(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodePing(ctx, nodename)
-	return err
-}
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
 
-// GetNodeSchedule converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodeSchedule(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "nodename" -------------
-	var nodename InPathNodeName
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kind", runtime.ParamLocationPath, ctx.Param("kind"), &kind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "nodename", runtime.ParamLocationPath, ctx.Param("nodename"), &nodename)
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
 	ctx.Set(BasicAuthScopes, []string{})
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodeSchedule(ctx, nodename)
-	return err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostInstanceSyncBlockdeltaParams
+	// ------------- Optional query parameter "rid" -------------
 
-// GetNodeSystemDisk converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodeSystemDisk(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "nodename" -------------
-	var nodename InPathNodeName
+	err = runtime.BindQueryParameter("form", true, false, "rid", ctx.QueryParams(), &params.Rid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rid: %s", err))
+	}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "nodename", runtime.ParamLocationPath, ctx.Param("nodename"), &nodename)
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "id", ctx.QueryParams(), &params.Id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	// ------------- Optional query parameter "base" -------------
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "base", ctx.QueryParams(), &params.Base)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter base: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodeSystemDisk(ctx, nodename)
+	err = w.Handler.PostInstanceSyncBlockdelta(ctx, nodename, namespace, kind, name, params)
 	return err
 }
 
-// GetNodeSystemGroup converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodeSystemGroup(ctx echo.Context) error {
+// GetNodeLogs converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeLogs(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "nodename" -------------
 	var nodename InPathNodeName
@@ -2891,53 +2962,371 @@ func (w *ServerInterfaceWrapper) GetNodeSystemGroup(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodeSystemGroup(ctx, nodename)
-	return err
-}
-
-// GetNodeSystemHardware converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodeSystemHardware(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "nodename" -------------
-	var nodename InPathNodeName
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodeLogsParams
+	// ------------- Optional query parameter "filter" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "nodename", runtime.ParamLocationPath, ctx.Param("nodename"), &nodename)
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
-
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "follow" -------------
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodeSystemHardware(ctx, nodename)
-	return err
-}
+	err = runtime.BindQueryParameter("form", true, false, "follow", ctx.QueryParams(), &params.Follow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter follow: %s", err))
+	}
 
-// GetNodeSystemIPAddress converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodeSystemIPAddress(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "nodename" -------------
-	var nodename InPathNodeName
+	// ------------- Optional query parameter "lines" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "nodename", runtime.ParamLocationPath, ctx.Param("nodename"), &nodename)
+	err = runtime.BindQueryParameter("form", true, false, "lines", ctx.QueryParams(), &params.Lines)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lines: %s", err))
 	}
 
-	ctx.Set(BasicAuthScopes, []string{})
+	// ------------- Optional query parameter "paths" -------------
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "paths", ctx.QueryParams(), &params.Paths)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter paths: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodeSystemIPAddress(ctx, nodename)
+	err = w.Handler.GetNodeLogs(ctx, nodename, params)
 	return err
 }
 
-// GetNodeSystemPackage converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodeSystemPackage(ctx echo.Context) error {
+// PostInstanceActionPushResourceInfo converts echo context to params.
+func (w *ServerInterfaceWrapper) PostInstanceActionPushResourceInfo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "nodename", runtime.ParamLocationPath, ctx.Param("nodename"), &nodename)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kind", runtime.ParamLocationPath, ctx.Param("kind"), &kind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostInstanceActionPushResourceInfoParams
+	// ------------- Optional query parameter "requester_sid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "requester_sid", ctx.QueryParams(), &params.RequesterSid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter requester_sid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostInstanceActionPushResourceInfo(ctx, nodename, namespace, kind, name, params)
+	return err
+}
+
+// GetInstanceLogs converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInstanceLogs(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "nodename", runtime.ParamLocationPath, ctx.Param("nodename"), &nodename)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kind", runtime.ParamLocationPath, ctx.Param("kind"), &kind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInstanceLogsParams
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "follow" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "follow", ctx.QueryParams(), &params.Follow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter follow: %s", err))
+	}
+
+	// ------------- Optional query parameter "lines" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lines", ctx.QueryParams(), &params.Lines)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lines: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInstanceLogs(ctx, nodename, namespace, kind, name, params)
+	return err
+}
+
+// GetInstanceResourceInfo converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInstanceResourceInfo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "nodename", runtime.ParamLocationPath, ctx.Param("nodename"), &nodename)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kind", runtime.ParamLocationPath, ctx.Param("kind"), &kind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInstanceResourceInfo(ctx, nodename, namespace, kind, name)
+	return err
+}
+
+// GetInstanceSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInstanceSchedule(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "nodename", runtime.ParamLocationPath, ctx.Param("nodename"), &nodename)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kind", runtime.ParamLocationPath, ctx.Param("kind"), &kind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInstanceSchedule(ctx, nodename, namespace, kind, name)
+	return err
+}
+
+// GetNodePing converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodePing(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "nodename", runtime.ParamLocationPath, ctx.Param("nodename"), &nodename)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodePing(ctx, nodename)
+	return err
+}
+
+// GetNodeSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeSchedule(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "nodename", runtime.ParamLocationPath, ctx.Param("nodename"), &nodename)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeSchedule(ctx, nodename)
+	return err
+}
+
+// GetNodeSystemDisk converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeSystemDisk(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "nodename", runtime.ParamLocationPath, ctx.Param("nodename"), &nodename)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeSystemDisk(ctx, nodename)
+	return err
+}
+
+// GetNodeSystemGroup converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeSystemGroup(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "nodename", runtime.ParamLocationPath, ctx.Param("nodename"), &nodename)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeSystemGroup(ctx, nodename)
+	return err
+}
+
+// GetNodeSystemHardware converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeSystemHardware(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "nodename", runtime.ParamLocationPath, ctx.Param("nodename"), &nodename)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeSystemHardware(ctx, nodename)
+	return err
+}
+
+// GetNodeSystemIPAddress converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeSystemIPAddress(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "nodename", runtime.ParamLocationPath, ctx.Param("nodename"), &nodename)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeSystemIPAddress(ctx, nodename)
+	return err
+}
+
+// GetNodeSystemPackage converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeSystemPackage(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "nodename" -------------
 	var nodename InPathNodeName
@@ -3078,6 +3467,19 @@ func (w *ServerInterfaceWrapper) GetObjects(ctx echo.Context) error {
 	return err
 }
 
+// PostObjectApply converts echo context to params.
+func (w *ServerInterfaceWrapper) PostObjectApply(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostObjectApply(ctx)
+	return err
+}
+
 // GetObjectPaths converts echo context to params.
 func (w *ServerInterfaceWrapper) GetObjectPaths(ctx echo.Context) error {
 	var err error
@@ -3889,6 +4291,139 @@ func (w *ServerInterfaceWrapper) GetObjectConfigGet(ctx echo.Context) error {
 	return err
 }
 
+// GetObjectConfigHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetObjectConfigHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kind", runtime.ParamLocationPath, ctx.Param("kind"), &kind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetObjectConfigHistory(ctx, namespace, kind, name)
+	return err
+}
+
+// GetObjectConfigHistoryDiff converts echo context to params.
+func (w *ServerInterfaceWrapper) GetObjectConfigHistoryDiff(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kind", runtime.ParamLocationPath, ctx.Param("kind"), &kind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Path parameter "revision" -------------
+	var revision InPathRevision
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "revision", runtime.ParamLocationPath, ctx.Param("revision"), &revision)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter revision: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetObjectConfigHistoryDiffParams
+	// ------------- Optional query parameter "against" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "against", ctx.QueryParams(), &params.Against)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter against: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetObjectConfigHistoryDiff(ctx, namespace, kind, name, revision, params)
+	return err
+}
+
+// PostObjectConfigHistoryRevert converts echo context to params.
+func (w *ServerInterfaceWrapper) PostObjectConfigHistoryRevert(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kind", runtime.ParamLocationPath, ctx.Param("kind"), &kind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Path parameter "revision" -------------
+	var revision InPathRevision
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "revision", runtime.ParamLocationPath, ctx.Param("revision"), &revision)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter revision: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostObjectConfigHistoryRevert(ctx, namespace, kind, name, revision)
+	return err
+}
+
 // PostObjectConfigUpdate converts echo context to params.
 func (w *ServerInterfaceWrapper) PostObjectConfigUpdate(ctx echo.Context) error {
 	var err error
@@ -4317,6 +4852,78 @@ func (w *ServerInterfaceWrapper) GetObjectSchedule(ctx echo.Context) error {
 	return err
 }
 
+// PostObjectTemplateRender converts echo context to params.
+func (w *ServerInterfaceWrapper) PostObjectTemplateRender(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kind", runtime.ParamLocationPath, ctx.Param("kind"), &kind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostObjectTemplateRender(ctx, namespace, kind, name)
+	return err
+}
+
+// PostObjectTemplateUpgrade converts echo context to params.
+func (w *ServerInterfaceWrapper) PostObjectTemplateUpgrade(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kind", runtime.ParamLocationPath, ctx.Param("kind"), &kind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostObjectTemplateUpgrade(ctx, namespace, kind, name)
+	return err
+}
+
 // GetPools converts echo context to params.
 func (w *ServerInterfaceWrapper) GetPools(ctx echo.Context) error {
 	var err error
@@ -4529,6 +5136,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/auth/token", wrapper.PostAuthToken)
 	router.POST(baseURL+"/cluster/action/abort", wrapper.PostClusterActionAbort)
 	router.POST(baseURL+"/cluster/action/freeze", wrapper.PostClusterActionFreeze)
+	router.POST(baseURL+"/cluster/action/rebalance", wrapper.PostClusterActionRebalance)
 	router.POST(baseURL+"/cluster/action/unfreeze", wrapper.PostClusterActionUnfreeze)
 	router.POST(baseURL+"/daemon/action/join", wrapper.PostDaemonJoin)
 	router.POST(baseURL+"/daemon/action/leave", wrapper.PostDaemonLeave)
@@ -4580,8 +5188,14 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/node/name/:nodename/instance/path/:namespace/:kind/:name/action/unfreeze", wrapper.PostInstanceActionUnfreeze)
 	router.POST(baseURL+"/node/name/:nodename/instance/path/:namespace/:kind/:name/action/unprovision", wrapper.PostInstanceActionUnprovision)
 	router.POST(baseURL+"/node/name/:nodename/instance/path/:namespace/:kind/:name/clear", wrapper.PostInstanceClear)
+	router.POST(baseURL+"/node/name/:nodename/instance/path/:namespace/:kind/:name/clone", wrapper.PostInstanceClone)
 	router.GET(baseURL+"/node/name/:nodename/instance/path/:namespace/:kind/:name/config/file", wrapper.GetInstanceConfigFile)
+	router.DELETE(baseURL+"/node/name/:nodename/instance/path/:namespace/:kind/:name/snapshot", wrapper.DeleteInstanceSnapshot)
+	router.GET(baseURL+"/node/name/:nodename/instance/path/:namespace/:kind/:name/snapshot", wrapper.GetInstanceSnapshots)
+	router.POST(baseURL+"/node/name/:nodename/instance/path/:namespace/:kind/:name/snapshot", wrapper.PostInstanceSnapshot)
+	router.POST(baseURL+"/node/name/:nodename/instance/path/:namespace/:kind/:name/snapshot/rollback", wrapper.PostInstanceSnapshotRollback)
 	router.POST(baseURL+"/node/name/:nodename/instance/path/:namespace/:kind/:name/state/file", wrapper.PostInstanceStateFile)
+	router.POST(baseURL+"/node/name/:nodename/instance/path/:namespace/:kind/:name/sync/blockdelta", wrapper.PostInstanceSyncBlockdelta)
 	router.GET(baseURL+"/node/name/:nodename/log", wrapper.GetNodeLogs)
 	router.POST(baseURL+"/node/name/:nodename/object/path/:namespace/:kind/:name/action/push/resource/info", wrapper.PostInstanceActionPushResourceInfo)
 	router.GET(baseURL+"/node/name/:nodename/object/path/:namespace/:kind/:name/log", wrapper.GetInstanceLogs)
@@ -4600,6 +5214,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/node/name/:nodename/system/san/path", wrapper.GetNodeSystemSANPath)
 	router.GET(baseURL+"/node/name/:nodename/system/user", wrapper.GetNodeSystemUser)
 	router.GET(baseURL+"/object", wrapper.GetObjects)
+	router.POST(baseURL+"/object/apply", wrapper.PostObjectApply)
 	router.GET(baseURL+"/object/path", wrapper.GetObjectPaths)
 	router.POST(baseURL+"/object/path/:namespace/svc/:name/disable", wrapper.PostSvcDisable)
 	router.POST(baseURL+"/object/path/:namespace/svc/:name/enable", wrapper.PostSvcEnable)
@@ -4621,6 +5236,9 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/object/path/:namespace/:kind/:name/config/file", wrapper.PostObjectConfigFile)
 	router.PUT(baseURL+"/object/path/:namespace/:kind/:name/config/file", wrapper.PutObjectConfigFile)
 	router.GET(baseURL+"/object/path/:namespace/:kind/:name/config/get", wrapper.GetObjectConfigGet)
+	router.GET(baseURL+"/object/path/:namespace/:kind/:name/config/history", wrapper.GetObjectConfigHistory)
+	router.GET(baseURL+"/object/path/:namespace/:kind/:name/config/history/:revision/diff", wrapper.GetObjectConfigHistoryDiff)
+	router.POST(baseURL+"/object/path/:namespace/:kind/:name/config/history/:revision/revert", wrapper.PostObjectConfigHistoryRevert)
 	router.POST(baseURL+"/object/path/:namespace/:kind/:name/config/update", wrapper.PostObjectConfigUpdate)
 	router.GET(baseURL+"/object/path/:namespace/:kind/:name/kvstore", wrapper.GetObjectKVStore)
 	router.PATCH(baseURL+"/object/path/:namespace/:kind/:name/kvstore", wrapper.PatchObjectKVStore)
@@ -4631,6 +5249,8 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/object/path/:namespace/:kind/:name/kvstore/keys", wrapper.GetObjectKVStoreKeys)
 	router.GET(baseURL+"/object/path/:namespace/:kind/:name/resource/info", wrapper.GetObjectResourceInfo)
 	router.GET(baseURL+"/object/path/:namespace/:kind/:name/schedule", wrapper.GetObjectSchedule)
+	router.POST(baseURL+"/object/path/:namespace/:kind/:name/template/render", wrapper.PostObjectTemplateRender)
+	router.POST(baseURL+"/object/path/:namespace/:kind/:name/template/upgrade", wrapper.PostObjectTemplateUpgrade)
 	router.GET(baseURL+"/pool", wrapper.GetPools)
 	router.GET(baseURL+"/pool/volume", wrapper.GetPoolVolumes)
 	router.GET(baseURL+"/public/openapi", wrapper.GetSwagger)
@@ -4645,214 +5265,257 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9e3Mbt5LvV0HxbFWSvZRk2U428a3UlmPFOTpxbB3JPlu1ka8KnGmSOJoBJgCGspLS",
-	"d7+F1zyB4QxJybI0/8QRB49G49eNBtDo/msSsTRjFKgUkxd/TTLMcQoSuP7r6PSno1eMzsniLU5B/RKD",
-	"iDjJJGF08mIil4DmeZKgDMslYnOkfyAJICJQDHEeQYzmnKX6A1VtTCdE1fwjB349mU70by8m9hOHP3LC",
-	"IZ68kDyH6URES0ix6ldeZ6qckJzQxeTmZjo5yjk2ZDSpSvEnFLuv/v4qn8s+4BNOs0R9/lZMpp4uf17h",
-	"JMfSwwhwX/zdVT63hjRjLAFMbQdA5WuSSODtPhIipOIxqEKKy6qUv7/iY9kbkZCKdqOmJIJPGQchCKMv",
-	"0O+XhMYff58meAbJj4py+Pif54pVJYPezf4NkTyTWObiQxZjCfFUYeDHOWNt1hU/YM7xtR7pcZoBF4x6",
-	"uUnKjxo4ln2EUYQFoiwO8blScdKNnjckJdLH45RIpHmFIpZTGehIl/OD53A6mTOeYqnoofK75yU/CJWw",
-	"AG4IYIt1E52wxa6mGSPPRFcmuD7b+/v7tdkWJP7xB/w9PHkO3+3NosOne8+fwXd73z+LD/fmcPgk/vbZ",
-	"d88A/1evmVcDZ0nCrjxg1L/rKU/YQoRGbWqvEaU3bPGGUPDwgkPGuERySQSieToDrpidYSFRov/DFgio",
-	"5AREcPap/tYioDrBSmOKDEfwTneMkzYl1BXp0IruexeY37K4qxcWAxKQQCRZFQD7oV6NfPn0In06xX/+",
-	"CPmhVz2eYLlsd8+0qhhCgFIknYtBSVA8O5xewew/g/SE2bIxXRvRIcJibglRrQskGRJAY41/NGe8gxTR",
-	"R/ArjddFehUdTpFYRU97Ce0pJPj6VZILCfz4yG8IROYzIjEqbApnE4iESfWBUf0nV80FhmabuSDxEINg",
-	"Ovm0t2B7to2SUke7EhEatGGo/boV4a6RgXaMJu8UUuZbCY/nSLeACqUFSOhVVxGoqRHmR+ArxXuBooQY",
-	"+vfR8RzNcSIAMY4oU1iXgZYqTUA6gziG2LQekgVuCF6jhPXYPgjgftbb0SFMY8vdP3LQGFpiMyzOmEQL",
-	"jqkmHJtiKQiBF1AaliKDiMwJxCgXwA3hKMNcEm0zECqkqmvHWfTylSgLhcaZO+J7TGKHjLuZYojQKMlj",
-	"QMQBSmSMCkAxlliADLLb4M4j72uEty4Ylk5FMYnDupGDYDmPBi0brk5AQ87F3w6nJPMqyFOWQAfzcEYQ",
-	"Z0lolbSfPKz5Dw7zyYvJ3w7KPc6BKSYOVJ9eVXdmhxzmjmNKgJ7K5y7IEKrWhV8JjTXNtFxgbDvKDO/U",
-	"JV3D0+2W3bjtm6ebDVRW2aaxTsINO+ulz1ouQUgvPGx3LIauYfTRvmVnCYtwsmTBHv+p5vQIEpAGmd5N",
-	"pP7cZxV+b3e/Gs1IQKT1kmTINDFFV0QuWS7RjOPoEqSo298Si8u/5fQKUwlxr/XaDYAIPEvglCXJDEeX",
-	"wYGYYhfclVuj1m3r1e1w711vnTFHwGEOHGgEUyQilpnFIGJ0BXaRuoTrK8ZjxPEV0tuT/ZIDHqJeMx4F",
-	"KZozHkHP0TV2qEO2m57JVza4RoBaAirb26sl0GJ/SxcIu/HuozOQ+qdacYsTx9of9frJQeacCoTRTzhG",
-	"p2Z9Q8A54/td+P4VrkNDu4TrTkmqD/ElulwJybieLXfO09Wt6O53rUD16bB7JdRE1GhSXA/TddWTLItW",
-	"yZAykVZQl2Sgq/1NBPkN4NgcFXj3o+ZrP1yfOvPnjMShBgsT6UJoM7xstzjayHPSHkHT2HA9GctBmeMV",
-	"Ojq6b3Ta2Um91TOQwTk05tWASWQZmGNCZ/5AjLBA5/mTJ8+iyyv9L/xu/iQ0hk/ml4/mF5aZP81fWnWZ",
-	"H4y6RyxDCbkE9CP6Pz+ivR/bQAEsf5zznEgxBCpn+UwNNMQD83Xd+q5beo8XoWYkXvRsgwWbYP1a+EBF",
-	"x5zmtOesVpdgs9spFmEjqLtehG+U+jTGvSbn6ZMn6p+IUQlUzw/OsoREGmAH/xbmJLufdXfC2SyB1PRS",
-	"H+e7XxUtT588b7PgLUOvbO8308nzu6GnsiKZXg/votcPFOdyyTj5E2LT7bO76PY14zMSx0BNn8/vos+3",
-	"TKLXLKd2nN/fRZ/OxHhPUmC5ndgf7qLnV4zOExLpLr+9GwQfUwmc4gSdmQOSn5VdZfq/E1CpbkkE6APF",
-	"K0wSZalr/WirqpZf8hmRHEvGzZWMvsnjavmSxGgfUfzeRYWtfTOd5Dzxa+XSJPxdF5q6pj8WGtCcOapW",
-	"XuZyeUznrE1PCnLJrLnlFDbQPFXNsgyotgBmWJBIrfffPvlBdWTMiEpPYVPPttHq15yOXZhPrVauIEku",
-	"Lim7ohc5J+sZ0Cg/rTT/sVnWjTjEp/fsEmibYPiUqRYusKyZXzGWsCdJwO51TXVTX2na1fER9wpneEYS",
-	"Iq/b1Llzve6OdKnupo8lpO3mYyzXSk6FvJupOTSpYKnRgw86Kazv5C2L4TdVrjk0e0ij25gaetcPVPQ+",
-	"pWqQ7wF6WeINEbLNwg26Ed2M1P18nK6Zc8sY072XJeaM3iOi2uFgLcmmuvFOUO3pa6t+ldRsaiVhiOlX",
-	"6V1BeT9daqs5ldpgjx3k1N22WVI6tWl9yKXUtUq8tawIfX9XjDtUolxG2iVYmhKpbOA21kS0xHQBcWAH",
-	"WmVAWdY31KO3Z6cQMe7V4Fj4D76dtmh9CGip6UTKxHd76wjqpdds4aklzDTaoQqO3p79L6PQWzZLVnik",
-	"/+j0p6OXScKiwhVm+9XDrI1DNvrmni0llHE/OzPGZeCavMpPXcw1NK2vTyQAlMJDKbx6FEOZXUv/wVSV",
-	"iPDEYUgZfcMicyfQWAgr94stjnKWS+eLsIYF1WNsVytMzInPxMlqxk2Q1yGG6oZDZmRUKu0eus8IpWpv",
-	"LcgrvG2pSttY0ZaXbCIuPQiAVWadEgIw7wFrFkPin1ZYEEb7r7KnurxPjAX5E+pCF/IcCqqm6WQFNK4J",
-	"YADgWpwdZ2zfRW033kKruUGGmL654aanzGNpFK3elrGmibNNdQ2r/8wWJPtUNBGXW5hmJTEBVu3IHDvi",
-	"ZOWzxra08E2zW4DEkOUbu/4iPi9SitENmNCSIz606K/b4KVCUphrOwKN9ld1xLZcCPTZZ1EEEYEwcnf6",
-	"QijlUq7MhGJ9rNuaxl84yzMPL3xrnE9998Ov1olBEGsaNsewGYJnMsp2PxeACwr6A6wk2gNf/XEL9Fbo",
-	"CfFrR9D9O+bxFeYwaINRRbjve6FDW5+CZki/nYZdq6sElBsO261tq2uwm2O4YJdnWmqtfy4kV4noj7ca",
-	"6R48u+9bQLpOWAf7dgTs45OXccxBeKx3XH5ozdE8wYsYMg4Rlt4NfF25vk7w4qgsrq/r5NzbcoqjwO/G",
-	"ZN9QJFSz02JIrQFYgmw3HbJR8Gtz4ShZ7pneevufSzxqVPQHb514j4AUBbaQkAZtPh4eVXvZgYxQITGN",
-	"YNPDR1e/PH1MGSWS8b4Vf7PFe58muoqV48TgqF7qm+2XUQSZ95jO3qNcDD/oqTtyVFleabOL4aGjGpxl",
-	"XlUQLSG6FHka+EiSmJubjv7usDHPfMeT0wnQVUAzwqeLFH/yH22Zr4R2fJWYL0D6C1jcXOAoaFV0Hi0x",
-	"Hi1BSG691Log9K5SVFsk3D3668+8oBmTJTiCFKi8yFhCouu1952u/Ikprg8Jmf+cJeNw0YNPGSeM21uq",
-	"NqOde7JbCInx7j2pwbD79MY0UMp8C+XagXEYQ1snP+GDH+O6s/4O1xSrkMkylrDF2il578rdTCe5edM3",
-	"4Ny4oQ+UQFfEtyKsRgKNuFWEqyJJdbFpyYgXENPqyWlVKKbOdnZ492C1gp0qUNyElqyvMLPGo5bKs2qz",
-	"mESj/fZfuVuf4useSd3huJGtyYLIZT7bj1h6wDKgYhUdsPTZQcQ4HLiGzBNK+8cWdkvRnGfJrba+qdVS",
-	"LHdbXJ1WCRlgU1TJ99kt9vs2ZkuNsA4W9jNaTJ+2lS5G/IazTXVYdcLD7duJbV9w+Feg5mViYHyFZOiW",
-	"OgdY2lIN46yy4LdqLxI2w8kFfMr85DRKXDC9jRbr27oYrgynEyIulvgiKdx22+YGEes+Zxz0m6zYX0I/",
-	"Yegab7XARoOo69gL+ARRPrSNUheXJmeXifmuWt4c4TWaEBexvT9t86Ri1LQmdWcWQMV4b5sANdu6py1t",
-	"NgF+8dJfNpq9rdfwukR1SEVItKogb4hEA75hsHoQFEJEjfuOpx4OdgK7IXl1e6DWSGlQFHqprx3gELRb",
-	"QyB0aavdBvu7/kWh/dacsz+BDlWDNS0WwxzniZy80O9Sm56OrigiwjwMJXPzTN8+VF3q6A8SzQAosnOB",
-	"4lw/qsHndAmYyxlgiWJ2RRVJKGIr4BCj2TXCKMXKmqaKVSgDTli8f071Axz9qrT1FQGNxbT6UlYsWZ7E",
-	"aAYop9Z9ZXpOMY1RQfoVSRJVQIBUZOlx7uvoGR4NjoW8EBLzwUq18jax36QqPuBkQIWMsxVRwmQmbo3v",
-	"alF0l3q2JKaty3NKFS+G7bUinIB/d7j9fkfLmBWeqqi0Z7kyfeW8tNROlf91JeTG7ga00U7E8nY3CujX",
-	"f51JxuFnGzSjrwFdqXbtm6/a95ZWm13bp5lr3Hum+k3ZWvPUPDwzjfqMU0vMr7CNn2W9keDGodHX9ued",
-	"7X7bA/BzabqB4V+eavRwdqr6PtrHf6pyv1Fsw3kv4szDryPs2wHpF1zqf6oChel1a1imoHcEpv3Nd+xV",
-	"An3AqbS/6Z7dtrHNlr1CxoAZqtAenppthK9KVZh5uxK5Chvbbpn2+W58gf1LFxEXRRn/Tse+UdyRyHbu",
-	"1cvOGoRN6wPxsqHBZLGKJtPJium1cq4XMVC/5EJZw1SY3yL1z8fAXYT9keKU0MX+r2YiNlzGTCNlwKgu",
-	"TxZbYEM/lrcgrxj3+CjqZ9oDj+HnHAKGTPCigJb991bXHc6GuYA+XqZ1D2lHg6qOF5pxaiC2tQ7Nb5l3",
-	"fOIR/WytG+dJY/yd96yuJzdfXeIUvA3h67f/p55LNB0exYqcC4JlPJMsMZ28GaZuS5Z68FV83ELdNujy",
-	"KNx6L9sfkLbmrq8zY7d0bPIWoM+EbTJdHZO1g6laM1G7miYrTptcvKu6gy/dte/E0At3Vanrsl19v4cX",
-	"7RUGtVeawAV35eTjYsFxBBfm/KO+FS4jpnoc4XF8PbzSvxmhm3UosoTI8F1w852jvmkMjrJBv5+yRp9r",
-	"ttlKh2992acXAhfe0f+KVgft9EXO0r/rY7QlFLaKDkJjPu0XtmsPMXijqvg0Dw0G1SsC6lkSqpEXNBlX",
-	"S+AmuKulVR+i6RCImOs4fIQudIS3fR8AMn9IRdOAb9iSISEZxwtAmnwkMDX99WbF2cu3OsKlL/ZGFW52",
-	"Umo30obePqgpJntHuNl4q+keSbYWA9fq53pL6wgYsL45kn2rZwHwoLXQDtpaQExV1OD2orQ4Mai3oH+u",
-	"N9EMbNVtZIQPGPRotjAECtYGJn6HJsCga2bfwVGw4dD18dAb4k0u3W7/UvZuL1Qf6X3m57yc7H+arxeM",
-	"re8Sa+tF8A5xYUOctKYFZ8T/exGTZOOLoFZYE58hruph6UfvBjeWC+NeEiLXc96xxpGjtNFapKeEXuiL",
-	"o4sU0oB/aFFEXOGsx4mLmSgzLfVJKFhVv55aaJ+8Oimtfus3+XZIfdC57T1TDZzCWcH9FzNVobnqB6wu",
-	"sSOzy0RXWPco3Q8wE2axi6uMx8AhTnG2/8787296fvpRTTCNWAIppgdlQ5rqVAvEZorVPT7QhX3LsmGJ",
-	"/1ploHfCrXpjG7nwn8jbC8/ePnZqWrZy0B5+Zb8DH+yiiWIF79XCmXRe5WEn7i7v7M29DW7X53oz3+mL",
-	"AiwXJolID5+Dfu4FfdylLYirkG26RJdeBz5f6AYGat7RdbcE5x9d84pujb7bpCnUw+Zb1Yp68exbKq1v",
-	"umU1TWyzaS2J6L8bqxDuQbH5usVmr0pSkG072vBVGNgiduClZLj5Is9Gf1Xwrq6dC/e0CWX6iYJhxRLr",
-	"0xxjrnPphVFtm/XPHHLfgbBv7zbkWLi1l2uyqNm+j1knOLrEC88RPObRMrz2JQnEbUMa+y2Exg2cq/+y",
-	"ac2oyvvvVQtdd5eiFu5q7c3MdLICLnodDbsDFVt+anhQXONUB27I6GDo5vrLzYhHCqttf663oxUa+muX",
-	"KuEewbOft1BfNarCnNuRO8cJlkY4GpSGJaOwnTeRBJ2WKhB0yKy/PbBtGqlUqQM6OMxtgKy45J8MufzM",
-	"ILYjG4IwxwwvgGW03NDxsVn3uk8HHhfI8gLM8RnHsfZHx3RhgjelbGX+pxHcpGT+tn6UXXrb/N9669a9",
-	"4VM9BCdvK11RTL4XnK71HeiJxp6qYVTog0B/hi5XERWGuKNPZ6Uzlsd0kjAcI7xyoSMF0vt4/Zc5ZYwY",
-	"1/9mHLC20Zdk7jdZGru3YO6wgjK3HygD9UqSajdmyuhe5a8DJYo5jWHu79huEhseAC7CaHNm11px27hQ",
-	"9dgELhUjhwF/wA5znYdVjzZWLMlTCO81O11VlgYmNe43muztp6UmdqCOVVDwaT/Gkm0EviDEJ++u7e33",
-	"Naqpf2lWdb9W7I9LIi4Yz5aYhh64hR7ghw5eemOxFYxTO2laP7TK8+2SwjVIMIwZjgfL0AAqzNctsVEl",
-	"LYCQSj+7wImQLsblQrxiVHKfCkxgBUl9zSDmUNpRFsMsX2hDTv98hblOzKtD0k8ncyyxmTSqA6frNWEt",
-	"9abXbrLP8tnLyB9jtm2FcHCrVfkvy7xLgchnHj8OE+izko0xITpTVxkqvkjLsZz97XCff+qVIcVrc2gK",
-	"QoN3h7knnC38MZWIuNBJBHHS5wJ1Qx+w8IVq2DvM1QkNTRnUZfRcl6KjPbtFdOENhlCGJjaj2Cwib52E",
-	"jkM2NSxzFGSwempx2BrU3CUJ8wSpXtvq2RXx7gVjEJLQIhBzWOWnhFqleLgGpNUmQwPWKR1/MykxgwF7",
-	"e9zf13KmumpBCycVi6HBavxhfc2U1vozrVfa8g7dJszwTIO0F0rNtE7LPMV0T5nFeJYAgk9Zgg1zXQbR",
-	"CElmXpyyKMq5zktn3dbOaWZ6rD3mrPs15IG8Q39///7EPSGNWAzo699PX7/6r6fPDj9O0ZlNRPTdN2gB",
-	"FLh+1Dq7Nn0yThaEutSsc8YD1CEfcVUrk8gEfDwRS8bltMkakacp5teNxpFqdx+hY4nO/v7uw5ujc/r2",
-	"3XtkdpsmG2uFMMnCZE4RfIogk+dUDSnLecYECJPzPMIJ+dPMytewv9ifolwQulBVlc5eAbIJV84phQWT",
-	"RJf9v0gAIA9bn+0//8Y7ZS1Rk+aKRbgba8OzAPYU4K4DjzkG7hVM1lSvbehmLexZpr4cVkVa/fBU7TXd",
-	"6Y/64VlHIH5nO7gsty6Jq+m8y9nMsWGL8yLHyIoN9ll8CqtDGWBJVhngM1ft922M1RphPlO12scOzi/q",
-	"F7HNxLc6E9EUuRs+xDgqcgVXrgabJwX2tX9KPun9oz4fkDwHn0Voo6QPiuW+cEGCN47y3uMx6/rg7N2B",
-	"1ssUHybiuiHaNwn3b0m/wHHMh0enM2mrvacbmzgAVhKJd8PcpcsuSZ8OsTcagT6KfoNzZXww/HrwFqfr",
-	"IoGAp8utzJloJoW5x9OpWdNjSjsT7jTmdkimhzooPGtDpcgWy0OLQs8K0exp+9MMF6Bj01dc7VCKPV9y",
-	"eSIw9XvN1QwpctMxqpDnHhEXNlF2HAzZZcfRUUItnPHs2v+dlxtWbwRL9fEidgLa46lUK+lSOYQGvTXi",
-	"ppUjnHq3feOLNJi5mzgjrtFjOme3H8SCB5IE1mzvNW8UKqu+eclrQlyEbermEIeoggZzvEqnLLOV1mkS",
-	"6VU7jb52p3c233AUmquL4G2uqQsNtcVmpErIBpOyZu53Me/r5nzH8/2GLQbT+IYtglfrrTLhg3gPCAqz",
-	"vM+pelmha4C7Crm5ceQBn7LqJDj0xqqygg1YyN1Bbdvwazrc9Vt1dhtfL0Cs5zWukIMsYA4pJrTuIhHa",
-	"TJZlp0VHXTNUbORDL3oGOfxXLiB6+2Y3rybskUD4lUDDSGsreGO6tM8lloRK85qyOIwgC8o4CISTxBxG",
-	"IMkxFfrJBTJ3P8Ibkw9oZN7Z1LsgNCYRlqC6wbLRl0BLTOOkOLdFuhGRJ/osV7/IETZOoKErRraN5XUG",
-	"fEUE40jri0CgQGLfvdRpuoTrPfOWNMOEC3MAExO6QApEXN8dqP83E2yT3UcsSSCS54oXsHdFYkB4xnJp",
-	"DpbdmKp0lBOUuHeynleNiwGKuWHx10clIUnMZNpbQDJHRLrQi5KTxQI4wsg2YCcTuTiO57Q6L5RJlGcB",
-	"rlajKDZmu+SEO7fHiwWHhZ5QQiVD74wLvT4KAxwjNkcvV5gk5dmYqbh/TnXCcIEIRa7HsvWY0a8kEpJl",
-	"CIeAGiB/wJuJkFJYt+WobFZaMZEsd8y04OQKXwsdGDObIlgBRXgu9TzpsQ0b2dBk5SY8uwdKjcgDplwd",
-	"6TqckxBkQSFGknnvkfFioHNRv5AxTp85pVPc6hs5M1JVSkotbmQrPGR54W53cMU9huWOHUcoE059RXXc",
-	"2fr9Hi8MbqXgmdHepbeieb8yS3B0mRAh3Q8LfRetfZNMRNfJdPJvpj8lgI1HI2N6vH/kWErgXoPdRWzw",
-	"uO0SSXCPAwfbwnFRXsPBPSDrUfO9KdwyfYsGi/Z8K2Kre8+6ZD+5eAJLJiQSSq27CBcIaJwxQuW+wU3v",
-	"CAcYXTGexHqNyCn5Qy80lfYQiYFKMifA93W6XueTQf6g+0+fPHm+d/hEoWI/n+VU5i+eHL6A72bxc/xs",
-	"9u23z8MeGy0xvs6KcAlF3/oust6riATpG0IhmA6qyfLN95o+7DQ3TN7ePpeLtI+Y/ptD71A8urFZbov9",
-	"qJ/gHmze0WWZa3YTPnWwZgccWcOI3Y7/faEQG3Krf3eS2wi/cy801A97h4daQ9l1a1/w1YsYVk/p4b6l",
-	"d9+MYv9wuL7Cd6SxoiXEeQJdnnm9ffn11pLnw2ImFJXmJOCvYGJg51EEQoRLUfg0vHPLqgu7sWE8dLRu",
-	"ijWsZo/xWWFnz2cLRRV3vlvlYpM9PmbUh+4bk38AXXDYYuFyw7mtQ9JdpAWqDnOAfqwyx6eB7fdtVHCN",
-	"MJ8Orvax/SFpeVriOsjVViJmV7R0EK6+yFBbg3h2jXQx87+6sNeC1nuH0I1YhtUWGJKAJ2U9ga0t2jtA",
-	"fbXn3Zzj1TOj9Z7PKiEeyLyvhAgo3bbnmCTMZOX1PqqpvJh301apMk/gk3c+PghfjvY7TYatSDjWy6rP",
-	"AQ7nQRcdbB6nhCLrDN1dV0gKh3XFKYgMB9zrOL66KMjqtQaXNdyAqn0EubWxJtbT7VEhRaufa6vgCOiv",
-	"FguSPROqvm2hcUtiAqzaibmrffmjnBN5rTR4arNQYEGilxb0miCtBdWvpbGylFLHgpkB5sBdafPXa2fk",
-	"/ON/3ltTwjShvzbbuKkcBlvv0IlVeuacGZm4T8UD+Mmz/cOn+0/NcSdQHaxr8mz/yf6TSSWK5oES2wPX",
-	"sDXm1TwY3/148mLyC0hFuI2R5GKi69pPnzyxvh/SBgnDWZYQ47J/8G9hTFAzW2tDfrk+9FDrqvPdr+rX",
-	"m6klV7JL4/6UMV/Y9lccsAQdV5SDzDlFGP3j7N1b9D8wQ+9VXW2eRwlRbIswRbkAhJXZrohg3Hoh67xC",
-	"MXBEKCJSoDlLEnZF6AJx82ZC7J/Tc/pe3wjoHyBGnCVgIplCOoM4hti0/JXWGl+hKMEkRWSOUiyjpWpM",
-	"0ZILfk5dERtz3/gu1+fihAk9GXoUJhMVTkECF5MXv/v5WxY5OFW0TW6mTYal+BPSPEXOn2SKUvyJpHlq",
-	"4lOip8+X+pBy8mLyRw46zr1dXioeKOU8lxudwyepZ5vz8ZZxZNgTANJ08tx052ulIOtAFdJlD/uUPTRl",
-	"n/Up+0yV/bYPDd8aGr7t064qVFVVGhAVJfX7RzXxVUX0+0c1EeaM+3ezfn/UQmad6g7MNucAz5zZ5RW3",
-	"l+qzuRcz+YmQrW/vmMwtTS1AiZabUzBH8jYusLvVMbEZkQm5aOHHqL7O08+eQ2JhfShtZG5N8i2izBf0",
-	"5V7j7fmT533KPjdlv+9T9ntT9oc+ZX8YhvktcGzB54fynAMYn24/ll/r7xpsZonQtQvgndMTDiu92CYJ",
-	"sk7xDrkCxRDp/bmY6gc7Vgu6cgJJfAnKztct6cCDlbxyJngXmsGccbV4Xdfy0hV4V7KgD9WuhYR0ek4r",
-	"dF6pZUe/FAKUYooXavEpId5PdAwLRtmpyc5DlYecrpOID7ZEh0ycgpAKs0F5UMDX64KLNHC9iYAoWqsi",
-	"kgBeOfvJBMRwF88hwTHCYiUHDRCcKRIM5RRLCVRZdG7Djog4p0C1Wy3CC0xoLxFzPB2F7GEKmXFfcTKm",
-	"r6/D1lMcI4woXBXZCqpCZt0Xyu0Fzogu2Np5cJTmQio50bsIiHXFrzhj8isF7a8UGV+Z7UlROeMsAqEf",
-	"WdqeVCnXpnGQuKbRkjPK8rKaftXqmKdKCbUkFvlUa22Y5XKJhcndmuWzhIglqN3N+yUR9jsRJhw+xHp0",
-	"P57nT548i3BGLtSf+i87ZGa3Ye5RaQf9U72vU7+WOzfT3ZwkakM0Pad76B+M0DNzJD8N9j3FaidnP5U/",
-	"o6+18nGTV4xSl9Y+V1Vl+Y3r7ti4gnV0p4axV/kc7PJKbS4TncYE4Vp3RW/aCWnDvjBF+pWoedCrtoeK",
-	"ieZBTa03Hafhm4DyM4Ek/mHcOBpb1vabaScHOG6zMLAJtU6s5ZmOiabs25BSuLqwxVNC3wBdKGl+2nuP",
-	"+uXvJ7dQc9q7kOLEq+eMf05Q0Z3CggizPuuShYaQDJloZQ0AoxTSmbYFBum5N6rx9YquTsOGmq7eyB2r",
-	"ulrn/XSd5s16ZWemw6fu6mrOlvMrOt3Xek2nRxFSP7o768zp0W66i3XqrbODXeq3N9Y/ba2Cc4Zrtf0d",
-	"KDYWw96VZHtF6L/PoN92rlsStjiIKkGTrGoJzkElxpJhGwj5E4uvd2ZX+/vyWNYCpPNjTtgCuUch9am8",
-	"8U9CN6efupXkkaw6hot1XJSOwqE7Cxu9ynmxDjspf+uu9t45x1tF4ppKZ2BcNso6t3nOXRtfx87uoU18",
-	"PjsovZvW6YMyeNlta4OyJ89cuDNw6jSCyGdljDMxqoXt0UHFQZynWUUj1KfgKE+z2tb66O0Z+pPRIqiQ",
-	"7+RGqZG3Z6rqbR7VHL09+19G4aEKMRV2jgqXnA6tfVzJXDFMZZ9guRyird+yGO5GU7sxaWcEzyRrV1n3",
-	"dsjEUZiWj5xobN8TPbKdpsVKHToHGZbLg78Kz5ubg78uCY1vzE83B1k1WmNwbWjFdhyKNUIV2gojoQ/c",
-	"TBWds753adWBhebtLF0tRnjQ+coEeVOqswCpA6d93KU28PNEO7eZnapuTO1T7XvL6qO+mMR6P6ffLUG8",
-	"33fxGw9eys1RX3EoreT1wrChpfwQRKHBAo8QKPYh68BZPK8bYTsQtpVc+6H13yacF+vOUaoPPMuToRmO",
-	"LoHGyHUUOFSx4Z8KbNyle1I1o/7DNPgc82tzfkCyHtN+fPLQ5/345PHMvA1iEpxze6Ez8GTmzsz2Iut1",
-	"wGTXx8KjuS7KtNzFtB9ECWDe4aGrPgtz9C7Q1xVfkKn2rYD4G0Ro2zdQ25v73jN4NVu62cl4djJ8vtY5",
-	"gFez5N6qwIkuF/AHxnS1Hh385QJV3gTdbdtgP4Gmo+tGRjuLoWJXj45ID8ARqSfGYo4J7YuxI114xNiI",
-	"sUEY6+lr7RZ5/7JeorDwS94Ohn0OHP6p9g2nzuHkjMS3b2habR5FkMn7Dt77BLIsF8sDLGwQqJDn0ZyD",
-	"WBrbXG0TnZOle2Ov/9KNoJiIiK2AX4etTDNVJ7lYvhQmvNIjR+QjQVlMxOW2IFNtDMPYkep1hNjjgFhW",
-	"5BreAmOZSX88DGYmf++Is0eCs8vF50HZ5WLE2MPHmIgwPWgm9e0GW3HUV62GIhwtYf+cvioejiHVNgVu",
-	"ntibuLdl9N1IP5000RCo/hUUNCtBVzkxz8t0i9h2o5rKhXFkNq+5EOPIBulEc8Ay5yDQDKsy9v2lyW8m",
-	"3fsyurDvyuwZZcBTuETKWYTLYREQo1w8Arm4Fhyyzsf0r4ySLZWveRtW1FynZc+KLu4MT68Zj8aN9UPD",
-	"6oCXwX1PcCrPXscznBFqNy0Tweu4e2pC+Og1t2ob2FdQ9jHsg7AQ7EXbTs2C2wR9yfR1Tg0j4A3giwCE",
-	"XRetRejD29aSP69wkmPZq+xxmgEXjOrit3qXo73sXNTFEVK9IHVg8eTVoL+AVNoK7Gwj7CKM1XwsTEM2",
-	"mheak8SzntcA+stdnn3/aigWA6oMQbetcmcgt8MZ1eYQjJtnuWGD9AgSkICEyf8tpiinAtxWSjrQi8Go",
-	"L9yLdNkPhoo7Q74Z1RDgf1DDHlLhTBe/VUuBpSmRo1XcB+31qAqVLD2hEzRdoPrEQhug+tl8JVCMhruO",
-	"FkpjtGJluiKBYqZfZNh0/s48tZETwL3q10YwZTqovs56xHJeC7VkXoMIfVp8ja6Ijtgnz6nk1/oM2QZ3",
-	"KsM92ef2NqWWGsV+5wv70yLZza3YxKOLYPB5ZQ+gimUudTTxIFLPlrnUAceLWGJhTOrwXNSkkCqRbULw",
-	"tRBZQ2U9/FcGnLB4Wkel5Nfn1ItILJBgjKp/5RIIr+S7t2H37CgtQV+Jc+qCVKifu/F75lg0FMBHLshq",
-	"//cyd7IBNMM6IaNa30BeJMs6ZMUD/I20+NY6XAFcekQlp5IkNmJeUf9iwXEEF0bqlFDAp4xwiNfIhWLF",
-	"fT7oGHG+Ac51+KHgplRtfYAaPFuzRVcQgWf4usjPKxsu4bZt7yEK9w1Jiex33AJUvtbhmG4rnIiET9Iw",
-	"fk9IDjjtj3FN3bgh7YtxPosPcJIwo086z160Guez2J4to5RQxhHN05k+paYxyhiXlTh+ptnyJNna8aHj",
-	"mKPTn45elqTca0VaJ3UnSLsfmzaFh9bxbsPhGWS0RHPOUoSN4sMGF+1DCDTneJGGo5K4ab+zo+Kys7sB",
-	"yXj+WwfeNGQnWget3oBShbXNmCRdTiqfH1y3E/GiPjZ7P+yDWZl7d3zmv71yVOueWLtIGmPQFg5pPf35",
-	"fi9ymsTRlOqFjZ6hTPrEjLqTM/m7jnZyyzGpTGKuMSbVkJhU6ACJVaSDTBQ/rHTA0coP0XxR/0FAo0ou",
-	"+A4Ewx0nzRjruCT4iTGjYm0MmyLZotcAcOAwDk0/mXzrD0q0NvQg619tUGmTaHJAhfd4MaQ0uxtdMvq/",
-	"DVQYu5P+WF8Sr70a31ADmNqjDrh1L9JRknax9LZW2tZavNuld8BD9w2E7w7fvY/CNwrfZ13Gigz3YWE6",
-	"cUU2laeigUcrUkfGbf2UJckMR5e3+NTnjc6fO5rbo556aHpqjVPeWeGS19BQ6IrIJcKIgwC+Mslw+yit",
-	"07OdeL6NKmvUQKMGeiAaqJf/2O70zw58tEb1M6qfUf08APXTz9lbO0pvuE3b2Ff6oeicUXOMmuMhao4N",
-	"N069dMa4RxqNlFHVjKqmompUjXh2vclRDaHI1kZpMLyNRwOd2S5HRTQqolERjYroYLOjmn765hGfyoza",
-	"YtQWD1BbDIy8t4HWuNNAfKNTyShPn1meeriVfCgLbS5V2aN3LRkdRMY1/FHrnD6JDxGmJvUh+vp8YoI3",
-	"maSH5xNUSYVYpED0p90Ovd50s++SIT6GJ1Ejqu/dsyQbs29OEugT2teut96HyyxFrv99dDwv/kA6xJk5",
-	"u0tYhBP9ZYpihjLOPl0H3s4XAqL7eq0IfNQPB1kkwR8uZM54iuXkxWRGKNaZlJsZk30rynSy1Ku67vrT",
-	"XoKF3EtZrEMO7fF59OzZsx8opqzWQ4wl7EmSmrmQErhq7f+dn8d/Pb/ZU/88df+8N/+8qP3z9fn5vvq/",
-	"w+kPN9/89//+93/4iX00SsHKk1MJxZ9GIRR/GnVQFoZa4R2pAr1cFZrALYw1kHBIsCQr2FNN+VJzd610",
-	"Z6r9ByvHfeIg7FqE20EOnvcJkPj8/qTXfN6n7PN7mV6zS3Q7pDFh4cgzZ8BXoBOJJGwhwiFl3rDFXUTX",
-	"esMW/cNgqcIsSdhVz8JvCO0XLVdRLW45qJam5+Hm+18T28FAt69Hay6WBy4NQ5GQfJsUZc373CLHg2pc",
-	"qcW+zq+5WJ7aujYH+nhset+OMB7nsUQ/Cdt2aXCzcUfLwz0D/12sVp97ERqPSm7hqKSfcLaWvHVHJbVl",
-	"DEmTjGjuvbXoFueHvKbd5uJU5dsoWHezhCnex3m/s0RXdhvZOHP9jXLRWy4cz+6/TAw5H7jv8pMRGrbu",
-	"sLg0URUlQ6qgzrwQJbky4k1E+I4Asyeq5d0HWvyyjpLuSbzp7fXfmiDSO1N4o4a5N+cvJt/kQUzEZRA3",
-	"/yJwZSKUq1IhcOiGjkyJexx4lYjLERpDoLHgLM/WY8MU6wTHL7bI/UWHpnCExxB4LDGPrzCH9QhxJUU3",
-	"Sv7uGrzPQHFEjlgZghWS4TjmIMRO1MnxyUvb2n1GSkHlCJUhUMlwdIkXPbSKK9gJlZOi0P0FiqVxhMkw",
-	"mMho2QckqtgaiJgi9xkgMlqO8BgED65mXF73QIgr2Q2SstQ9xoklcoTKEKgITA8IJZJgyfh6vJRFOwFz",
-	"9vLtcaXkPT42eflWdVYQO4JnKHicW2I3biTmC5BiLWrUZHwJgBlxMgQnuYAeukWVWoOQD+KeJzhSBI7Y",
-	"aGLDXDB2JjzV1y+mnHCve+xtTOBo/p0pPBgOCgxDEoduDgZD4QiHiu9uDRDNtSMwxcYbdZNpvovptb6y",
-	"D9L9LjRnNW8EsYqcK0Jswtt0JFsxBbR0Xy1ZYhJ2M44ES/V1HJGi8OIRfg/Us1Vkm9l0JRjuTzDUG/QO",
-	"3tSOt8dDHwz0hjHQbhT/THcBYtPKiOERwzvDcO+8kGbpujvs3Td/LDP+UIbHB7Ny7+yR46DnKnjG6nFM",
-	"2+rP8N8+YdDFHy8UebQEIQ2D/plDft/jUQx7Qfh9n7Lff3GvDW9bhtpZC7uFaLs8hKMUjVL0EKWoHS2u",
-	"W4q2Syg4StEoRZ/v5fsgwViQFegAxL1F4xdXYxSOUTjus3BsIA3eIIjd4rB1qsxRHkZ5+EIWiyzniwFG",
-	"1IkuPorFKBYPWyw4tBL2dAvGqa1w/0WjT2Ctgc55AV5oyVAdEg7x5IXkOdyMwjnacIOlcaAsnn0hkjjK",
-	"wSgHA+WgnlBlnRhsniRllIJRCu6tFFwR+0CmpxyY8qNlVrBiNMxGUdyJKPpy9nQL47Y5eMaFaZSGL+QM",
-	"IZCAZ518ZOPp8ygiD11ETMKL9V6MJlnF/ZaE9aV/XuEkx7JX2eM0Ay4Y1cVv303SMnh8wvJZXFl2my0G",
-	"02sT9e6KyCXCKIYsYdcQl8Ef0RvGLnWyJRM2vNWOTQNXppVBc8KF1PlnGh+WWCDKyjDjtXiTa7PRVNG3",
-	"TQ6LMbPMmFnmS9MP07W24BclF2OmljFTyxaikPskIR8FYRSExyQIg21Gayt6TcZfQCLGEdhtB8LoEq6v",
-	"GI/d4/ugIbm/zlb7BeSXvhuzjxR/NSwRA6oM2cfZKne2nbPDGQMSfHbJzDNle3e8k9fPeVRn6gcxRTkV",
-	"IG1SJ+lEVWwgq00D8oOh5GHIq2HbEHH9oPg6pMKZLj4+XL6vAna5EpLVwvIG1qpf/3WmCz6YlUrc8uJh",
-	"+PUzlZyADnjyKLHcc8fi4nM2lK/6+QuC3225HCg2tPG03t/gS9u3PIRbnFtRzwdAJb82do976FwXFbOU",
-	"12TlZ13nwejr0Yq4Dc3ba9F/BEi6tcuHL+tQ6P5aCGuO9x80Uu/gFPRhmRL3EsGdp/Ijfkf83mf8DjdZ",
-	"L9UWu++xgt6PP1rnvJIJ7qx5zG55p5jdVebkMihz4cQjOr11dpE4eUyD/GjTIN9FxmOFaU/W425cb5sD",
-	"dExhPKYwXoP9jLGky744YSzx2BT1WdDpbLEJQ4zRDEeXQGOkDBi8AKS7UN1PXkz+UCbtZDpRpScvzD/T",
-	"ChaaNumtpu5hLFmHqy9Y92m2l5N8sGJJnsK6uf6XLvWAZ9wM8JHMez5LSHTAMqA4I11Tf3aFFwud5WQr",
-	"5tvJtBH87zd/C35pJlmOcUjw9UEKQtTzIbYYdqoK/mbLDV2edeW3Nl9Nn+VWV3hlEpMcH/Wu8UEAp3dg",
-	"d1ZY8TBlSsNizQlqAxG39Wp6HbcVgQiblxAxlliAtI8wkB4FWgLmcgZYTno+tV533vPkUW0pHBRKbSEk",
-	"lrno9Hm0CkW4nYCuKFAuIEaza7cXzhiNCV3ouds/p+/1s5YFoQcZFkJ7SeoKkqE5yGipd808NX5XmJvU",
-	"EEItyjoBtJtm3U1gm6HBdGbo30iJid666BRSJu9CE5nhPOAFvo5As+fvXqpsUpAtk1atn2i1pA0pf0ri",
-	"u8mJ5VgQQsUCZHkYZRwapyhllEjGjf+jkZHHpegstAzSrpYMp502pC1xy3nujmOgUg1nB8I9mDs3Nzc3",
-	"/z8AAP//vQXjhKjrAQA=",
+	"H4sIAAAAAAAC/+y9a3MbN7IA+ldQ3FPl3XMpybKdPYlvpU55LTurjWNrJXu36kS+KnCmSWI1A0wAjGQm",
+	"5f9+C695AsMZknpYmi9xxMGj0ehuNBr9+GMSsTRjFKgUk5d/TDLMcQoSuP7r6PRvR68ZnZPFe5yC+iUG",
+	"EXGSScLo5OVELgHN8yRBGZZLxOZI/0ASQESgGOI8ghjNOUv1B6rGmE6I6vlbDnw1mU70by8n9hOH33LC",
+	"IZ68lDyH6URES0ixmleuMtVOSE7oYvL163RylHNswGhCleIvKHZf/fNVPpdzwBecZon6/J2YTD1TvrnC",
+	"SY6lBxHgvvinq3xuLWnGWAKY2gmAyrckkcDbcyRESIVjUI3Q3LTyz1d8LGcjElLRHtS0RPAl4yAEYfQl",
+	"+vWS0Pjzr9MEzyD5UUEOn//7XKGqRNCH2X8gkmcSy1x8ymIsIZ4qGvhxzlgbdcUPmHO80is9TjPgglEv",
+	"Nkn5UROORR9hFGGBKItDeK50nHRTzzuSEunDcUok0rhCEcupDEyk2/mJ53A6mTOeYqngofKvL0p8ECph",
+	"AdwAwBbrNjphi11tM0aeja5scH239/f3a7stSPzjD/h7ePoC/ro3iw6f7b14Dn/d+/55fLg3h8On8XfP",
+	"//oc8P/02nm1cJYk7NpDjPp3veUJW4jQqk3vNaz0ji3eEQoeXHDIGJdILolANE9nwBWyMywkSvR/2AIB",
+	"lZyACO4+BeEDoLrBSmKKDEfwQU+MkzYk1DXpkIruexcxv2dx1ywsBiQggUiyKgHsh2ZlcX3CkhDosyn+",
+	"/UfID73i8QTLZXt6pkXFEACUIOk8DEqA4tnh9Bpm/x2EJ4yWjeHaCA4RZnMLiBpdIMmQABpr+kdzxjtA",
+	"EX0YvzJ4naWvosMpElfRs15MewoJXr1OciGBHx/5FYHIfEYkRoVO4XQCkTCpPjCq/+RquMDS7DAXJB6i",
+	"EEwnX/YWbM+OUULqYFcsQoM6DLVftwLcDTJQj9HgnULKfCfh8RzpEVAhtAAJfeoqADU0wvwI/ErhXqAo",
+	"IQb+fXQ8R3OcCECMI8oUrcvASJUhIJ1BHENsRg/xAjcArxHCem2fBHA/6u3qEKaxxe5vOWgaWmKzLM6Y",
+	"RAuOqQYcm2YpCIEXUCqWIoOIzAnEKBfADeAow1wSrTMQKqTqy+b1WZ6IslFonbkDvscmdvC42ymGCI2S",
+	"PAZEHEGJjFEBKMYSC5BBdBu68/D7GuatM4aFU0FM4rBs5CBYzqNBx4brE5CQc/GnwynJvALylCXQgTyc",
+	"EcRZEjol7ScPav6Lw3zycvKng/KOc2CaiQM1p1fUndklh7HjkBKAp/K5i2QIVefCz4TGGmZaHjB2HKWG",
+	"d8qSruXpcctp3PXNM80GIqsc02gn4YGd9tLnLJcg5GQano7F0LWMPtK3nCxhEU6WrHPGU7giwt4wPTNy",
+	"97kH8qqKIaH/VARzBAlIEMXgzRuq/tzniP9or9aaVZCASP2uRI0ZYoquiVyyXKIZx9ElSFFX7iUWl3/K",
+	"6TWmEuJeyoBbABF4lsApS5IZji6DCzHNLrhrt+bMsKNX79q9r9R1xBwBhzlwoBFMkYhYZk6aiNErsCfg",
+	"JayuGY8Rx9dIDQj7k2kHUG8Zj4IQzRmPoOfqGtffIXdZz+YrBV9TgDpfym7oegm0uDzTBcJuvfvoDKT+",
+	"qdbc0ontAT/qw5mDzDkVCKO/4RidmsMTAeeM7weYRy/xZ1iFlnYJq06mqS/xFbq8EpJxvVvOiNQ1reie",
+	"dy1D9Zmw+5jVQNRgUlgPw3XdEyxLrZIhDim7gjonA73a34SR3wGOgYeAS8zXfnR96nSrMxKHBiz0rwtB",
+	"4tq4hd0kz0l7BU1Nxs1k1JLjoxocHdM3Ju2cpD7qGcjgHhrdbcAmsgyMDdLpVhAr69Z5/vTp8+jyWv8L",
+	"v5o/CY3hi/nls/mFZeZP85cWXeYHI+4Ry1BCLgH9iP6fH9Hej21CASx/nPOcSDGEVM4ozsSSye5TSOvk",
+	"tqUi1ihhFEJ6o2u4Vl2qAeC3R3+sTqzG3++2N/eZMJ8JkMFdN197jfQRL0LDSLzoOQYLDsH6jfCJig4q",
+	"zmlPOm5td6l2GNG0a7Xj63Ti7koanGdPn6p/IkYlUL0/OMsSEmmWOviPMGpbP2X5hLNZAqmZpb7ODz8r",
+	"WJ49fdFGwXuGXtvZv04nL24HnsoZbGY9vI1ZP1GcyyXj5HeIzbTPb2Pat4zPSBwDNXO+uI053zOJ3rKc",
+	"2nV+fxtzOqXqI0mB5XZjf7iNmdXjXkIiM+Xhs9uY8oRDxGhsTDNvMUkMSX13O/xzTCVwihN0Zqxdbzhn",
+	"3Mx/KyStpiURoE8UX2GSqJuRls62qxr5FZ8RybFk/B1goU+5jLMMuCRG9C1ZYvW1ltDkQOEa4gssa/pU",
+	"jCXsSRJQZPEl0AE9vlZV918dNJVxamB8LvobU7SasVyheUFsLzFxK+9CcxNRCo3FeF0d7axfp5OcJ/7T",
+	"srpC1agY2rueXC6P6Zy115GCXDKr+LuDFGieqmFZBlTrojMsSKQ0z++e/qAmMgptZabwpcOO0ZrXGIEv",
+	"zKfWKNeQJBeXlF3Ti5yT9QhotJ9Whv/cbOtWHMLTR3YJtA0wfMkINxTTn3DdUN3QV4Z2fXzAvcYZnpGE",
+	"yFUbOme+7p5It+oe+lhC2h5e2X7X0WwFvK9TYxus0FJjBh/ppLB+EmVj+0W1ay7N2iL1GFMD7/qFit7G",
+	"2Ab4HkIvW7wjQrZRuME0ohuRep7P66SfRYyZ3osS8xTlYVHtV7MWZNPdOOGo8fTrbL9OajdVFwtMv04f",
+	"Csj7yVLbzYnUBnrsIqfuUdmC0ilN60t++UewxXuLitD3D8W6Qy3K46fdgqUpkRI8wpWIaInpAuKALaSK",
+	"gLKtd6l6jVU7c30mo3V7ZXjE0tQqKu1vHLAcKExJ7DFX160h5jmV1rEe4At9sFnwi92vwFUuoIUXO6n9",
+	"0VDQkgjJ+Gr/tLS5F432SJoxbmSC9kCYLIhc5rP9iKUH6oAUV9EBS58fRIzDQW28ydfWJhyR+dwjoe2v",
+	"LbSpN0jvB8nWI0l3npqru55hPY2skX79xGBtRJ+0bUnFNgzbS8aj92enEDHuVV+w8D9uuqOy9SFwRE8n",
+	"Uia+hxgHUK9D3TaeWsDMoB3n4NH7s/9jFHrvSIkKz2YoJ8xXiXqwkl4hsYnqROJa2x72VsP8KaGM+9Hp",
+	"eNDz4lXFp27mBprWlTPil5KlF2pYdSqWMlvJ9XeW8MZhSBl9px4H23PRig9J++bFcun8zdagoPpU6XqF",
+	"gTnx6fcZiXtMlJG4Y+DQ3SsqNZYeB79hSjXeWiKv4LalJ9jBirG8YBNx6aEAuMqs41n3odZF1iyGxL+t",
+	"sCCM9petp7q9j40F+R3qTBfyDg2KpunkCmjsVQh8x6/DjJ276O3WW0g1t8gQ0je/tajevgOlGPWmbioa",
+	"ODtU17L672wBsk9EE3G5xb2kBCaAql2duJxc+a4iW15vzbBbEIkBy7d2/UXcLaUUqxuwoUUfL7Xor9vQ",
+	"SwWkMNZ2RDQ6JsEB23IT0w8yRRPlf4iR89sSAiYVZ/gZoVi/NbW28SfO8syDi95Xkl70q2VikIg1DJvT",
+	"sFmCZzPKce+KgAsI+hNYCbSHfPXHLai3Ak8IXzsi3b9jHl9jDoMuGFUK930vZGjrU1AN6XfTsGd1FYDy",
+	"wmGntWN1LXZzGi7Q5dmW2uh3RclVIPrTWw10Dz2771uQdB2wDvTtiLCPT17FMQfh0d5x+aFtrEjwIoaM",
+	"Q4Sl13pVF65vE7w4KptrHwLpN4OkOAr8Li69H/qxhBp2WiyptQALkJ2mgzcKfG3OHCXKPdtbH/+u2KMG",
+	"RX/irQPvYZCiwRYc0oDNh8Oj6iw74BEqJKYRbGp5d/1L03vKKJGM9+34i23e25TuOlZs6cFVvdLuNq+i",
+	"CDKvjdo+Il4MN/TU/emqKK+M2YXwkKkGZ5nfWr2E6FLkaeAjSWJunvn6hzxEWX7B4bdqYxPpZ07wzGe4",
+	"n06AXgXEJny5SPEXv93LfCW046vEfAHS32CJeXyB53NC7ctj/1WarlSSDfunWMFB1Z5dXBMam5BMj+hK",
+	"C2SujWwtuOQCR0EdqtOQxni0BCG5dY3uYpgPlaZa/+IujL0/EoJKW5bgCFL1zJ2xhESrtU4frv2Jaa6G",
+	"YMxvVco4XPTAU8YJ43Zb24h2ATfu2CcmXuWkxnTdtiozQCnhWjytveaHIbRl5wqTipAKB0s/NxrXyvW+",
+	"HKZZZQ0sYwlbrN2vj66dcgMxIeybO8Eo2VaRZBW5ZeSNES4VUVKRG3Uh0WIgL7VMq0bkKsdM3TXCMYOH",
+	"kCuEVaUit9sl6ivIrOFozZMdsQfB/mv3+rvhW50byGQMsH9socIVw3m0j+romypwxcm/hQtFFZAB6lUV",
+	"fJ8KZ79vo8HVAOtAYT/9zcxpR+lCxC8421TAVTc8PL7d2PZbj/94ajoVBNZXcIYeqXOBpVrZ0FMruk+r",
+	"9yJhM5xcwJfMD06jxQXTFgWxfqyL4cJwOiHiYokvkiKQpC3OiVj3OeOgQ5Bjfwsdsde13mqDjRbh14e6",
+	"COyXsse/TYeWAnQBXyDKh4JSivRSie9S2j9U2x8feYYQF7F9kW6jtqI4tWijPD3gS5ZgQnvrQa8xjUns",
+	"dLNiGI7p5Q0rNJWbV2s99YtRz4uQucH5BYL+shG9SY4juDDI9w69tVpSFxIdjB6SFlW+bXB5gyPD/Oeh",
+	"5hB11rbHId2D4k4mawiTuopTG6TUkQpR21e1cSS2W90m9CSvfcX7ezVHodv0nLPfB/l6twVzDHOcJ3Ly",
+	"UmeWaLq3u6bqIUhHj5K5SbRjU00sdf4miWYAFNm9QHGuI1fxOV0C5nIGWKKYXVMFEorYFXCI0WyFMKpI",
+	"aJQBJyzeP6c6ylXnhWh9RUBjMa3muhBLlicxmgHKqfXMm55TFRZcgH5NkkQ1EKCD1/Q6989piZzqoYSF",
+	"vBAS88ECvpJdoN+mKjzgZECHjDPjrwXxuk4nlaa7FMQlMC05zHNKFS6G3S0jnID/8Nj+Cqd5zDJPlVXa",
+	"u1zZvnJfWmKniv+6EHJrdwva6HJlcbsbAfTzv84k4/DGpr3qeyeodFv59qv2vSXVlKuW6OG8NdWB22s1",
+	"7ksd3W0G9enbFpifYRsX8vogwbtQY67trdntedsL8GNpusFdprTi9HBlq7p1mz3QnfutYhvMeynORFcf",
+	"Yd+lTodJq/+pMhSmq9ayTEPvCsz4mxshqgD6CKcy/qZmCDvGNlaIChgDdqjs1LE12zBfFaow8nbFchU0",
+	"tuB1OTLiC+w/uoi4KNr4b102EcCOWLbT/FBO1gBsWl+IFw0NJIuraDKdXDF9Vs71IQbql1xoT39hfovU",
+	"P58DL032R4pTQhf7P5uN2PAYM4OUKR+7/JRsgw29lNqXfW8uOQWhTrgGX2RNJTUmhalKTasyr1wvSbTU",
+	"qmntLiSKbGiK6uM8gRj9h80EwhxQDHPg3GkX1aMUFuZm3k/7BBr3b2yPlfZKXSaLGNTrE11oqM0qfeO4",
+	"9awnXrMaA+a0krVF916jH7l2+/92gGxIWMWEOsMmyGvGPd7HwDnjA5+c5hwCSmzwUYyW8/c+qjvciHMB",
+	"ffzH67EPDgbVHS9gYhdiR+s49S3yjk88Yj9b66B90lh/pweFm8n+T6coDb78cRL3TQxTs2hnpbh1KUyN",
+	"z6EFphM3w47aopuPvoqPWxy1Dbg8h219lu3t/a296+um3M0dm0T59NmwTbarY7N2sFVrNmpX22TZaROX",
+	"GtV3sDuN9ooa6kqjOnW50ajv99CFpoKgAIovbOTiRVjqh9xYqs8bC2N61kayuiZQJsbv+T7SasYBx6uh",
+	"Y3P4DyN0M7hElhAZ9qNobIF5iA8iowG/H7LGnGtUEnUmbP0WTnUwt6URf7IJncLdl0dV/65Nskso9F41",
+	"oEkBrzMz9RM1LIZ3qotPktFgimX3xYFQTRylwbheAjep/i2s2iCrE2IrtTdiSsfX+X73fQSQ+RNsmwF8",
+	"y5YMKS7CC0AafCQwNfP1RsXZq/c637kvWVqV3Oym1Bw2DLx9qKbY7B3RzcZmC5dLoHW4uFHvKuWEA2DA",
+	"eelA9p3GBYEHtY92Cv+CxFTHIu1cO3bPWZ/qI+if60M0M5F2Ky1hY5VezRaKRYHawMbvUKUY5IXhM0IG",
+	"Bw55Vwx1oNjkhfeb8Vm4XX+De/QGf5tP7Hf5Xt7/gUmfO1s/b9eOneCz9iLk+YAz4v+9SPm18dtkK/mY",
+	"736g+mHpp94NHtEXQLvA9SUJ73aXKlW9FugpoRf6LfMihdR/QyibiGuc9TAEmY0y21LfhAJV9RdTteAm",
+	"KK15684ldkl9qHPbp88acQqnTPc/E1WHpvIQUN7EjrQ3k8voVZYlq3WpMDwmad0ZmRukvUhNXQUKQgmy",
+	"VDztbSQLhC6GlLQK9H4VtLy8Of3C5AoqJJca3WXELzw3vEqnS9XTRkNOTZEQ1cJdP/QreKKM7OrKoVM+",
+	"QlzHlDdBsrb7+qfhgAWj6Hq50jMYWNG19XyxU2yN69DVs47tUxDaWadFK3x1wXMa8MUcpE4393bdhchN",
+	"3fX0ZsZcR+h+cWqSyXfJEMZj4BCnONv/YP73F5xV23TyKME0YgmkmB6UA+lVplr8b6ZGuNg+3TiMEv+7",
+	"9kD3sBuNZzKngP9J1Hqc9PbbVtuyVUTQcJ+pHQT9FEMU+mqvEc6kBbojaqgrHGhzd6+bDfLZLB7noiCW",
+	"C1OHsYfTVz//rj4hOJaIqyTbDLMp3b588TUNGqhF3NT9wlzMTS3SprX6bgW+EA+b23cq4sVz2a+Mvqmd",
+	"xwyxjaWnBGLo4RSy9pivW1hIqiAF0bYjK0kFgS1gB3qFhIcvShX2FwWm40dIswRLOAUa+1IMhZVTrntA",
+	"vLmW2je524f6OVJ4Mk8om0yLTVtibaw112guvQRfM3/8M4fc937ks6kMeUVq2Viam9kc37fmExxd4oXn",
+	"xQ7zaBk+pZVG3L7gYr8u03iwd/1fNfUu1Xlf5bjvdHUQZOH9vSMnGhe9Xn6cvdS2nxocFK++1YUbMDoQ",
+	"urmkdTvikRfVse8qiUQFhv5ysAq4R0TYz1sI2hpUYcztyPPvBMto6YE0zBmFlr8JJ5jMBP6n1DIz8Bra",
+	"NoNUutQJOrjMbQhZYcm/GXJ5x0RsVzaEwmwXPwHLaLmhj3yz76rPBKs+JhIcxzp0SdkX9M6rujv6fxpZ",
+	"zkrkb+ty3yW3zf+t18NdBLuaIbh5W8mKYvO9xOlG34GcaIc6+lUcTC9NdUb9+mxVmsh1EsXjNEbFzQGZ",
+	"y4Qt50WkQDr+kuIiKUAVMSrhSVbTAMt8J5CQBZklgbeYFNJgz6DrXFcMJxa95JXxk9MDVUAs4ZkWayoG",
+	"7dyCk+K+XtXrKEymgYrYDURXNMAZTnRgjAn8QUYNVO81OEb4ymWzF0ibfyZTN42IGNf/Zhywvtotydyv",
+	"PzYu/cGq3QWM7hpZ1g6RJNXhR5TRvcpfB1gbSGOY+ye2toUG9biiB002W6v8b+P+2sN2sFSIHCaFBhgm",
+	"1nnH9hjjiiV5CmETRaeb4dKQSQ37jSF7+9iqjR144ClS8B1FjCXbSN8CEJ/wdWNvfx1WQ/1Lo6o7cUJ/",
+	"uiTigvFsiWkoSD6UKChkr+tNi60U6Tq4whr6ozKTTAnhGkowiBlOD6ZfiCrM1y1powpagEIq8+yCToS0",
+	"qcqNw+cpOPne9SrSDHP2HJz4i4rdVwp2UutxOPWoACn+QtI8ReZwVWd9xGiUc66ku9LbhFILMLrGV+Cn",
+	"EO/KXE71hUqAJLlPuCdw1QBwQsybpMN5DLN8MZm6n68xpxMr2pUAwhIbcqQkcqfd2n0xs37uBPssn72K",
+	"/DUN2souB3cOl/+yzHvIqfRF7TPVJJZHBVlqpavq7VfWplzO/nS4z7/0KozqVW01BKHFu9eNE84W/hye",
+	"KpAYc0lw0sd/ZkPP5LA/Tdhn2fUJLU3d28pqDa5OZXt3i2oWGyyhLIVhVrFZBYg6CB1WZ7Us+8RpxYeh",
+	"v9ai5q42uKci0NpRz66J1+QQg5CEFoU/wodZSqgV94fr3l8rQ65ZsHrT3VJKmnE3ek1upaUrc/bxnEK/",
+	"+XXTCwEJRDL0YG+1bdfI1WNW9Smtl4BdRVlUX+W1UK/5hLpvaynOtevG+To7ujpxUtHDmSiQ0VovWNpJ",
+	"kB4NJHBhfE6FsrfH8MXk2yi+Nmqgl3C7gfx4LaYJ1myv4qcYqx+CPmULjuNtD/EbRqciGGOfGYTYkMQ4",
+	"hQSvfgEhvBZ9W0Olh1el1YeM/HTdgleqVCyGZvH0V3cxkrY2nxm9MpZ382051fZWg7SOD80i68s8xXRP",
+	"3cNVhdWq6QSJDCIyJ5HaG52ahkVGD4tcTMI5zcyMtawvdW/TPFAT++8fP564XDORMvb8+dfTt6//59nz",
+	"w89TdGaLZP/1L2gBFLjOfjNbmTkZJwtCkTB1aeeMB6BDPuCq11oiE/DhRCwZl9MmakSeppivGoMjNe4+",
+	"QscSnf39w6d3R+f0/YePzpdJBWJUAZMsDOYUwZcIMnlO1ZKynGdMgFCNtP8r+d3syp9hf7E/RblQgR4Z",
+	"Z0qVugJky/GeUwoLJolu+/8iAYA8aH2+/+Iv3i1rnYDSuAII50docBagPUVwq0Dk70DjhM4A4/1U7Fo4",
+	"bEB9OayytPrhWWmwMz887yhG6FR6y3oWHDd5VySBQ8MWrwUOkZVL350EjFSXMuDqWunlvR/b79vcjmuA",
+	"+e7G1Tl2YL2uOwzVxYUwdaqnyHmiqJQGLocRqriwNE2T9qhNyReInUFS8hx8F7XiKv6Lerhoa9S2tmL7",
+	"xDX21zJqzGpwBaxEoDLH0i5yQXd6Xkm2GZQ60VjVFbIPjNpA4J3OWBH0K4JqZOzWqMyO5j4b4QxXhOXC",
+	"tMQcENC46pUatE0Zo1S1cmXFtUkD56O1YqdPEkyHuqNuWquwYaDxpSq/giGl3arUus4WYG6Vha9rDRg3",
+	"sx9TC69FpKvM3cLVT9q4AF6PTFDr69Z116ArS/8SXYzOAO1HwX1Tcy9UCZLhqey5WsiOMnvqJwDeT982",
+	"81ZBnw7RwRtZMot5g3tl/Gf9usENbtdFAgEv5RvZM9EsFn2Pt1OjpseWdhbibuztEElZ6+g7xipNtlCZ",
+	"WhB6tKbmTNs/KbjslpumwWjXXeiZCsOT37hfOoxmPs6vHasKRV2omEMi1J0xDqbwtuvoaKFUn3i28n/n",
+	"pW3Vmx1afbyIHYP2yA3R3NnKEhrw1oCbVl4b6tP2Tc7ZQOZuknS6QVVI2c1ngPRmQWreR9c6eBRo4qTM",
+	"5RW+ZzaXOEQU1Hv6hU7ZZiup0wTSK3Yac+1O7mx+CXcjdAK8jeNeIaG2uKBXAdlgU9bs/S72fd2e73i/",
+	"37HFYBjfsUXQ2bDVJvxm7CGCQi3v8wBcduha4K5KcGycus0nrDoBDiWVqJxgAw5y96bYVvyaIQj9Tp3d",
+	"JqcPANsmGizkIA2YQ4oJrTuNhi6TZdtpMVHXDhXGrVDugUHBmpW38t5xdY0FODNZOMKzoaS1BbxRXdrG",
+	"nyWh0qSPKQx0ZEEZB4FwkhgDHZIcU6FfuJBxUxDehPZAI5y1pyA0JpF2VZVLLBtzqbT+NE6KtwykBxF5",
+	"ot83dO4AYZPsG7hiZMdYrjJlZxSMIy0vAln2iY3Qr8N0Cas9kzwnw4QLY5SM1fuBIiKun7nV/5sNVgtX",
+	"r3EsSSCS5woXsHdNYkB4xnJpHlvcmqpwlBuUuMRAnjQuiwGCuaHx11clIUnMZlqHFTJHRLq6BZKTxQK4",
+	"KoVgBrCbWZj5zml1X9QTdZ4FsFotQdDY7RIT7i0LLxYcFnpDCZUMfTDhj9qYCThWdsVXKsCytG6ajvvn",
+	"9I32EdVP5XbGcvSY0ScSCckyhEOEGgB/QLxrSCisu3JULiuthMIWO2ZbcHKNV0JXlcimCK6AIjyXep/0",
+	"2oatrN+drlyDKdcWsEFXUq2ZdnVKV1SChSALZcqXrISnJG6JFwM9fPvl3HTyzAmdwgHN8JnhqpJTakUX",
+	"WrUVSt8we4Mr3vYsduw6QkWC6yeqw87WmUZ4oXArAc8SqKqLODaxx7MER5cJEdL9sNBuU9NJUQ5lMp2o",
+	"JIQKJ2AcBDljer2/5VhK4F6F3aWo8wQyEUlwD4ODHeG4aK/JwQX/9+j50TRuqb7FgMV4vhOxNb3nXLKf",
+	"XAK1JRMSCSXWXUo/9bKRMULlfitqojulG0bXjCexPiNySn7LoT4eIjFQSeYEuBq6dB8kv9H9Z0+fvtg7",
+	"fKqoYj+f5VTmL58evoS/zuIX+Pnsu+9ehJ0LW2y8yor8cMXc+n2+PquIBOmbMy5YKbuJ8s3vmj7aaV6Y",
+	"vLPdVdCYD5j+l0PvUjyysdlui/uoH+AeaN7RA7IbdhM8daBmBxhZg4jdrv9jIRAbfKt/d5zbyDd6LyTU",
+	"D3uHh1pC2XNrX/CrlzFcPaOH+xbefbOK/cPh8grfksSqlBUIOZH3jm7UV0ueD8vuVnSak4APj24h8igC",
+	"IcKtKHwZPrlF1YW92DAeMq2bZg2tud2wf5WG0tvddXH23SoWm+jxIaO+dN+a/AvoIoctDi63nJsyku6i",
+	"THB1mQPkY6WXVwLb79uI4BpgPhlcnWN7I+kZxZlYskDqG5NFbkCxk3CNgcFlJFwYYdtJVGQ40r6NIk9L",
+	"q4mwK9E5T3Sg9n61ME1Pr4t6AQorPB0aLFDr8Dhgtyu9vARlv29DUDXAfARVnWMHBFXcvt0EeaY4kV3T",
+	"MjiqGmc7nQgZz1Yoz4r/1Y29VzJ9GQ09sXq8lUKunEXT3uUCqzPvxjBcL73fez+rgHhI5mMlX1gZsjbH",
+	"JGFXwEPh3JX0WW7bKl1Udi/vfnwSviAL4iuO43Ob6ue/YYrAhDxoFAjHWk/zeRnjPOjzhansClwYaq6p",
+	"gBQWgjgFLby8Xzm+vijA6qXUlT3cgqpzBLG18dGuevtESDHqXd09HQD9xWIBsmdD1bctJG4JTABVO7k/",
+	"6TjGKOdErpRKkBoAZ1iQ6JUleg2QloLq1/LsW0qpE0POAHPgrrX56607L//x74+TaWUI/bU5xtfK64J1",
+	"wZ9YoWceLpBJeVzkmJo83z98tv/M2M+Bqq/qt6f7TyeVOhQHim0P3MD2dqj2wcQtxpOXk59AKsBtemBX",
+	"oU73fvb0qXUmkjY/tk4aa8IVD/5j82uY3Vqb7drNoZdaF50ffla/fp1acCW7NP50GfMV0XutdQidJpeD",
+	"zLmKVv7H2Yf36N8wQx9VX5PVJCEKbRGmKBeAsLoHKiAYt6EeuspzDFxpOUQKNGdJwq7VUw038aLqzeCc",
+	"flyC+wFixFkCphYIpDOIY4jNyE+01HiCogSTVD2VpFhGS1eLLhf8nLomtlqdCRCp74WKrVIw6lVMppMi",
+	"PktMXv7qx2/Z5ECZdRWrNBGW4i9I4xTFRTY7FwZu3q2evVhqq/fk5eS3HPjKSr+6S1O5z+XN+fBp6rk3",
+	"f75hOjLoCRDSdPLi6dPQKAVYB6qRbnvYp+2hafu8T9vnqu13fWD4zsDwXZ9xVaOqqNIEURFSv35WG18V",
+	"RL9+/vrZPjaoS7L67bNmMuuleWDuzQd45tQuL7u9Up/NQ6uJZEC2v3201IPUS0dqvjkF88ZjK+u4Z0JT",
+	"lgCZagOW/NQ7VJLodiLEFrXUCRqmm5RWvryK95reXjx90aftC9P2+z5tvzdtf+jT9odhNL8FHVvi85Py",
+	"nAP8DmFafqu/a2IzR4TuXRDeOT3h6s1U6hY28shRrkAxRNrgI6Y6KtJKQddOIIkvQen5eiSdc79S5d9k",
+	"8kUzmDOuDq9VrSRrQe+KFxRoYiUkpNNzWoHzWh07OhwTUIopXkCMKiTej3UMCkbeqfHOQ+UHXksx42UJ",
+	"FUNkpXSRvytZmYxfMXJX12rigTKiTOcpgPicGsMR4a1kZoUGZYK+FAGrAGiqvTd0zJeYqih0hRTHGy7P",
+	"0FSreLPVOcUiAhqbgFwT4mUPjiJTzT76t8pOZ8Ocpk4xlEsz3zlVyeuUd40i65Uaich99EEugV8TAUVD",
+	"/Vxgay8o4xeOLlV4kIWjdIaZ2qrJWT5LiFiCQJbJitis/0aZTaui3TBkP+4sultDMwj5NxavdsabHRN6",
+	"WLQgH+swIybVi5XkOXy9QTlSD9UbJcidSJCcrjtTP9kWHaeq8tRkvDgp2yeqOjq1Zuky0K02OWJzWj9k",
+	"lYuKu4EZgeV8oUJH7zmtnL1owNE7RYKhnGIpdfhqKTeJOKdAdaQHwgtMaC8x4HA6HtMPk8nMIeJ4THtU",
+	"he9fcazytcJ1UTG0ymTWo640UOCM6IYt2wVHaS6k4hNthwBT2/8JZ0w+UaT9RIHxxBg4is4ZZxEInQvD",
+	"zqRauTGNz96KRkvOKMvLbjr5iEOeaiWUSlBEgNfGMAr3EqvzH2hxoir7yEflIGi+E2FKUkKsV/fjef70",
+	"6fMIZ+RC/an/sktm1pCD5Fr4p9oypH4tbT9mujlJJHDlLLyH/sEIPTOvxNPg3FOsbEH2U/kz+rMWPm7z",
+	"ilXq1mova8LyL266Y+Od3DGdWsZe5XNwSlXuCSe6lDDCtemK2bRf7IZzYYpAdTd5V5SBSSHRxHjWZtNZ",
+	"7v4SEH4mDd8/jGdhw+jVfrV0fIDjNgoDZiyX5bemvPhNWhSuL2zzlNB3QBeKm5/1tnJ9+xapLcScdnin",
+	"OPHKOeMyGhR0KuGAMOezbllICMmQSSneIGCUQjrTusAgOfdODb5e0NVh2FDS1Qe5ZVFXm7yfrNO4WS/s",
+	"zHb4xF1dzNl2fkGn51ov6fQqQuJHT2fjCzzSTU+xTrx1TrBL+fbOukyvFXBOca2OvwPBxmLYu5Zsr8jP",
+	"fwfybeeyJWGLg6iSctaKluAeVDLU3twVuz2XR7MWIF1oTcIWyMUp9rpgd2P6mTtJHsmpY7BYp4sydiX0",
+	"6mlz/5p2Q9/a3jvngA8uFuTrdG2nM5vYs+xzky9ltfV13Owe2sbns4PS4XadPChTP9+0NChn8uyFe0Wj",
+	"TiKIfFZmiBajWNieOqg4iPM0q0iE+hYc5WlWu1ofvT9DvzNa5H70WW6UGHl/prrepKnm6P3Z/zEKD5WJ",
+	"qbB7VDj1dUjt40ohzGEiW4VIDJHWyrx4O5LarUm7M3k2WUdv2Da2LOG0jLulsQ1xfWQ3TUsrddI5UK5P",
+	"B38UvntfD/5Q7l9fzU9fD7Jqrvvg2dDKjD+U1ghV1FYoCX3IzXT5mdC4f2s1gSXNmzm6WojwUOdrk4u3",
+	"KGJOSwu/izdWF/h5ot1jzU1VD6ZTROqDrxZnHpNY3+d0KC3E+30Pv9HwUl6O+rJDqSWvZ4YNNeWHwAoN",
+	"FHiYQKGvyN/vEDWS7UCypSCvGb/sOv/fmyZinR2lmnOgtAypB32gMXITBYwq2GQkLGjjNh0c7QJDusAD",
+	"UPgc8mt7fkCyHtt+fPLQ9/345PHsvI1kC+65fdAZaJm5NbVdzdSlsmuz8KiuiyIasNz2gygBzDt8/NVn",
+	"YUzvAv254gsy1b4VEP/FVS+teRcrzOrg7rYao3ZLDzsZbSfD92tdCInm1ZuOISkneaDisYF0dR4d/OFy",
+	"J38NOuy3if0Emq7yGyntLIaKXj06Ij0AR6SeNBZzTGhfGjvSjUcaG2lsEI31jNZwh7z/WC+psIhs2I4M",
+	"+xgc/qnuDafO4eSMxDevaFppHkWQyftOvPeJyLJcLA+wsHkJQ55Hcw5iaXRzdU10TpYu7Yv+Sw+CYiIi",
+	"5dm7CmuZZqtOcrF8JUzGv0dOkY+EymIiLrclMjXGMBo7UrOOJPY4SCzDrjrvFjSW4ehSZYAbRGYneuaR",
+	"zh4JnV0u7obKLhcjjT18GhMRpgdFsJdLOtVJbIWpr9oNRThaKmfo1+7HFVJjU+A22FSnYi9Tm0U6+Nrk",
+	"U9FBncoHmq8qecA5MeFlekRsp1FD5cI4MptoLuVJbvNGozlgmXMQaIZVGxvBbcrQShdfRhc2rszaKAOe",
+	"wiWlnEWYvq6iaOSLh88XK8Eh60zH8doI2VL4mtiwouc6KXtWTHFr9PSW8Wi8WD80Wh0QGdzXglMJex1t",
+	"OCOpfW2pCF7H3dMy10O1vYuCssGwD0JDsA9tO1ULbpLoS6Svc2oYCd4QfJHCtOuhtUieetNS8o1Ks4Jl",
+	"r7bHaQZcMIrlDRPVB+1lZ3EwklQ/kjqw9OSVoD+BVNIK7G4j7HIU1nwszEA2H6CKGvWc5zUC/ek2bd8/",
+	"G4jFgC5DqNt2uTUit8sZxeYQGjdhuWGF9AgSkIAERDZpS04FuKuUdEQvBlN94V6k234yUNwa5ZtVDSH8",
+	"T2rZQzqc6eY3qimwNCVy1Ir7UHs9q0KlcFzIgqYbVEMstAJKhNONTSoD9QfS+YZpjK5YWUFPoJjpiIzI",
+	"RHo49dR0y8BF9WslmDJd50UX4mM5r6Va0h2R0NbiFbomOuenPKeSr7QN2SZ3KtM92XB7W+VRrWK/M8L+",
+	"tKi/diM68egiGAyv7EGoYplLXY8gSKlny1zqkgVFLrEwTer0XNRUNSwp2yTxbFFkjSrr6b8y4ITF0zpV",
+	"Sq6y/fkoEgskGKPqX5Px0AFUJO60q7QAPRHn1CWpUD930++Z7TyYgI/sATUgXuZWLoBmWSdkFOsb8Itk",
+	"WQeveAh/Iym+tQxXBC49rJJTSRKbMa/or6osRHBhuE4xBXzJCId4DV8oVNxnQ8dI5xvQuU4/FLyUqqsP",
+	"UEPPpoNNpBoIw9dN3lzZdAk3rXsPEbjvSEpkP3MLUPlWp2O6qXQiEr5Ig/g9ITngtD+Na+jGC2lfGuez",
+	"+AAnCTPypNP2osU4n8XWtoxSQhlHNE9n2kpNY5QxLit5/MywpSXZ6vEhc8zR6d+OXpWg3GtBWgd1J5R2",
+	"Py5tih5a5t2GwzPIaInmnKUIG8GHDV20jRBozvEiDWclcdt+a6bicrLbIZLR/lsnvGlIT7QOWr0JSjXW",
+	"OmOSdDmp3D1x3UzGi/ra7Puwj8zKcvBjmP/2wlGde2LtIWmUQds4JPX05/t9yGkQR1WqF230TGXSJ2fU",
+	"rdjkbzvbyQ3npDKl/cacVENyUqEDZYGZTKs/XLGk/kM0X9R/ENDokgu+A8Zw5qQZYx2PBH9jzIhYm8Om",
+	"KNfqVQAccRiHJtX3obHWhh5k/bsNam1K1Q7o8BEvhrRmtyNLRv+3gQJjd9wf60fitU/jG0oA03uUATfu",
+	"RTpy0i6O3tZJ2zqLd3v0Dgh034D5bjHufWS+kfnu9BjT3tqikdu7jvsT12RTfioGeLQsdWTc1k9ZkqjE",
+	"eTcY6vNOV+Ae1e1RTj00ObXGKe+scMlrSCikKpUijDgoRwpTTruP0Do924nn2yiyRgk0SqAHIoF6+Y/t",
+	"Tv7swEdrFD+j+BnFzwMQP/2cvVWLTa9pG/tKPxSZM0qOUXI8RMmx4cWpl8wY70ijkjKKmlHUVESN6hHP",
+	"VpuYaghFtjdKg+ltPBLozE45CqJREI2CaBREB5uZavrJm0dslRmlxSgtHqC0GJh5bwOpcauJ+EankpGf",
+	"7piferiVfCobbc5V2aN3LRkdRMYz/FHLnD6FDxGmpvQh+vP5xCRvMkUPzyeoUgqxKIHoL7sdit50u++K",
+	"IT6GkKiRqu9dWFKUMNqhwr7mgG1Agspi447bGKjNTWPz9T6R7AlSU02LoGaIUUIu4ZzWeOOKJVPj3BBj",
+	"qSoDMwqxC7YXFGdiyaRLGux6nVM1ueqwj16ZROuuJREo0jDGiMzRE/f7E6TzqkkkQK7nQEYfr479kQ1o",
+	"fGbR6+PwF31ys724P5X9XvRp++JeVva7gfNQM+2BSkHQJ8O3lQPe/AUsRW7+fXQ8L/5wHKn6q8wiif4y",
+	"VfmwMs6+rAIpNAou1XO9JcmDY9VhOiaLJPizBs0ZT7GcvJzMCMW6oHqzcLpPsZxOllq511N/2UuwkHsp",
+	"i7V03+Pz6Pnz5z9QTFlthhhL2JMkNXshJXA12v93fh7/8eLrnvrnmfvno/nnZe2fP5+f76v/O5z+8PUv",
+	"//t///tffmAfjW5g+clpBsWfRi8o/jRaQdkYao13pBG4I9QIABev6I1TDJ/YqDiwWzxt+jq2Lk6Ux3r+",
+	"OgSEZMHDPVbv5qichvPcaQp2FC0GkHTlmHLbKcYsF/0tIQ5p9z8Xy7dA3503uT4iG32s8AGyuts5jUEl",
+	"zLGXtWrlFElUizl6opoOuXmNwn+N8N89i4USyXybLPYIbmaODw+489PpTDHPOPi5uiwPqqnJpOB2g2uW",
+	"P6dFpzQXOls3mgHKM1WhIQEhlNUzgrgvVxeORSN3b/I4M1pYHhYfSyyhMLA4Bq7dvTkkWJIr2FNDqZ+a",
+	"V+JOrlPjP1jzSJ8sk7u2jHwdGfDuGLDLIrILblzR6GCWsOgyhkTi8Jn6KsuSFcJIt0W6MTIk5g7UGIQk",
+	"1JhAY1BJ961mfU7VLPvlLGX1wvoDCiexLhzwqj4+EUiTt04wnayUhk2kKV6ovulTPnZavLLbnVPb03ab",
+	"6gyANW3gGlvlXGKu3kwEUV4T6w70FY3+VuLq0XojWeeEOoF8rG2DlT7TCVHfflPdJtOJornJywmJJ9OK",
+	"VGpJ9z5DW5JTZKKevlia5Wof8QKrPd5Hb9JMrvTzNEbzPElst/0ASIqYOoEape89k74vDp/1aHv47Fvw",
+	"1khYOAv7GfAr0G+9CVuIcHr1d2xxG7a2d2zRvySEasyShF33bPyO0H6V4xTU4oYLTGh4uu1wDzjPsVE0",
+	"+mZ3yMXywB3qB4TOWdflfM5BLMuK8cV9PDH1Q7yxTaXGQI0Y7ZsIIhfLU9v3WME1uhDfPxfix+mi14/D",
+	"tj0a3G7c0vFwz4j/Nk6ruz6ERrfBG3Ab7MecrSNvnb9Q7RhD0hTmn3s9+LvZ+SGfaTd5OFXxNjLW7Rxh",
+	"Cvdx3s+hzrXdhjfO3HwjX/R/FrU4Gz0PbpN/MmX2CXEFFpemwpBkSDXUVYijJBfSVUftKLZ2okbefdGh",
+	"b8v0dE9qL24v/9YUVNyZwBslzL2xv4iVkJAexERcBunmXwSujR1ctQoRhx7oyLS4x0XIiLgcSWMIaSw4",
+	"y7P1tGGadRLHT7bJ/aUODeFIHkPIY4l5fI05rKcQ11J0U8nf3YD3mVAckCOtDKEVkuE45iDETsTJ8ckr",
+	"O9p9ppQCypFUhpBKhqNLvOghVVzDTlI5KRrdX0KxMI5kMoxMZLTsQySq2RoSMU3uM4HIaDmSxyDy4GrH",
+	"5aoHhbiW3URStrrHdGKBHEllCKkITA8IJZJgyfh6eimbdhLM2av3x5WW99hs8uq9mqwAdiSeocTjnMi7",
+	"6UZivgAp1lKN2oxvgWBGOhlCJ7mAHrJFtVpDIZ/EPS/2rwAcaaNJG+aBMUgBOihaPb+YdsJlurKvMQHT",
+	"/AfTeDA5KGL4oKfGyc0Sg4FwJIdKpEWNIA4Uajtysf+CL6FKCAV9pOpKoOIKQQcY+tLECB3q8AZHS+9n",
+	"59WOORQukvphMUkgrjc9p7Vn8n30CxFCPRg6aDAHlxxqWvxoC2vFZD4HDlSe0/r8qlOexaaTctbUHTKe",
+	"U5hWZrNrJXRxTvXHCwHWj3OWm9hJyywKF2pQk0sjNtHV9S4qtnqO80QKtWTFcNWJ7CoLP4PiFxNhfU7/",
+	"rSCM+eqC53SKePmqFi0xXYBwYSCE62XrFbFc6jCRlV6BXEIaigIxzKLjYCZ9gwEGXhAas3h4MpR0qFib",
+	"WQxiuoOwgBIO8eSl5Dl8vXFpokE/BZEno1DxC5WGQho4N4yL+yZnx22cGQa6h+nTG9qzmouTuIrM31/V",
+	"G616rA8fE7bAhGbP6yVLQDmKIMaRYKl+4ydSFK6Bwu/WfnYV2WE2VS+HOyltGBd2k0lrR5eUoTGjvckY",
+	"aDcVv6G7IOI3dKThkYZ3SsM1b9P1B+vt0d59c/I061+X+WZMH8iHEJyLgcMzVi8UGLxB6PavdPPHS4o8",
+	"WoKQBkH/zCG/7wnfh4Uxf9+n7fffXMKJm+ahMs1mPyYyKTRHLhq5aOSikova5Zi6uejtVsWVRi4aueju",
+	"kh8NYowFuYJm5sBu1vjJ9RiZY2SO+8wcG3CDt8pYNzucbFswbOSHkR++kcMiy/ligBJ1opuPbDGyxcNm",
+	"Cw46ZWJ/xji1He4/a9zog34NF5ozbvFhfmTOB6rDDeTFs2+EE0c+GPlgIB+wbAgbsGzkgpELHhwXXBMb",
+	"ddeTD0z7UTMrUDEqZiMr7oQVczr0FeaT6zEeTCM3PGwbQk43sD1/qnQaWWRkkQfKIiaqY70XoykDfb85",
+	"YX3rN1c4ybHs1fY4zYALRrG8aSarIngMYbkTV5bd1mHHdGVSabqgN8gStoK4zCiL3jF2qcvF2NqAzXEY",
+	"bRRsR3PCVaGZ43nzw1LXFirGriexXVvnvUp925QxG2u2jzXbvzX5MF2rC35TfDGWixqL9W3BCrmPE/KR",
+	"EUZGeEyMMFhntLqiV2X8CaQKWQR77UAYXcLqmvHYJSkIKpL763S1n0B+67cxG6T4s0GJGNBlyD3Odrm1",
+	"65xdzpjl5M45c0mEZHzVnWbHpIUUEnEw1j7RqALiuZxdQiZVAVnVyuTjb1zoKmPdxT3u73bhj9ZuadBw",
+	"andhLDLyLbLtwR+Oi1TKi/m8j10mp6a4s2pvTTF15l7P25IhTJlcAi86TdUZ3p0TyfQlZnBbFBgVhK+S",
+	"K+nqzzpxUE/uPVJr/uZPeNXa8aGninNVWCoU2xRUSLJA4WSLW1/tZEIlLIC74sm3Ilr0Jo2i5RsWLRyu",
+	"oO7TWN/JY8PwgwWJyTfGQU3YkhZE6ETDeIFlmW4tA1doCSXkErQV2QiiemeTJa07bVhNkpyaJT4wWfL5",
+	"EV24HzxjGpruSGmlI+/VZOoHMUU51fn9dFFn6W7VYoNrdZNnPhlIHsbV2qBtyM36k8LrkA5nuvmYY+i+",
+	"MtjllT6B1r/w//yvM93wwRiVxA3beQy+3lDJCejchI+Slns+Lrj6HA3hq37+hsjvpryDFRra9LTeNfhb",
+	"03gegsPVjYjnA6DSWCrLnER1VjFHeY1X3ug+D0Zej1rETUjeXof+I6CkG/MT+rauk/dXQ1jjifOgKfUW",
+	"HBYelipxLym404FmpN+Rfu8z/Q5XWS/VFbuvWUHfxx/te3SJBPcWPRqhb5VmXaL4A0LnrM9LsuuAVAck",
+	"dSp6Nq8UZSr8NETnk+6pHedYzfto6b+KhdExShN64ebjzMGVH4YGcSk0x3m/0BXXtk7TlUeTfnR95qZ8",
+	"tDTtMDB6F90p7UtIswRLOOBAY1NV0f+EeKq/dzwPGnchNx6yD/fWgU976AngV6rQmTkgtNI7RVggbNx/",
+	"8mKCK6xKfnHlN6Q/X8KqKB5mKkBG80UBulCHXVFNTbkNFECoNRaltkoi14XNOESMx1D4Eh+9efvq07uP",
+	"+0Vn1a/544UeRJQvphYsg72mo4KBxgkG48RkK7t1+x98tNMZpD/ylB8NZATKrLX3vIKz26+rth7oUcDd",
+	"r5feQhLm2YLjGNaKQu1Lt0Yg1iizTpQ1AUSk2EwAmWKP1q2ytyiKjQsVKIlc1HY0AxcCTbM0mrF4pcVl",
+	"CnyhYbXuVgX05Yr6VHU04EpRL+lo4S8PB+8SeonMT3b3RplZxUZAaFaoUbLqDqmPlg/uujjlugJIo/y8",
+	"afmZMZZ0WapOGEs81qk2uemjWV2ZkMrSD8r7TDKOF4D0FH6XZf2Px1+5sG7e5C1FLW3dDeUbvkVrtJeb",
+	"fHDFkjyFdXv9L93qAe+4WeAj2fd8lpDogGVAcUa6tv7sGi8W+jayFfLtZho5c8/xW+BLI8lijEOCVwcp",
+	"CIEXnbxyqhr+YtsN1Ud05/csBtrzVUl3eG0qmx8f9e7xSQCnt2DBrKDiYfKUJos1b/ENirgpHXAdthWA",
+	"CBujTIwlFiCd/UavAi0BczkDLPsqfeteDp8+KuO0I4VSWgiJZS46A92tQBHOpqw7CpQLiF2wrIEwVtck",
+	"tXf75/SjjoFdEHqQYSF0aLzuIBmag4yW+v2Fp+ZGqiPDlA0Np+Z/im3W0wQM1pqYzgz8Gwkx0VsWnULK",
+	"5G1IIrOcB3zA1ynQvB51H1WmzWYl7D9ozOGkz0arI21I+1MSl81v45EtRBULkOWzpjFMTFHKKJHKTK01",
+	"W80jj0vQWdIylHa9ZDjt1CFtixvcRqXPHMdApVrODph7MHaUd8L/PwD0gsgSkDoCAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -8,10 +8,12 @@ import (
 
 	"github.com/iancoleman/orderedmap"
 	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/opensvc/om3/core/confighistory"
 	"github.com/opensvc/om3/core/instance"
 	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/core/node"
 	"github.com/opensvc/om3/core/resource"
+	"github.com/opensvc/om3/core/schedule"
 )
 
 const (
@@ -37,6 +39,11 @@ const (
 	CapabilityListKindCapabilityList CapabilityListKind = "CapabilityList"
 )
 
+// Defines values for ConfigRevisionListKind.
+const (
+	ConfigRevisionListKindConfigRevisionList ConfigRevisionListKind = "ConfigRevisionList"
+)
+
 // Defines values for DiskItemKind.
 const (
 	DiskItemKindDiskItem DiskItemKind = "DiskItem"
@@ -132,6 +139,14 @@ const (
 	NodeListKindNodeList NodeListKind = "NodeList"
 )
 
+// Defines values for ObjectApplyItemAction.
+const (
+	Create    ObjectApplyItemAction = "create"
+	Delete    ObjectApplyItemAction = "delete"
+	Unchanged ObjectApplyItemAction = "unchanged"
+	Update    ObjectApplyItemAction = "update"
+)
+
 // Defines values for ObjectItemKind.
 const (
 	ObjectItemKindObjectItem ObjectItemKind = "ObjectItem"
@@ -179,6 +194,7 @@ const (
 
 // Defines values for PlacementPolicy.
 const (
+	PlacementPolicyBalance    PlacementPolicy = "balance"
 	PlacementPolicyLastStart  PlacementPolicy = "last start"
 	PlacementPolicyLoadAvg    PlacementPolicy = "load avg"
 	PlacementPolicyNodesOrder PlacementPolicy = "nodes order"
@@ -294,6 +310,11 @@ const (
 	ScheduleListKindScheduleList ScheduleListKind = "ScheduleList"
 )
 
+// Defines values for SnapshotListKind.
+const (
+	SnapshotListKindSnapshotList SnapshotListKind = "SnapshotList"
+)
+
 // Defines values for Status.
 const (
 	Down      Status = "down"
@@ -321,10 +342,18 @@ const (
 	UserListKindUserList UserListKind = "UserList"
 )
 
+// ArbitratorLease defines model for ArbitratorLease.
+type ArbitratorLease struct {
+	Holder    string    `json:"holder"`
+	RenewedAt time.Time `json:"renewed_at"`
+	TakenAt   time.Time `json:"taken_at"`
+}
+
 // ArbitratorStatus defines model for ArbitratorStatus.
 type ArbitratorStatus struct {
-	Status Status `json:"status"`
-	Url    string `json:"url"`
+	Lease  *ArbitratorLease `json:"lease,omitempty"`
+	Status Status           `json:"status"`
+	Url    string           `json:"url"`
 }
 
 // AuthInfo defines model for AuthInfo.
@@ -397,6 +426,25 @@ type Committed struct {
 	Ischanged bool `json:"ischanged"`
 }
 
+// ConfigRevision defines model for ConfigRevision.
+type ConfigRevision = confighistory.Revision
+
+// ConfigRevisionDiff defines model for ConfigRevisionDiff.
+type ConfigRevisionDiff struct {
+	Diff string `json:"diff"`
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// ConfigRevisionList defines model for ConfigRevisionList.
+type ConfigRevisionList struct {
+	Items []ConfigRevision       `json:"items"`
+	Kind  ConfigRevisionListKind `json:"kind"`
+}
+
+// ConfigRevisionListKind defines model for ConfigRevisionList.Kind.
+type ConfigRevisionListKind string
+
 // DNSRecord defines model for DNSRecord.
 type DNSRecord struct {
 	Class string `json:"class"`
@@ -716,6 +764,9 @@ type Kind = naming.Kind
 // LogList responseLogList is a list of sse
 type LogList = openapi_types.File
 
+// MaintenanceWindow the open or next maintenance window, during which the orchestrations and the scheduled jobs are deferred
+type MaintenanceWindow = schedule.Window
+
 // Network defines model for Network.
 type Network struct {
 	Errors  *[]string `json:"errors,omitempty"`
@@ -830,6 +881,34 @@ type NodeStatus = node.Status
 // NodesInfo defines model for NodesInfo.
 type NodesInfo = node.NodesInfo
 
+// ObjectApplyConfig defines model for ObjectApplyConfig.
+type ObjectApplyConfig struct {
+	// Data the object configuration, in the ini format
+	Data string `json:"data"`
+	Path string `json:"path"`
+}
+
+// ObjectApplyItem defines model for ObjectApplyItem.
+type ObjectApplyItem struct {
+	Action ObjectApplyItemAction `json:"action"`
+
+	// Diff the unified diff of the installed and applied configurations
+	Diff *string `json:"diff,omitempty"`
+
+	// Error the reason why the change was not applied
+	Error *string `json:"error,omitempty"`
+	Path  string  `json:"path"`
+}
+
+// ObjectApplyItemAction defines model for ObjectApplyItem.Action.
+type ObjectApplyItemAction string
+
+// ObjectApplyResult defines model for ObjectApplyResult.
+type ObjectApplyResult struct {
+	DryRun bool              `json:"dry_run"`
+	Items  []ObjectApplyItem `json:"items"`
+}
+
 // ObjectConfig defines model for ObjectConfig.
 type ObjectConfig struct {
 	Data  orderedmap.OrderedMap `json:"data"`
@@ -896,6 +975,12 @@ type ObjectMeta struct {
 // ObjectPaths defines model for ObjectPaths.
 type ObjectPaths = []string
 
+// ObjectTemplateRender defines model for ObjectTemplateRender.
+type ObjectTemplateRender struct {
+	// Data the rendered object configuration, in the ini format
+	Data string `json:"data"`
+}
+
 // Orchestrate defines model for Orchestrate.
 type Orchestrate string
 
@@ -980,6 +1065,16 @@ type PatchList struct {
 // PatchListKind defines model for PatchList.Kind.
 type PatchListKind string
 
+// PlacementCandidate the rank of a node in the candidates list of a placement policy, with its explanation
+type PlacementCandidate struct {
+	CpuPct   float32 `json:"cpu_pct"`
+	Eligible bool    `json:"eligible"`
+	MemPct   float32 `json:"mem_pct"`
+	Node     string  `json:"node"`
+	Rank     int     `json:"rank"`
+	Reason   string  `json:"reason"`
+}
+
 // PlacementPolicy object placement policy
 type PlacementPolicy string
 
@@ -1032,6 +1127,14 @@ type PoolVolumeList struct {
 // PoolVolumeListKind defines model for PoolVolumeList.Kind.
 type PoolVolumeListKind string
 
+// PostClusterActionRebalance defines model for PostClusterActionRebalance.
+type PostClusterActionRebalance struct {
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// MaxParallel the maximum number of concurrent moves in a wave
+	MaxParallel *int `json:"max_parallel,omitempty"`
+}
+
 // PostDaemonLogsControl defines model for PostDaemonLogsControl.
 type PostDaemonLogsControl struct {
 	Level PostDaemonLogsControlLevel `json:"level"`
@@ -1074,6 +1177,33 @@ type PostObjectActionSwitch struct {
 	Destination []string `json:"destination"`
 }
 
+// PostObjectApply defines model for PostObjectApply.
+type PostObjectApply struct {
+	DryRun  *bool               `json:"dry_run,omitempty"`
+	Objects []ObjectApplyConfig `json:"objects"`
+	Prune   *bool               `json:"prune,omitempty"`
+
+	// PruneSelector the object selector expression of the objects to delete if not in objects
+	PruneSelector *string `json:"prune_selector,omitempty"`
+}
+
+// PostObjectTemplateRender defines model for PostObjectTemplateRender.
+type PostObjectTemplateRender struct {
+	// Params the template parameters values, indexed by parameter name
+	Params *map[string]string `json:"params,omitempty"`
+
+	// Template the template name
+	Template string `json:"template"`
+}
+
+// PostObjectTemplateUpgrade defines model for PostObjectTemplateUpgrade.
+type PostObjectTemplateUpgrade struct {
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// Params the template parameters values to change, indexed by parameter name
+	Params *map[string]string `json:"params,omitempty"`
+}
+
 // PostRelayMessage defines model for PostRelayMessage.
 type PostRelayMessage struct {
 	ClusterID   string `json:"cluster_id"`
@@ -1130,6 +1260,28 @@ type PropertyListKind string
 // Provisioned service, instance or resource provisioned state
 type Provisioned string
 
+// RebalanceMove defines model for RebalanceMove.
+type RebalanceMove struct {
+	// From the nodes where the object instance is running
+	From     []string `json:"from"`
+	Path     string   `json:"path"`
+	Priority int      `json:"priority"`
+
+	// To the nodes where the object instance will run
+	To []string `json:"to"`
+
+	// Wave the moves of a wave start when the moves of the previous wave are ended
+	Wave int `json:"wave"`
+}
+
+// RebalancePlan defines model for RebalancePlan.
+type RebalancePlan struct {
+	DryRun      bool               `json:"dry_run"`
+	ID          openapi_types.UUID `json:"id"`
+	MaxParallel int                `json:"max_parallel"`
+	Moves       []RebalanceMove    `json:"moves"`
+}
+
 // Region defines model for Region.
 type Region struct {
 	Devpath string `json:"devpath"`
@@ -1365,6 +1517,29 @@ type ScheduleList struct {
 // ScheduleListKind defines model for ScheduleList.Kind.
 type ScheduleListKind string
 
+// SnapshotItem defines model for SnapshotItem.
+type SnapshotItem struct {
+	Created time.Time `json:"created"`
+	Name    string    `json:"name"`
+	Node    string    `json:"node"`
+	Path    string    `json:"path"`
+
+	// Used The space consumed by the snapshot, in bytes.
+	Used int64 `json:"used"`
+}
+
+// SnapshotItems defines model for SnapshotItems.
+type SnapshotItems = []SnapshotItem
+
+// SnapshotList defines model for SnapshotList.
+type SnapshotList struct {
+	Items SnapshotItems    `json:"items"`
+	Kind  SnapshotListKind `json:"kind"`
+}
+
+// SnapshotListKind defines model for SnapshotList.Kind.
+type SnapshotListKind string
+
 // Status defines model for Status.
 type Status string
 
@@ -1492,6 +1667,9 @@ type InPathNamespace = string
 // InPathNodeName defines model for inPathNodeName.
 type InPathNodeName = string
 
+// InPathRevision defines model for inPathRevision.
+type InPathRevision = int
+
 // InQueryDeletes defines model for inQueryDeletes.
 type InQueryDeletes = []string
 
@@ -1528,6 +1706,12 @@ type InQueryRid = string
 // InQuerySets defines model for inQuerySets.
 type InQuerySets = []string
 
+// InQuerySnapshot defines model for inQuerySnapshot.
+type InQuerySnapshot = string
+
+// InQuerySnapshotName defines model for inQuerySnapshotName.
+type InQuerySnapshotName = string
+
 // InQuerySubset defines model for inQuerySubset.
 type InQuerySubset = string
 
@@ -1561,6 +1745,9 @@ type N408 = Problem
 // N409 defines model for 409.
 type N409 = Problem
 
+// N412 defines model for 412.
+type N412 = Problem
+
 // N500 defines model for 500.
 type N500 = Problem
 
@@ -1832,6 +2019,44 @@ type PostInstanceActionUnprovisionParams struct {
 	To           *InQueryTo           `form:"to,omitempty" json:"to,omitempty"`
 }
 
+// PostInstanceCloneParams defines parameters for PostInstanceClone.
+type PostInstanceCloneParams struct {
+	To *InQueryTo `form:"to,omitempty" json:"to,omitempty"`
+
+	// Snapshot The name of the snapshot to clone.
+	Snapshot *InQuerySnapshot `form:"snapshot,omitempty" json:"snapshot,omitempty"`
+}
+
+// DeleteInstanceSnapshotParams defines parameters for DeleteInstanceSnapshot.
+type DeleteInstanceSnapshotParams struct {
+	// Name The snapshot name.
+	Name *InQuerySnapshotName `form:"name,omitempty" json:"name,omitempty"`
+}
+
+// PostInstanceSnapshotParams defines parameters for PostInstanceSnapshot.
+type PostInstanceSnapshotParams struct {
+	// Name The snapshot name.
+	Name *InQuerySnapshotName `form:"name,omitempty" json:"name,omitempty"`
+}
+
+// PostInstanceSnapshotRollbackParams defines parameters for PostInstanceSnapshotRollback.
+type PostInstanceSnapshotRollbackParams struct {
+	// Name The snapshot name.
+	Name  *InQuerySnapshotName `form:"name,omitempty" json:"name,omitempty"`
+	Force *InQueryForce        `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// PostInstanceSyncBlockdeltaParams defines parameters for PostInstanceSyncBlockdelta.
+type PostInstanceSyncBlockdeltaParams struct {
+	Rid *InQueryRid `form:"rid,omitempty" json:"rid,omitempty"`
+
+	// Id The id of the stream
+	Id *string `form:"id,omitempty" json:"id,omitempty"`
+
+	// Base The id of the stream the delta is computed against. Empty for a full stream.
+	Base *string `form:"base,omitempty" json:"base,omitempty"`
+}
+
 // GetNodeLogsParams defines parameters for GetNodeLogs.
 type GetNodeLogsParams struct {
 	// Filter list of log filter
@@ -1906,6 +2131,12 @@ type GetObjectConfigGetParams struct {
 	Impersonate *InQueryImpersonate `form:"impersonate,omitempty" json:"impersonate,omitempty"`
 }
 
+// GetObjectConfigHistoryDiffParams defines parameters for GetObjectConfigHistoryDiff.
+type GetObjectConfigHistoryDiffParams struct {
+	// Against the revision to compare to
+	Against *int `form:"against,omitempty" json:"against,omitempty"`
+}
+
 // PostObjectConfigUpdateParams defines parameters for PostObjectConfigUpdate.
 type PostObjectConfigUpdateParams struct {
 	Delete *InQueryDeletes `form:"delete,omitempty" json:"delete,omitempty"`
@@ -1983,6 +2214,9 @@ type GetResourcesParams struct {
 	Resource *RidOptional `form:"resource,omitempty" json:"resource,omitempty"`
 }
 
+// PostClusterActionRebalanceJSONRequestBody defines body for PostClusterActionRebalance for application/json ContentType.
+type PostClusterActionRebalanceJSONRequestBody = PostClusterActionRebalance
+
 // PostDaemonLogsControlJSONRequestBody defines body for PostDaemonLogsControl for application/json ContentType.
 type PostDaemonLogsControlJSONRequestBody = PostDaemonLogsControl
 
@@ -1998,6 +2232,9 @@ type PostInstanceStatusJSONRequestBody = InstanceStatus
 // PostNodeDRBDConfigJSONRequestBody defines body for PostNodeDRBDConfig for application/json ContentType.
 type PostNodeDRBDConfigJSONRequestBody = PostNodeDRBDConfigRequest
 
+// PostObjectApplyJSONRequestBody defines body for PostObjectApply for application/json ContentType.
+type PostObjectApplyJSONRequestBody = PostObjectApply
+
 // PostObjectActionRestartJSONRequestBody defines body for PostObjectActionRestart for application/json ContentType.
 type PostObjectActionRestartJSONRequestBody = PostObjectActionRestart
 
@@ -2007,5 +2244,11 @@ type PostObjectActionSwitchJSONRequestBody = PostObjectActionSwitch
 // PatchObjectKVStoreJSONRequestBody defines body for PatchObjectKVStore for application/json ContentType.
 type PatchObjectKVStoreJSONRequestBody = PatchKVStoreEntries
 
+// PostObjectTemplateRenderJSONRequestBody defines body for PostObjectTemplateRender for application/json ContentType.
+type PostObjectTemplateRenderJSONRequestBody = PostObjectTemplateRender
+
+// PostObjectTemplateUpgradeJSONRequestBody defines body for PostObjectTemplateUpgrade for application/json ContentType.
+type PostObjectTemplateUpgradeJSONRequestBody = PostObjectTemplateUpgrade
+
 // PostRelayMessageJSONRequestBody defines body for PostRelayMessage for application/json ContentType.
 type PostRelayMessageJSONRequestBody = PostRelayMessage
@@ -552,3 +552,17 @@ func (t ResourceInfoItem) Unstructured() map[string]any {
 		"value":  t.Value,
 	}
 }
+
+func (t SnapshotList) GetItems() any {
+	return t.Items
+}
+
+func (t SnapshotItem) Unstructured() map[string]any {
+	return map[string]any{
+		"node":    t.Node,
+		"path":    t.Path,
+		"name":    t.Name,
+		"created": t.Created,
+		"used":    t.Used,
+	}
+}
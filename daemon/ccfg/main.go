@@ -120,7 +120,9 @@ func (t *Manager) worker() {
 	}
 }
 
-// AuthenticateNode returns nil if nodename is a cluster node and password is cluster secret
+// AuthenticateNode returns nil if nodename is a cluster node and password is
+// the cluster secret, or the next or previous cluster secret during a secret
+// rotation.
 func (*NodeDB) AuthenticateNode(nodename, password string) error {
 	if nodename == "" {
 		return fmt.Errorf("can't authenticate: nodename is empty")
@@ -133,8 +135,14 @@ func (*NodeDB) AuthenticateNode(nodename, password string) error {
 	if clusterSecret == "" {
 		return fmt.Errorf("can't authenticate: empty cluster secret")
 	}
-	if clusterSecret != password {
-		return fmt.Errorf("can't authenticate: %s has wrong password", nodename)
+	if clusterSecret == password {
+		return nil
 	}
-	return nil
+	// during a secret rotation, the peers may not have switched secret yet
+	for _, s := range clu.AltSecrets() {
+		if s == password {
+			return nil
+		}
+	}
+	return fmt.Errorf("can't authenticate: %s has wrong password", nodename)
 }
@@ -0,0 +1,377 @@
+/*
+Package csecret drives the cluster secret rotations.
+
+A rotation is started by "om cluster secret rotate", setting the
+cluster.next_secret keyword. The cluster config replication distributes the
+next secret to the nodes, which accept it in addition to the current
+secret.
+
+Each node publishes the fingerprints of the cluster secrets it knows in
+DaemonClusterSecretUpdated messages, so the leader can follow the
+rotation progress:
+
+	distribute: when all nodes report the next secret, the leader switches
+	  cluster.secret to the next secret, and keeps the replaced secret as
+	  cluster.previous_secret.
+
+	switch: the nodes encrypt with the new secret, still accept the
+	  previous secret, and re-encrypt their sec and usr keys still
+	  encrypted with the previous secret.
+
+	retire: when all nodes report the new secret and the re-encryption
+	  done, the leader waits cluster.secret_grace_period, then removes
+	  cluster.previous_secret.
+*/
+package csecret
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/core/cluster"
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/keyop"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/daemon/daemonsubsystem"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/key"
+	"github.com/opensvc/om3/util/plog"
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+type (
+	T struct {
+		ctx    context.Context
+		cancel context.CancelFunc
+		bus    *pubsub.Bus
+		log    *plog.Logger
+
+		sub   *pubsub.Subscription
+		subQS pubsub.QueueSizer
+
+		wg        sync.WaitGroup
+		localhost string
+
+		status daemonsubsystem.ClusterSecret
+
+		// reencryptedFor is the fingerprint of the previous secret the
+		// local sec and usr keys were re-encrypted for.
+		reencryptedFor string
+
+		// committedStep and committedAt identify the last rotation step
+		// committed by the leader, so it is not committed again while the
+		// cluster config is reloaded.
+		committedStep string
+		committedAt   time.Time
+	}
+)
+
+const (
+	StateIdle        = "idle"
+	StateDistributed = "distributed"
+	StateSwitched    = "switched"
+	StateReencrypted = "reencrypted"
+)
+
+var (
+	keySecret         = key.New("cluster", "secret")
+	keyNextSecret     = key.New("cluster", "next_secret")
+	keyPreviousSecret = key.New("cluster", "previous_secret")
+
+	// checkInterval is the interval between the leader evaluations of the
+	// rotation progress.
+	checkInterval = 5 * time.Second
+
+	// commitRetryDelay is the delay before the leader commits again a
+	// rotation step not yet visible in the cluster config.
+	commitRetryDelay = time.Minute
+)
+
+func New(subQS pubsub.QueueSizer) *T {
+	return &T{
+		localhost: hostname.Hostname(),
+		log: plog.NewDefaultLogger().
+			Attr("pkg", "daemon/csecret").
+			WithPrefix("daemon: csecret: "),
+		subQS: subQS,
+	}
+}
+
+// Start launches the cluster secret worker goroutine
+func (t *T) Start(parent context.Context) error {
+	t.log.Infof("starting")
+	t.ctx, t.cancel = context.WithCancel(parent)
+	t.bus = pubsub.BusFromContext(t.ctx)
+	t.status = daemonsubsystem.ClusterSecret{
+		Status: daemonsubsystem.Status{
+			ID:        "csecret",
+			CreatedAt: time.Now(),
+			State:     StateIdle,
+		},
+		Waiting: make([]string, 0),
+	}
+	t.startSubscriptions()
+
+	t.wg.Add(1)
+	go func() {
+		defer func() {
+			if err := t.sub.Stop(); err != nil && !errors.Is(err, context.Canceled) {
+				t.log.Warnf("subscription stop: %s", err)
+			}
+			t.wg.Done()
+			t.log.Infof("stopped")
+		}()
+		t.log.Infof("started")
+		t.worker()
+	}()
+	return nil
+}
+
+func (t *T) Stop() error {
+	t.cancel()
+	t.wg.Wait()
+	return nil
+}
+
+func (t *T) startSubscriptions() {
+	sub := t.bus.Sub("daemon.csecret", t.subQS)
+	sub.AddFilter(&msgbus.ClusterConfigUpdated{}, pubsub.Label{"node", t.localhost})
+	sub.AddFilter(&msgbus.DaemonClusterSecretUpdated{})
+	sub.Start()
+	t.sub = sub
+}
+
+func (t *T) worker() {
+	defer t.log.Debugf("done")
+	t.onClusterConfigUpdated()
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-ticker.C:
+			t.lead()
+		case i := <-t.sub.C:
+			switch c := i.(type) {
+			case *msgbus.ClusterConfigUpdated:
+				t.onClusterConfigUpdated()
+			case *msgbus.DaemonClusterSecretUpdated:
+				if c.Node != t.localhost {
+					t.lead()
+				}
+			}
+		}
+	}
+}
+
+// onClusterConfigUpdated updates the local node rotation state from the
+// cluster secrets, and re-encrypts the local sec and usr keys after a
+// switch.
+func (t *T) onClusterConfigUpdated() {
+	clusterConfig := cluster.ConfigData.Get()
+	current := fingerprint(clusterConfig.Secret())
+	next := fingerprint(clusterConfig.NextSecret())
+	previous := fingerprint(clusterConfig.PreviousSecret())
+	state := nodeState(next, previous, t.reencryptedFor)
+	if current == t.status.Current && next == t.status.Next && previous == t.status.Previous && state == t.status.State {
+		return
+	}
+	switch state {
+	case StateDistributed:
+		t.log.Infof("accept the next cluster secret %s", next)
+	case StateSwitched:
+		t.log.Infof("switched to the cluster secret %s, accept the previous cluster secret %s", current, previous)
+	case StateIdle:
+		if t.status.Previous != "" {
+			t.log.Infof("retired the previous cluster secret %s", t.status.Previous)
+		}
+		t.reencryptedFor = ""
+	}
+	t.status.Current = current
+	t.status.Next = next
+	t.status.Previous = previous
+	t.status.State = state
+	t.status.ConfiguredAt = time.Now()
+	if state == StateSwitched {
+		t.status.Reencrypted = 0
+		t.publish()
+		t.status.Reencrypted = t.reencrypt()
+		t.reencryptedFor = previous
+		t.status.State = StateReencrypted
+	}
+	t.publish()
+	t.lead()
+}
+
+// reencrypt re-encrypts the local sec and usr keys still encrypted with the
+// previous secret, and returns the number of re-encrypted keys.
+//
+// The keys that can't be decoded are not re-encrypted, but are not blocking
+// the rotation either, because they can't be decoded with any secret.
+func (t *T) reencrypt() int {
+	var count int
+	for p := range instance.ConfigData.GetByNode(t.localhost) {
+		if p.Kind != naming.KindSec && p.Kind != naming.KindUsr {
+			continue
+		}
+		i, err := object.New(p)
+		if err != nil {
+			t.log.Warnf("re-encrypt %s: %s", p, err)
+			continue
+		}
+		o, ok := i.(object.SecureKeystore)
+		if !ok {
+			continue
+		}
+		n, err := o.ReencryptKeys()
+		if err != nil {
+			t.log.Warnf("re-encrypt %s: %s", p, err)
+		}
+		if n > 0 {
+			t.log.Infof("re-encrypted %d keys of %s", n, p)
+		}
+		count += n
+	}
+	return count
+}
+
+// lead advances the rotation when the local node is the leader and all
+// cluster nodes have reached the current step.
+func (t *T) lead() {
+	if nodeStatus := node.StatusData.Get(t.localhost); nodeStatus == nil || !nodeStatus.IsLeader {
+		t.setWaiting(nil, time.Time{})
+		return
+	}
+	clusterConfig := cluster.ConfigData.Get()
+	switch t.status.State {
+	case StateDistributed:
+		next := fingerprint(clusterConfig.NextSecret())
+		waiting := waitingNodes(clusterConfig.Nodes, func(c *daemonsubsystem.ClusterSecret) bool {
+			return c.Next == next
+		})
+		t.setWaiting(waiting, time.Time{})
+		if len(waiting) > 0 {
+			return
+		}
+		t.commit("switch "+next, []keyop.T{
+			*keyop.New(keySecret, keyop.Set, clusterConfig.NextSecret(), 0),
+			*keyop.New(keyPreviousSecret, keyop.Set, clusterConfig.Secret(), 0),
+		}, keyNextSecret)
+	case StateReencrypted:
+		current := fingerprint(clusterConfig.Secret())
+		waiting := waitingNodes(clusterConfig.Nodes, func(c *daemonsubsystem.ClusterSecret) bool {
+			return c.Current == current && c.State == StateReencrypted
+		})
+		if len(waiting) > 0 {
+			t.setWaiting(waiting, time.Time{})
+			return
+		}
+		retireAt := t.status.RetireAt
+		if retireAt.IsZero() {
+			retireAt = time.Now().Add(clusterConfig.SecretGracePeriod())
+			t.log.Infof("all nodes switched to the cluster secret %s, retire the previous cluster secret at %s", current, retireAt)
+			t.setWaiting(waiting, retireAt)
+		}
+		if time.Now().Before(retireAt) {
+			return
+		}
+		t.commit("retire "+t.status.Previous, nil, keyPreviousSecret)
+	default:
+		t.setWaiting(nil, time.Time{})
+	}
+}
+
+// commit applies the keyword changes of the rotation <step> to the cluster
+// configuration. The change is then replicated to the peers like any other
+// cluster configuration change.
+func (t *T) commit(step string, ops []keyop.T, unset ...key.T) {
+	if step == t.committedStep && time.Since(t.committedAt) < commitRetryDelay {
+		return
+	}
+	t.log.Infof("commit the cluster secret rotation step: %s", step)
+	ccfg, err := object.NewCluster(object.WithVolatile(false))
+	if err != nil {
+		t.log.Errorf("commit cluster config: %s", err)
+		return
+	}
+	c := ccfg.Config()
+	if err := c.PrepareSet(ops...); err != nil {
+		t.log.Errorf("commit cluster config: %s", err)
+		return
+	}
+	if err := c.PrepareUnset(unset...); err != nil {
+		t.log.Errorf("commit cluster config: %s", err)
+		return
+	}
+	if err := c.Commit(); err != nil {
+		t.log.Errorf("commit cluster config: %s", err)
+		return
+	}
+	t.committedStep = step
+	t.committedAt = time.Now()
+}
+
+func (t *T) setWaiting(waiting []string, retireAt time.Time) {
+	if waiting == nil {
+		waiting = make([]string, 0)
+	}
+	if slices.Equal(waiting, t.status.Waiting) && retireAt.Equal(t.status.RetireAt) {
+		return
+	}
+	t.status.Waiting = waiting
+	t.status.RetireAt = retireAt
+	t.publish()
+}
+
+func (t *T) publish() {
+	t.status.UpdatedAt = time.Now()
+	daemonsubsystem.DataClusterSecret.Set(t.localhost, t.status.DeepCopy())
+	t.bus.Pub(&msgbus.DaemonClusterSecretUpdated{Node: t.localhost, Value: *t.status.DeepCopy()}, pubsub.Label{"node", t.localhost})
+}
+
+// nodeState returns the rotation state of a node knowing the <next> and
+// <previous> secret fingerprints, and having re-encrypted its keys for the
+// <reencryptedFor> previous secret fingerprint.
+func nodeState(next, previous, reencryptedFor string) string {
+	switch {
+	case next != "":
+		return StateDistributed
+	case previous == "":
+		return StateIdle
+	case previous == reencryptedFor:
+		return StateReencrypted
+	default:
+		return StateSwitched
+	}
+}
+
+// waitingNodes returns the <nodes> with no cluster secret status, or with
+// a cluster secret status not satisfying <reached>.
+func waitingNodes(nodes []string, reached func(*daemonsubsystem.ClusterSecret) bool) []string {
+	l := make([]string, 0)
+	for _, nodename := range nodes {
+		if c := daemonsubsystem.DataClusterSecret.Get(nodename); c == nil || !reached(c) {
+			l = append(l, nodename)
+		}
+	}
+	return l
+}
+
+// fingerprint returns a short digest identifying the secret <s>, or an
+// empty string if <s> is empty.
+func fingerprint(s string) string {
+	if s == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:6])
+}
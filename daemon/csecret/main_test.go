@@ -0,0 +1,48 @@
+package csecret
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/daemon/daemonsubsystem"
+)
+
+func TestNodeState(t *testing.T) {
+	cases := map[string]struct {
+		next, previous, reencryptedFor string
+		expected                       string
+	}{
+		"no rotation":            {expected: StateIdle},
+		"next secret accepted":   {next: "n", expected: StateDistributed},
+		"switched":               {previous: "p", expected: StateSwitched},
+		"switched and reencrypt": {previous: "p", reencryptedFor: "p", expected: StateReencrypted},
+		"switched again":         {previous: "p2", reencryptedFor: "p", expected: StateSwitched},
+		"retired":                {reencryptedFor: "p", expected: StateIdle},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, c.expected, nodeState(c.next, c.previous, c.reencryptedFor))
+		})
+	}
+}
+
+func TestWaitingNodes(t *testing.T) {
+	daemonsubsystem.InitData()
+	defer daemonsubsystem.InitData()
+	daemonsubsystem.DataClusterSecret.Set("node1", &daemonsubsystem.ClusterSecret{Next: "n"})
+	daemonsubsystem.DataClusterSecret.Set("node2", &daemonsubsystem.ClusterSecret{})
+	reached := func(c *daemonsubsystem.ClusterSecret) bool {
+		return c.Next == "n"
+	}
+	require.Equal(t, []string{"node2", "node3"}, waitingNodes([]string{"node1", "node2", "node3"}, reached),
+		"nodes with no status or with a status not reached are waited for")
+	daemonsubsystem.DataClusterSecret.Set("node2", &daemonsubsystem.ClusterSecret{Next: "n"})
+	require.Equal(t, []string{}, waitingNodes([]string{"node1", "node2"}, reached))
+}
+
+func TestFingerprint(t *testing.T) {
+	require.Equal(t, "", fingerprint(""))
+	require.Len(t, fingerprint("foo"), 12)
+	require.NotEqual(t, fingerprint("foo"), fingerprint("bar"))
+}
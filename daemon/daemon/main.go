@@ -20,6 +20,7 @@ import (
 	"github.com/opensvc/om3/core/cluster"
 	"github.com/opensvc/om3/daemon/ccfg"
 	"github.com/opensvc/om3/daemon/collector"
+	"github.com/opensvc/om3/daemon/csecret"
 	"github.com/opensvc/om3/daemon/cstat"
 	"github.com/opensvc/om3/daemon/daemonapi"
 	"github.com/opensvc/om3/daemon/daemonctx"
@@ -36,6 +37,8 @@ import (
 	"github.com/opensvc/om3/daemon/listener"
 	"github.com/opensvc/om3/daemon/msgbus"
 	"github.com/opensvc/om3/daemon/nmon"
+	"github.com/opensvc/om3/daemon/notifier"
+	"github.com/opensvc/om3/daemon/rebalancer"
 	"github.com/opensvc/om3/daemon/runner"
 	"github.com/opensvc/om3/daemon/scheduler"
 	"github.com/opensvc/om3/util/converters"
@@ -185,6 +188,9 @@ func (t *T) Start(ctx context.Context) error {
 		scheduler.New(qsHuge),
 		daemonvip.New(qsSmall),
 		runner.NewDefault(qsSmall),
+		rebalancer.New(qsSmall),
+		notifier.New(qsMedium),
+		csecret.New(qsSmall),
 	} {
 		if err := t.startComponent(t.ctx, s); err != nil {
 			return err
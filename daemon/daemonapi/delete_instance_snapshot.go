@@ -0,0 +1,43 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+// DeleteInstanceSnapshot deletes a snapshot of the instance vol data.
+func (a *DaemonAPI) DeleteInstanceSnapshot(ctx echo.Context, nodename, namespace string, kind naming.Kind, name string, params api.DeleteInstanceSnapshotParams) error {
+	if nodename == a.localhost || nodename == "localhost" {
+		return a.deleteLocalInstanceSnapshot(ctx, namespace, kind, name, params)
+	}
+	return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.DeleteInstanceSnapshot(ctx.Request().Context(), nodename, namespace, kind, name, &params)
+	})
+}
+
+func (a *DaemonAPI) deleteLocalInstanceSnapshot(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.DeleteInstanceSnapshotParams) error {
+	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+		return err
+	}
+	log := LogHandler(ctx, "DeleteInstanceSnapshot")
+	if params.Name == nil || *params.Name == "" {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Bad request", "The 'name' query parameter is required")
+	}
+	o, err := localVol(ctx, namespace, kind, name)
+	if o == nil {
+		return err
+	}
+	log = naming.LogWithPath(log, o.Path())
+	if err := o.DeleteSnapshot(ctx.Request().Context(), *params.Name); err != nil {
+		log.Warnf("delete snapshot %s: %s", *params.Name, err)
+		return snapshotProblem(ctx, "Delete snapshot", err)
+	}
+	log.Infof("deleted snapshot %s", *params.Name)
+	return ctx.NoContent(http.StatusNoContent)
+}
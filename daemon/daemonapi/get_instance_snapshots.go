@@ -0,0 +1,47 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+// GetInstanceSnapshots returns the snapshots of the instance vol data.
+func (a *DaemonAPI) GetInstanceSnapshots(ctx echo.Context, nodename, namespace string, kind naming.Kind, name string) error {
+	if nodename == a.localhost || nodename == "localhost" {
+		return a.getLocalInstanceSnapshots(ctx, namespace, kind, name)
+	}
+	return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.GetInstanceSnapshots(ctx.Request().Context(), nodename, namespace, kind, name)
+	})
+}
+
+func (a *DaemonAPI) getLocalInstanceSnapshots(ctx echo.Context, namespace string, kind naming.Kind, name string) error {
+	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleGuest, namespace), rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+		return err
+	}
+	o, err := localVol(ctx, namespace, kind, name)
+	if o == nil {
+		return err
+	}
+	snaps, err := o.Snapshots(ctx.Request().Context())
+	if err != nil {
+		return snapshotProblem(ctx, "List snapshots", err)
+	}
+	items := make(api.SnapshotItems, len(snaps))
+	for i, snap := range snaps {
+		items[i] = api.SnapshotItem{
+			Node:    a.localhost,
+			Path:    o.Path().String(),
+			Name:    snap.Name,
+			Created: snap.Created,
+			Used:    snap.Used,
+		}
+	}
+	return ctx.JSON(http.StatusOK, api.SnapshotList{Kind: "SnapshotList", Items: items})
+}
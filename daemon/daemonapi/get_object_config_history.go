@@ -0,0 +1,43 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+func (a *DaemonAPI) GetObjectConfigHistory(ctx echo.Context, namespace string, kind naming.Kind, name string) error {
+	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleGuest, namespace), rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+		return err
+	}
+	log := LogHandler(ctx, "GetObjectConfigHistory")
+	p, err := naming.NewPath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameter", "invalid path: %s", err)
+	}
+	log = naming.LogWithPath(log, p)
+
+	if instance.ConfigData.Get(p, a.localhost) != nil {
+		revisions, err := a.objectConfigHistory(p).List()
+		if err != nil {
+			log.Errorf("list config revisions: %s", err)
+			return JSONProblemf(ctx, http.StatusInternalServerError, "List config revisions", "%s", err)
+		}
+		return ctx.JSON(http.StatusOK, api.ConfigRevisionList{
+			Kind:  "ConfigRevisionList",
+			Items: revisions,
+		})
+	}
+	for nodename := range instance.ConfigData.GetByPath(p) {
+		return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+			return c.GetObjectConfigHistory(ctx.Request().Context(), namespace, kind, name)
+		})
+	}
+	return JSONProblemf(ctx, http.StatusNotFound, "Not found", "object not found: %s", p)
+}
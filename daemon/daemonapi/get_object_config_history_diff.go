@@ -0,0 +1,66 @@
+package daemonapi
+
+import (
+	"errors"
+	"net/http"
+	"os"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/confighistory"
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+func (a *DaemonAPI) GetObjectConfigHistoryDiff(ctx echo.Context, namespace string, kind naming.Kind, name string, revision int, params api.GetObjectConfigHistoryDiffParams) error {
+	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleGuest, namespace), rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+		return err
+	}
+	log := LogHandler(ctx, "GetObjectConfigHistoryDiff")
+	p, err := naming.NewPath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameter", "invalid path: %s", err)
+	}
+	log = naming.LogWithPath(log, p)
+
+	if instance.ConfigData.Get(p, a.localhost) != nil {
+		history := a.objectConfigHistory(p)
+		rev, from, err := history.Get(revision)
+		if errors.Is(err, confighistory.ErrNotFound) {
+			return JSONProblemf(ctx, http.StatusNotFound, "Not found", "%s", err)
+		} else if err != nil {
+			log.Errorf("get config revision %d: %s", revision, err)
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Get config revision", "%s", err)
+		}
+		resp := api.ConfigRevisionDiff{From: rev.Name()}
+		var to []byte
+		if params.Against != nil {
+			var againstRev confighistory.Revision
+			againstRev, to, err = history.Get(*params.Against)
+			if errors.Is(err, confighistory.ErrNotFound) {
+				return JSONProblemf(ctx, http.StatusNotFound, "Not found", "%s", err)
+			} else if err != nil {
+				log.Errorf("get config revision %d: %s", *params.Against, err)
+				return JSONProblemf(ctx, http.StatusInternalServerError, "Get config revision", "%s", err)
+			}
+			resp.To = againstRev.Name()
+		} else {
+			to, err = os.ReadFile(p.ConfigFile())
+			if err != nil {
+				return JSONProblemf(ctx, http.StatusInternalServerError, "Read config file", "%s", err)
+			}
+			resp.To = "installed"
+		}
+		resp.Diff = confighistory.Diff(resp.From, from, resp.To, to)
+		return ctx.JSON(http.StatusOK, resp)
+	}
+	for nodename := range instance.ConfigData.GetByPath(p) {
+		return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+			return c.GetObjectConfigHistoryDiff(ctx.Request().Context(), namespace, kind, name, revision, &params)
+		})
+	}
+	return JSONProblemf(ctx, http.StatusNotFound, "Not found", "object not found: %s", p)
+}
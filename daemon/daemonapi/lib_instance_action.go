@@ -14,6 +14,7 @@ import (
 	"github.com/opensvc/om3/util/hostname"
 	"github.com/opensvc/om3/util/plog"
 	"github.com/opensvc/om3/util/pubsub"
+	"github.com/opensvc/om3/util/tracing"
 )
 
 func (a *DaemonAPI) apiExec(ctx echo.Context, p naming.Path, requesterSid uuid.UUID, args []string, log *plog.Logger) (uuid.UUID, error) {
@@ -32,6 +33,7 @@ func (a *DaemonAPI) apiExec(ctx echo.Context, p naming.Path, requesterSid uuid.U
 			"OSVC_REQUEST_ID="+fmt.Sprint(ctx.Get("uuid")),
 			"OSVC_REQUESTER_SESSION_ID="+fmt.Sprint(requesterSid),
 		),
+		command.WithVarEnv(tracing.Environ(ctx.Request().Context())...),
 	)
 	labels := []pubsub.Label{
 		labelAPI,
@@ -2,6 +2,7 @@ package daemonapi
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/opensvc/om3/daemon/daemonauth"
 	"net/http"
@@ -12,10 +13,12 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog"
 	"github.com/shaj13/go-guardian/v2/auth"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/opensvc/om3/daemon/daemonctx"
 	"github.com/opensvc/om3/daemon/rbac"
 	"github.com/opensvc/om3/util/plog"
+	"github.com/opensvc/om3/util/tracing"
 )
 
 type (
@@ -33,6 +36,14 @@ var (
 		"/public/ui/*":    zerolog.DebugLevel,
 		"/relay/message":  zerolog.DebugLevel,
 	}
+
+	// traceSkipPerPath defines the paths not traced by TraceMiddleware.
+	traceSkipPerPath = map[string]bool{
+		"/metrics":        true,
+		"/public/openapi": true,
+		"/public/ui/*":    true,
+		"/relay/message":  true,
+	}
 )
 
 func LogMiddleware(parent context.Context) echo.MiddlewareFunc {
@@ -160,6 +171,48 @@ func LogRequestMiddleWare(parent context.Context) echo.MiddlewareFunc {
 	}
 }
 
+// TraceMiddleware starts a span for the request handling, child of the trace
+// context found in the request headers. The request context is replaced by
+// the span context, so the handler requests to peers and the commands it
+// executes are part of the same trace.
+func TraceMiddleware(parent context.Context) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if traceSkipPerPath[c.Path()] {
+				return next(c)
+			}
+			req := c.Request()
+			ctx := tracing.ExtractRequest(req.Context(), req)
+			ctx, span := tracing.StartSpan(ctx, "api "+req.Method+" "+c.Path(),
+				attribute.String("http.method", req.Method),
+				attribute.String("http.route", c.Path()),
+				attribute.String("request_id", fmt.Sprint(c.Get("uuid"))),
+				attribute.String("user", userFromContext(c).GetUserName()),
+			)
+			c.SetRequest(req.WithContext(ctx))
+			err := next(c)
+			status := c.Response().Status
+			if err != nil && !c.Response().Committed {
+				// The echo error handler writes the response after the
+				// middlewares return, so derive the status from the error.
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) {
+					status = httpErr.Code
+				} else {
+					status = http.StatusInternalServerError
+				}
+			}
+			span.SetAttributes(attribute.Int("http.status_code", status))
+			spanErr := err
+			if spanErr == nil && status >= http.StatusInternalServerError {
+				spanErr = fmt.Errorf("%s", http.StatusText(status))
+			}
+			tracing.EndSpan(span, spanErr)
+			return err
+		}
+	}
+}
+
 func UIMiddleware(_ context.Context) echo.MiddlewareFunc {
 	uiHandler := http.StripPrefix("/public/ui", swaggerui.Handler("/public/openapi"))
 	echoUI := echo.WrapHandler(uiHandler)
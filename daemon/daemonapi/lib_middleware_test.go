@@ -0,0 +1,64 @@
+package daemonapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/shaj13/go-guardian/v2/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTraceMiddleware(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	defer func() { _ = provider.Shutdown(context.Background()) }()
+	otel.SetTracerProvider(provider)
+
+	cases := map[string]struct {
+		handler echo.HandlerFunc
+		status  int
+		isError bool
+	}{
+		"ok": {
+			handler: func(c echo.Context) error { return c.NoContent(http.StatusNoContent) },
+			status:  http.StatusNoContent,
+		},
+		"http error": {
+			handler: func(c echo.Context) error { return echo.NewHTTPError(http.StatusNotFound) },
+			status:  http.StatusNotFound,
+			isError: true,
+		},
+		"error": {
+			handler: func(c echo.Context) error { return assert.AnError },
+			status:  http.StatusInternalServerError,
+			isError: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			exporter.Reset()
+			e := echo.New()
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+			c.Set("user", auth.NewUserInfo("root", "", nil, nil))
+			_ = TraceMiddleware(context.Background())(tc.handler)(c)
+
+			spans := exporter.GetSpans()
+			require.Len(t, spans, 1)
+			assert.Contains(t, spans[0].Attributes, attribute.Int("http.status_code", tc.status))
+			if tc.isError {
+				assert.Equal(t, codes.Error, spans[0].Status.Code)
+			} else {
+				assert.NotEqual(t, codes.Error, spans[0].Status.Code)
+			}
+		})
+	}
+}
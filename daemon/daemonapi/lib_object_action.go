@@ -15,6 +15,7 @@ import (
 	"github.com/opensvc/om3/daemon/api"
 	"github.com/opensvc/om3/daemon/msgbus"
 	"github.com/opensvc/om3/util/pubsub"
+	"github.com/opensvc/om3/util/tracing"
 )
 
 func (a *DaemonAPI) postObjectAction(eCtx echo.Context, namespace string, kind naming.Kind, name string, globalExpect instance.MonitorGlobalExpect, fn func(c *client.T) (*http.Response, error)) error {
@@ -24,18 +25,8 @@ func (a *DaemonAPI) postObjectAction(eCtx echo.Context, namespace string, kind n
 	}
 
 	if instMon := instance.MonitorData.Get(p, a.localhost); instMon != nil {
-		ctx, cancel := context.WithTimeout(eCtx.Request().Context(), 500*time.Millisecond)
-		defer cancel()
-
-		value := instance.MonitorUpdate{
-			GlobalExpect:             &globalExpect,
-			CandidateOrchestrationID: uuid.New(),
-		}
-		msg, setImonErr := msgbus.NewSetInstanceMonitorWithErr(ctx, p, a.localhost, value)
-
-		a.EventBus.Pub(msg, pubsub.Label{"path", p.String()}, labelAPI)
-
-		return JSONFromSetInstanceMonitorError(eCtx, &value, setImonErr.Receive())
+		value, err := a.setObjectGlobalExpect(eCtx.Request().Context(), p, globalExpect)
+		return JSONFromSetInstanceMonitorError(eCtx, &value, err)
 	}
 	for nodename, _ := range instance.MonitorData.GetByPath(p) {
 		if nodename == a.localhost {
@@ -46,6 +37,24 @@ func (a *DaemonAPI) postObjectAction(eCtx echo.Context, namespace string, kind n
 	return JSONProblem(eCtx, http.StatusNotFound, "object not found", "")
 }
 
+// setObjectGlobalExpect asks the local instance monitor of the object p to
+// orchestrate globalExpect.
+func (a *DaemonAPI) setObjectGlobalExpect(ctx context.Context, p naming.Path, globalExpect instance.MonitorGlobalExpect) (instance.MonitorUpdate, error) {
+	ctx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+	defer cancel()
+
+	value := instance.MonitorUpdate{
+		GlobalExpect:             &globalExpect,
+		CandidateOrchestrationID: uuid.New(),
+		TraceParent:              tracing.TraceParent(ctx),
+	}
+	msg, setImonErr := msgbus.NewSetInstanceMonitorWithErr(ctx, p, a.localhost, value)
+
+	a.EventBus.Pub(msg, pubsub.Label{"path", p.String()}, labelAPI)
+
+	return value, setImonErr.Receive()
+}
+
 // JSONFromSetInstanceMonitorError sends a JSON response where status code depends
 // on SetMonitorUpdate error value.
 //   - StatusOK: expectation value accepted
@@ -1,12 +1,15 @@
 package daemonapi
 
 import (
+	"errors"
 	"io"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/opensvc/om3/core/confighistory"
 	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/node"
 	"github.com/opensvc/om3/core/object"
 )
 
@@ -15,21 +18,64 @@ func (a *DaemonAPI) writeObjectConfigFile(ctx echo.Context, p naming.Path) error
 	if err != nil {
 		return JSONProblemf(ctx, http.StatusInternalServerError, "Read body", "%s", err)
 	}
+	return a.commitObjectConfigData(ctx, p, body, "")
+}
+
+// commitObjectConfigData validates and installs body as the object p
+// configuration file, and records the change in the object config history.
+func (a *DaemonAPI) commitObjectConfigData(ctx echo.Context, p naming.Path, body []byte, comment string) error {
+	if status, title, err := a.installObjectConfigData(ctx, p, body, comment); err != nil {
+		return JSONProblemf(ctx, status, title, "%s", err)
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// installObjectConfigData validates and installs body as the object p
+// configuration file, and records the change in the object config history.
+// On error, it also returns the http status code and the title of the
+// problem to report.
+func (a *DaemonAPI) installObjectConfigData(ctx echo.Context, p naming.Path, body []byte, comment string) (int, string, error) {
 	o, err := object.New(p, object.WithConfigData(body))
 	if err != nil {
-		return JSONProblemf(ctx, http.StatusInternalServerError, "New object", "%s", err)
+		return http.StatusInternalServerError, "New object", err
 	}
 	configurer := o.(object.Configurer)
 	alerts, err := configurer.ValidateConfig(ctx.Request().Context())
 	if err != nil {
-		return JSONProblemf(ctx, http.StatusInternalServerError, "Validate config", "%s", err)
+		return http.StatusInternalServerError, "Validate config", err
 	}
 	if alerts.HasError() {
-		return JSONProblemf(ctx, http.StatusBadRequest, "Validate config", "%s", err)
+		return http.StatusBadRequest, "Validate config", errors.New(alerts.StringWithoutMeta())
 	}
+	history := a.objectConfigHistory(p)
+	a.recordObjectConfig(ctx, history, "", "")
 	// Use the non-validating commit func as we already validate to emit a explicit error
 	if err := configurer.Config().RecommitInvalid(); err != nil {
-		return JSONProblemf(ctx, http.StatusInternalServerError, "Commit", "%s", err)
+		return http.StatusInternalServerError, "Commit", err
+	}
+	a.recordObjectConfig(ctx, history, userFromContext(ctx).GetUserName(), comment)
+	return 0, "", nil
+}
+
+// objectConfigHistory returns the object p config history, sized by the
+// node config_history_size keyword.
+func (a *DaemonAPI) objectConfigHistory(p naming.Path) *confighistory.T {
+	size := confighistory.DefaultMax
+	if cfg := node.ConfigData.Get(a.localhost); cfg != nil {
+		size = cfg.ConfigHistorySize
+	}
+	return confighistory.New(p, size)
+}
+
+// recordObjectConfig records the installed object configuration file in
+// the history. The revision is not recorded if the file content is the
+// content of the latest revision. An empty author is used to record a
+// configuration not yet recorded before its replacement.
+func (a *DaemonAPI) recordObjectConfig(ctx echo.Context, history *confighistory.T, author, comment string) {
+	log := LogHandler(ctx, "recordObjectConfig")
+	if rev, recorded, err := history.RecordFile(author, comment); err != nil {
+		log.Warnf("record config revision: %s", err)
+	} else if recorded {
+		log.Infof("recorded config %s", rev)
 	}
-	return ctx.NoContent(http.StatusNoContent)
 }
@@ -42,6 +42,11 @@ func keyopRbac(op keyop.T) error {
 		case "script", "start", "stop", "check", "info":
 			return fmt.Errorf("%s", op.Key)
 		}
+	case "check":
+		switch op.Key.Option {
+		case "command":
+			return fmt.Errorf("%s", op.Key)
+		}
 	case "container":
 		switch op.Key.Option {
 		case "volume_mounts":
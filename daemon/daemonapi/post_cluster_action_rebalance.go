@@ -0,0 +1,76 @@
+package daemonapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/rebalance"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+func (a *DaemonAPI) PostClusterActionRebalance(eCtx echo.Context) error {
+	if v, err := assertRole(eCtx, rbac.RoleRoot); err != nil {
+		return err
+	} else if !v {
+		return nil
+	}
+	log := LogHandler(eCtx, "PostClusterActionRebalance")
+	var payload api.PostClusterActionRebalance
+	if err := eCtx.Bind(&payload); err != nil {
+		return JSONProblem(eCtx, http.StatusBadRequest, "Invalid body", err.Error())
+	}
+	maxParallel := 1
+	if payload.MaxParallel != nil {
+		if *payload.MaxParallel < 1 {
+			return JSONProblemf(eCtx, http.StatusBadRequest, "Invalid body", "max_parallel must be greater than 0: %d", *payload.MaxParallel)
+		}
+		maxParallel = *payload.MaxParallel
+	}
+	dryRun := payload.DryRun != nil && *payload.DryRun
+
+	plan := rebalance.NewPlan(maxParallel)
+	if dryRun || len(plan.Moves) == 0 {
+		return eCtx.JSON(http.StatusOK, rebalancePlanToAPI(plan, true))
+	}
+
+	ctx, cancel := context.WithTimeout(eCtx.Request().Context(), 300*time.Millisecond)
+	defer cancel()
+	msg, errReceiver := msgbus.NewClusterRebalanceRequestedWithErr(ctx, a.localhost, plan)
+	a.EventBus.Pub(msg, labelAPI, a.LabelNode)
+	switch err := errReceiver.Receive(); {
+	case err == nil:
+		log.Infof("rebalance %s queued with %d moves", plan.ID, len(plan.Moves))
+		return eCtx.JSON(http.StatusOK, rebalancePlanToAPI(plan, false))
+	case errors.Is(err, context.DeadlineExceeded):
+		return JSONProblemf(eCtx, http.StatusRequestTimeout, "rebalance", "timeout publishing the rebalance request")
+	case errors.Is(err, context.Canceled):
+		return JSONProblemf(eCtx, http.StatusRequestTimeout, "rebalance", "client context canceled")
+	default:
+		return JSONProblemf(eCtx, http.StatusConflict, "rebalance", "%s", err)
+	}
+}
+
+func rebalancePlanToAPI(plan rebalance.Plan, dryRun bool) api.RebalancePlan {
+	resp := api.RebalancePlan{
+		ID:          plan.ID,
+		DryRun:      dryRun,
+		MaxParallel: plan.MaxParallel,
+		Moves:       make([]api.RebalanceMove, 0, len(plan.Moves)),
+	}
+	for _, move := range plan.Moves {
+		resp.Moves = append(resp.Moves, api.RebalanceMove{
+			Path:     move.Path.String(),
+			From:     append([]string{}, move.From...),
+			To:       append([]string{}, move.To...),
+			Priority: int(move.Priority),
+			Wave:     move.Wave,
+		})
+	}
+	return resp
+}
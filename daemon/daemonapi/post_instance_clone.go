@@ -0,0 +1,59 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+// PostInstanceClone creates a vol object with data cloned from a snapshot
+// of the instance vol data.
+func (a *DaemonAPI) PostInstanceClone(ctx echo.Context, nodename, namespace string, kind naming.Kind, name string, params api.PostInstanceCloneParams) error {
+	if nodename == a.localhost || nodename == "localhost" {
+		return a.postLocalInstanceClone(ctx, namespace, kind, name, params)
+	}
+	return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.PostInstanceClone(ctx.Request().Context(), nodename, namespace, kind, name, &params)
+	})
+}
+
+func (a *DaemonAPI) postLocalInstanceClone(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PostInstanceCloneParams) error {
+	if params.To == nil || *params.To == "" {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Bad request", "The 'to' query parameter is required")
+	}
+	to, err := naming.ParsePath(*params.To)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Bad request", "Invalid 'to' path: %s", err)
+	}
+	// the requester must be admin of both the source and clone namespaces
+	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+		return err
+	}
+	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, to.Namespace), rbac.GrantRoot); !v {
+		return err
+	}
+	log := LogHandler(ctx, "PostInstanceClone")
+	var snapName string
+	if params.Snapshot != nil {
+		snapName = *params.Snapshot
+	}
+	o, err := localVol(ctx, namespace, kind, name)
+	if o == nil {
+		return err
+	}
+	log = naming.LogWithPath(log, o.Path())
+	if to.Exists() {
+		return JSONProblemf(ctx, http.StatusConflict, "Object exists", "%s", to)
+	}
+	if err := o.Clone(ctx.Request().Context(), to, snapName); err != nil {
+		log.Warnf("clone to %s: %s", to, err)
+		return snapshotProblem(ctx, "Clone", err)
+	}
+	log.Infof("cloned to %s", to)
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,63 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/pool"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+// PostInstanceSnapshot creates a snapshot of the instance vol data.
+func (a *DaemonAPI) PostInstanceSnapshot(ctx echo.Context, nodename, namespace string, kind naming.Kind, name string, params api.PostInstanceSnapshotParams) error {
+	if nodename == a.localhost || nodename == "localhost" {
+		return a.postLocalInstanceSnapshot(ctx, namespace, kind, name, params)
+	}
+	return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.PostInstanceSnapshot(ctx.Request().Context(), nodename, namespace, kind, name, &params)
+	})
+}
+
+func (a *DaemonAPI) postLocalInstanceSnapshot(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PostInstanceSnapshotParams) error {
+	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+		return err
+	}
+	log := LogHandler(ctx, "PostInstanceSnapshot")
+	var snapName string
+	if params.Name != nil {
+		snapName = *params.Name
+		if err := pool.ValidateSnapshotName(snapName); err != nil {
+			return JSONProblemf(ctx, http.StatusBadRequest, "Bad request", "%s", err)
+		}
+	}
+	o, err := localVol(ctx, namespace, kind, name)
+	if o == nil {
+		return err
+	}
+	log = naming.LogWithPath(log, o.Path())
+	snapName, err = o.CreateSnapshot(ctx.Request().Context(), snapName)
+	if err != nil {
+		log.Warnf("create snapshot %s: %s", snapName, err)
+		return snapshotProblem(ctx, "Create snapshot", err)
+	}
+	log.Infof("created snapshot %s", snapName)
+	item := api.SnapshotItem{
+		Node: a.localhost,
+		Path: o.Path().String(),
+		Name: snapName,
+	}
+	if snaps, err := o.Snapshots(ctx.Request().Context()); err == nil {
+		for _, snap := range snaps {
+			if snap.Name == snapName {
+				item.Created = snap.Created
+				item.Used = snap.Used
+				break
+			}
+		}
+	}
+	return ctx.JSON(http.StatusOK, item)
+}
@@ -0,0 +1,53 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/actioncontext"
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+// PostInstanceSnapshotRollback restores the instance vol data to the
+// content of a snapshot.
+func (a *DaemonAPI) PostInstanceSnapshotRollback(ctx echo.Context, nodename, namespace string, kind naming.Kind, name string, params api.PostInstanceSnapshotRollbackParams) error {
+	if nodename == a.localhost || nodename == "localhost" {
+		return a.postLocalInstanceSnapshotRollback(ctx, namespace, kind, name, params)
+	}
+	return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.PostInstanceSnapshotRollback(ctx.Request().Context(), nodename, namespace, kind, name, &params)
+	})
+}
+
+func (a *DaemonAPI) postLocalInstanceSnapshotRollback(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PostInstanceSnapshotRollbackParams) error {
+	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+		return err
+	}
+	log := LogHandler(ctx, "PostInstanceSnapshotRollback")
+	if params.Name == nil || *params.Name == "" {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Bad request", "The 'name' query parameter is required")
+	}
+	force := params.Force != nil && *params.Force
+	o, err := localVol(ctx, namespace, kind, name)
+	if o == nil {
+		return err
+	}
+	p := o.Path()
+	log = naming.LogWithPath(log, p)
+	if instStatus := instance.StatusData.Get(p, a.localhost); !force && instStatus != nil && instStatus.Avail.Is(status.Up, status.Warn) {
+		return JSONProblemf(ctx, http.StatusConflict, "Instance is up", "Refuse to rollback the data of the started instance")
+	}
+	rctx := actioncontext.WithForce(ctx.Request().Context(), force)
+	if err := o.RollbackSnapshot(rctx, *params.Name); err != nil {
+		log.Warnf("rollback to snapshot %s: %s", *params.Name, err)
+		return snapshotProblem(ctx, "Rollback snapshot", err)
+	}
+	log.Infof("rolled back to snapshot %s", *params.Name)
+	return ctx.NoContent(http.StatusNoContent)
+}
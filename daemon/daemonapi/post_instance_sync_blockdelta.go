@@ -0,0 +1,89 @@
+package daemonapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/rbac"
+	"github.com/opensvc/om3/util/blockdelta"
+)
+
+type (
+	blockDeltaReceiver interface {
+		ReceiveBlockDelta(ctx context.Context, r io.Reader, id, base string) (int64, error)
+	}
+)
+
+// PostInstanceSyncBlockdelta applies the block delta stream of the request
+// body to the destination device of a sync.blockdelta resource.
+func (a *DaemonAPI) PostInstanceSyncBlockdelta(ctx echo.Context, nodename, namespace string, kind naming.Kind, name string, params api.PostInstanceSyncBlockdeltaParams) error {
+	if nodename == a.localhost || nodename == "localhost" {
+		return a.postLocalInstanceSyncBlockdelta(ctx, namespace, kind, name, params)
+	}
+	return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.PostInstanceSyncBlockdeltaWithBody(ctx.Request().Context(), nodename, namespace, kind, name, &params, "application/octet-stream", ctx.Request().Body)
+	})
+}
+
+func (a *DaemonAPI) postLocalInstanceSyncBlockdelta(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PostInstanceSyncBlockdeltaParams) error {
+	if v, err := assertGrant(ctx, rbac.GrantRoot); !v {
+		return err
+	}
+	log := LogHandler(ctx, "PostInstanceSyncBlockdelta")
+	p, err := naming.NewPath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Bad request path", fmt.Sprint(err))
+	}
+	log = naming.LogWithPath(log, p)
+	if params.Rid == nil || *params.Rid == "" {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Bad request", "The 'rid' query parameter is required")
+	}
+	rid := *params.Rid
+	if !p.Exists() {
+		return JSONProblemf(ctx, http.StatusNotFound, "Object not found", "")
+	}
+	if instStatus := instance.StatusData.Get(p, a.localhost); instStatus != nil && instStatus.Avail == status.Up {
+		return JSONProblemf(ctx, http.StatusConflict, "Instance is up", "Refuse to overwrite the data of the started instance")
+	}
+	o, err := object.NewActor(p)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "New object", "%s", err)
+	}
+	o.Resources()
+	r := o.ResourceByID(rid)
+	if r == nil {
+		return JSONProblemf(ctx, http.StatusNotFound, "Resource not found", "%s", rid)
+	}
+	i, ok := r.(blockDeltaReceiver)
+	if !ok {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Bad request", "The resource %s does not receive block deltas", rid)
+	}
+	var id, base string
+	if params.Id != nil {
+		id = *params.Id
+	}
+	if params.Base != nil {
+		base = *params.Base
+	}
+	n, err := i.ReceiveBlockDelta(ctx.Request().Context(), ctx.Request().Body, id, base)
+	if errors.Is(err, blockdelta.ErrBaseMismatch) {
+		log.Infof("refuse block delta for %s: %s", rid, err)
+		return JSONProblemf(ctx, http.StatusPreconditionFailed, "Receive block delta", "%s", err)
+	} else if err != nil {
+		log.Warnf("receive block delta for %s: %s", rid, err)
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Receive block delta", "%s", err)
+	}
+	log.Infof("received %d bytes of block delta for %s", n, rid)
+	return ctx.NoContent(http.StatusNoContent)
+}
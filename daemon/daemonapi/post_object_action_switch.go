@@ -14,6 +14,7 @@ import (
 	"github.com/opensvc/om3/daemon/api"
 	"github.com/opensvc/om3/daemon/msgbus"
 	"github.com/opensvc/om3/util/pubsub"
+	"github.com/opensvc/om3/util/tracing"
 )
 
 func (a *DaemonAPI) PostObjectActionSwitch(eCtx echo.Context, namespace string, kind naming.Kind, name string) error {
@@ -38,6 +39,7 @@ func (a *DaemonAPI) PostObjectActionSwitch(eCtx echo.Context, namespace string,
 				Destination: payload.Destination,
 			},
 			CandidateOrchestrationID: uuid.New(),
+			TraceParent:              tracing.TraceParent(ctx),
 		}
 
 		msg, setInstanceMonitorErr := msgbus.NewSetInstanceMonitorWithErr(ctx, p, a.localhost, value)
@@ -0,0 +1,365 @@
+package daemonapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/confighistory"
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/keyop"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/objectselector"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/core/xconfig"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/rbac"
+	"github.com/opensvc/om3/util/key"
+)
+
+type (
+	// applyChange is the change needed to make an object match its
+	// applied configuration.
+	applyChange struct {
+		path   naming.Path
+		action api.ObjectApplyItemAction
+		data   []byte
+		diff   string
+		err    error
+	}
+)
+
+func (a *DaemonAPI) PostObjectApply(ctx echo.Context) error {
+	log := LogHandler(ctx, "PostObjectApply")
+	var payload api.PostObjectApply
+	if err := ctx.Bind(&payload); err != nil {
+		return JSONProblem(ctx, http.StatusBadRequest, "Invalid body", err.Error())
+	}
+	dryRun := payload.DryRun != nil && *payload.DryRun
+	prune := payload.Prune != nil && *payload.Prune
+
+	applied := make(map[naming.Path][]byte)
+	namespaces := make(map[string]any)
+	order := make(naming.Paths, 0, len(payload.Objects))
+	for _, o := range payload.Objects {
+		p, err := naming.ParsePath(o.Path)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid body", "invalid path %s: %s", o.Path, err)
+		}
+		if _, ok := applied[p]; ok {
+			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid body", "duplicate path %s", p)
+		}
+		applied[p] = []byte(o.Data)
+		namespaces[p.Namespace] = nil
+		order = append(order, p)
+	}
+
+	var pruned naming.Paths
+	if prune {
+		selector := ""
+		if payload.PruneSelector != nil {
+			selector = *payload.PruneSelector
+		}
+		var err error
+		pruned, err = a.applyPrunedPaths(selector, namespaces, applied)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid prune selector", "%s", err)
+		}
+		for _, p := range pruned {
+			namespaces[p.Namespace] = nil
+		}
+	}
+
+	for namespace := range namespaces {
+		if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+			return err
+		}
+	}
+
+	changes := make([]applyChange, 0, len(order)+len(pruned))
+	for _, p := range order {
+		changes = append(changes, a.applyPlanChange(ctx, p, applied[p]))
+	}
+	for _, p := range pruned {
+		change := applyChange{path: p, action: api.Delete}
+		if b, err := a.getObjectConfigData(ctx, p); err != nil {
+			change.err = err
+		} else {
+			change.diff = confighistory.Diff(p.String(), b, "/dev/null", nil)
+		}
+		changes = append(changes, change)
+	}
+
+	resp := api.ObjectApplyResult{
+		DryRun: dryRun,
+		Items:  make([]api.ObjectApplyItem, len(changes)),
+	}
+	for i, change := range changes {
+		if !dryRun && change.err == nil && change.action != api.Unchanged {
+			log.Infof("apply: %s %s", change.action, change.path)
+			change.err = a.applyDoChange(ctx, change)
+			if change.err != nil {
+				log.Warnf("apply: %s %s: %s", change.action, change.path, change.err)
+			}
+		}
+		item := api.ObjectApplyItem{
+			Path:   change.path.String(),
+			Action: change.action,
+		}
+		if change.diff != "" {
+			item.Diff = &change.diff
+		}
+		if change.err != nil {
+			s := change.err.Error()
+			item.Error = &s
+		}
+		resp.Items[i] = item
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// applyPrunedPaths returns the paths of the existing objects selected by
+// the prune selector and not in the applied set. The default selector
+// selects all the objects of the applied namespaces. The cluster config
+// object is never selected.
+func (a *DaemonAPI) applyPrunedPaths(selector string, namespaces map[string]any, applied map[naming.Path][]byte) (naming.Paths, error) {
+	paths := object.StatusData.GetPaths()
+	var selected naming.Paths
+	if selector == "" {
+		for _, p := range paths {
+			if _, ok := namespaces[p.Namespace]; ok {
+				selected = append(selected, p)
+			}
+		}
+	} else {
+		var err error
+		selected, err = objectselector.New(
+			selector,
+			objectselector.WithPaths(paths),
+			objectselector.WithLocal(true),
+		).Expand()
+		if err != nil {
+			return nil, err
+		}
+	}
+	l := make(naming.Paths, 0)
+	for _, p := range selected {
+		if _, ok := applied[p]; ok {
+			continue
+		}
+		if p.Kind == naming.KindCcfg {
+			continue
+		}
+		l = append(l, p)
+	}
+	return l, nil
+}
+
+// applyPlanChange returns the change needed to make the object p match the
+// configuration data b.
+func (a *DaemonAPI) applyPlanChange(ctx echo.Context, p naming.Path, b []byte) applyChange {
+	change := applyChange{path: p}
+	var (
+		installed rawconfig.T
+		id        string
+	)
+	if len(instance.ConfigData.GetByPath(p)) == 0 {
+		change.action = api.Create
+		id = uuid.New().String()
+	} else if installedData, err := a.getObjectConfigData(ctx, p); err != nil {
+		change.action = api.Update
+		change.err = err
+		return change
+	} else {
+		installed, id, err = formatObjectConfigData(p, installedData)
+		if err != nil {
+			change.action = api.Update
+			change.err = fmt.Errorf("installed config: %w", err)
+			return change
+		}
+	}
+	data, err := normalizeObjectConfigData(ctx, p, b, id)
+	if err != nil {
+		change.err = err
+		return change
+	}
+	if change.data, err = xconfig.MarshalDocument(data, xconfig.FormatINI); err != nil {
+		change.err = err
+		return change
+	}
+	switch {
+	case change.action == api.Create:
+		change.diff = confighistory.Diff("/dev/null", nil, p.String(), change.data)
+	case sameRawConfig(installed, data):
+		change.action = api.Unchanged
+	default:
+		change.action = api.Update
+		installedData, _ := xconfig.MarshalDocument(installed, xconfig.FormatINI)
+		change.diff = confighistory.Diff(p.String(), installedData, p.String(), change.data)
+	}
+	return change
+}
+
+// applyDoChange creates, updates or deletes the object of change, locally
+// if the object has a local instance or does not exist yet, or through a
+// peer node hosting an instance.
+func (a *DaemonAPI) applyDoChange(ctx echo.Context, change applyChange) error {
+	p := change.path
+	switch change.action {
+	case api.Create:
+		if _, _, err := a.installObjectConfigData(ctx, p, change.data, "apply"); err != nil {
+			return err
+		}
+		return nil
+	case api.Update:
+		if instance.ConfigData.Get(p, a.localhost) != nil {
+			if _, _, err := a.installObjectConfigData(ctx, p, change.data, "apply"); err != nil {
+				return err
+			}
+			return nil
+		}
+		for nodename := range instance.ConfigData.GetByPath(p) {
+			c, err := newProxyClient(ctx, nodename)
+			if err != nil {
+				return err
+			}
+			resp, err := c.PutObjectConfigFileWithBodyWithResponse(ctx.Request().Context(), p.Namespace, p.Kind, p.Name, "application/octet-stream", bytes.NewReader(change.data))
+			if err != nil {
+				return err
+			}
+			if resp.StatusCode() != http.StatusNoContent {
+				return problemError(nodename, resp.Status(), resp.Body)
+			}
+			return nil
+		}
+		return fmt.Errorf("object not found")
+	case api.Delete:
+		if instance.MonitorData.Get(p, a.localhost) != nil {
+			_, err := a.setObjectGlobalExpect(ctx.Request().Context(), p, instance.MonitorGlobalExpectDeleted)
+			return err
+		}
+		for nodename := range instance.MonitorData.GetByPath(p) {
+			c, err := newProxyClient(ctx, nodename)
+			if err != nil {
+				return err
+			}
+			resp, err := c.PostObjectActionDeleteWithResponse(ctx.Request().Context(), p.Namespace, p.Kind, p.Name)
+			if err != nil {
+				return err
+			}
+			if resp.StatusCode() != http.StatusOK {
+				return problemError(nodename, resp.Status(), resp.Body)
+			}
+			return nil
+		}
+		return fmt.Errorf("object not found")
+	}
+	return nil
+}
+
+// getObjectConfigData returns the installed configuration file content of
+// the object p, read from the local node or from a peer node hosting an
+// instance.
+func (a *DaemonAPI) getObjectConfigData(ctx echo.Context, p naming.Path) ([]byte, error) {
+	if instance.ConfigData.Get(p, a.localhost) != nil {
+		return os.ReadFile(p.ConfigFile())
+	}
+	for nodename := range instance.ConfigData.GetByPath(p) {
+		c, err := newProxyClient(ctx, nodename)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetObjectConfigFileWithResponse(ctx.Request().Context(), p.Namespace, p.Kind, p.Name)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return nil, problemError(nodename, resp.Status(), resp.Body)
+		}
+		return resp.Body, nil
+	}
+	return nil, fmt.Errorf("object not found")
+}
+
+// normalizeObjectConfigData returns the validated configuration data b of
+// the object p, as parsed by the config parser. The id is set if b has no
+// id.
+func normalizeObjectConfigData(ctx echo.Context, p naming.Path, b []byte, id string) (rawconfig.T, error) {
+	var raw rawconfig.T
+	o, err := object.New(p, object.WithConfigData(b), object.WithVolatile(true))
+	if err != nil {
+		return raw, err
+	}
+	configurer := o.(object.Configurer)
+	config := configurer.Config()
+	if id != "" && config.GetString(key.New("DEFAULT", "id")) == "" {
+		op := keyop.Parse("id=" + id)
+		if err := config.PrepareSet(*op); err != nil {
+			return raw, err
+		}
+	}
+	alerts, err := configurer.ValidateConfig(ctx.Request().Context())
+	if err != nil {
+		return raw, err
+	}
+	if alerts.HasError() {
+		return raw, errors.New(alerts.StringWithoutMeta())
+	}
+	return config.Raw(), nil
+}
+
+// formatObjectConfigData returns the configuration data b of the object p
+// as parsed by the config parser, and its id.
+func formatObjectConfigData(p naming.Path, b []byte) (rawconfig.T, string, error) {
+	o, err := object.New(p, object.WithConfigData(b), object.WithVolatile(true))
+	if err != nil {
+		return rawconfig.T{}, "", err
+	}
+	config := o.(object.Configurer).Config()
+	return config.Raw(), config.GetString(key.New("DEFAULT", "id")), nil
+}
+
+// sameRawConfig returns true if the configurations a and b have the same
+// keyword values, regardless of the sections and keywords order. A
+// configuration that can't be converted for comparison is considered
+// changed.
+func sameRawConfig(a, b rawconfig.T) bool {
+	toMap := func(raw rawconfig.T) (map[string]map[string]any, error) {
+		m := make(map[string]map[string]any)
+		b, err := json.Marshal(raw)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, &m); err != nil {
+			return nil, err
+		}
+		return m, nil
+	}
+	ma, err := toMap(a)
+	if err != nil {
+		return false
+	}
+	mb, err := toMap(b)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(ma, mb)
+}
+
+// problemError returns an error describing the problem response of a peer
+// node request.
+func problemError(nodename, status string, b []byte) error {
+	var problem api.Problem
+	if err := json.Unmarshal(b, &problem); err == nil && problem.Detail != "" {
+		return fmt.Errorf("%s: %s: %s", nodename, status, problem.Detail)
+	}
+	return fmt.Errorf("%s: %s", nodename, status)
+}
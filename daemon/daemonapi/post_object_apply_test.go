@@ -0,0 +1,27 @@
+package daemonapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/rawconfig"
+)
+
+func TestSameRawConfig(t *testing.T) {
+	parse := func(s string) rawconfig.T {
+		c := rawconfig.New()
+		require.NoError(t, c.UnmarshalJSON([]byte(s)))
+		return c
+	}
+	require.True(t, sameRawConfig(
+		parse(`{"DEFAULT": {"nodes": "n1", "id": "x"}, "fs#1": {"type": "flag"}}`),
+		parse(`{"fs#1": {"type": "flag"}, "DEFAULT": {"id": "x", "nodes": "n1"}}`)))
+	require.False(t, sameRawConfig(
+		parse(`{"DEFAULT": {"nodes": "n1"}}`),
+		parse(`{"DEFAULT": {"nodes": "n2"}}`)))
+	require.False(t, sameRawConfig(
+		parse(`{"DEFAULT": "invalid"}`),
+		parse(`{"DEFAULT": "invalid"}`)),
+		"configurations not comparable are considered changed")
+}
@@ -0,0 +1,45 @@
+package daemonapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/confighistory"
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+func (a *DaemonAPI) PostObjectConfigHistoryRevert(ctx echo.Context, namespace string, kind naming.Kind, name string, revision int) error {
+	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+		return err
+	}
+	log := LogHandler(ctx, "PostObjectConfigHistoryRevert")
+	p, err := naming.NewPath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameter", "invalid path: %s", err)
+	}
+	log = naming.LogWithPath(log, p)
+
+	if instance.ConfigData.Get(p, a.localhost) != nil {
+		_, b, err := a.objectConfigHistory(p).Get(revision)
+		if errors.Is(err, confighistory.ErrNotFound) {
+			return JSONProblemf(ctx, http.StatusNotFound, "Not found", "%s", err)
+		} else if err != nil {
+			log.Errorf("get config revision %d: %s", revision, err)
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Get config revision", "%s", err)
+		}
+		log.Infof("revert %s config to revision %d", p, revision)
+		return a.commitObjectConfigData(ctx, p, b, fmt.Sprintf("revert to revision %d", revision))
+	}
+	for nodename := range instance.ConfigData.GetByPath(p) {
+		return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+			return c.PostObjectConfigHistoryRevert(ctx.Request().Context(), namespace, kind, name, revision)
+		})
+	}
+	return JSONProblemf(ctx, http.StatusNotFound, "Not found", "object not found: %s", p)
+}
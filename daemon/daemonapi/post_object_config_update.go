@@ -91,12 +91,15 @@ func (a *DaemonAPI) PostObjectConfigUpdate(ctx echo.Context, namespace string, k
 			log.Debugf("Validate has errors %s", p)
 			return JSONProblemf(ctx, http.StatusBadRequest, "Validate config", "%s", alerts.StringWithoutMeta())
 		}
+		history := a.objectConfigHistory(p)
+		a.recordObjectConfig(ctx, history, "", "")
 		log.Infof("committing %s", p)
 		if err := oc.Config().CommitInvalid(); err != nil {
 			log.Errorf("CommitInvalid %s: %s", p, err)
 			return JSONProblemf(ctx, http.StatusInternalServerError, "Commit", "%s", err)
 		}
 		log.Infof("committed %s", p)
+		a.recordObjectConfig(ctx, history, userFromContext(ctx).GetUserName(), "")
 		return ctx.NoContent(http.StatusNoContent)
 	}
 
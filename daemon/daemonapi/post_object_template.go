@@ -0,0 +1,185 @@
+package daemonapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/confighistory"
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/objecttemplate"
+	"github.com/opensvc/om3/core/xconfig"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/rbac"
+	"github.com/opensvc/om3/util/key"
+)
+
+func (a *DaemonAPI) PostObjectTemplateRender(ctx echo.Context, namespace string, kind naming.Kind, name string) error {
+	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+		return err
+	}
+	var payload api.PostObjectTemplateRender
+	if err := ctx.Bind(&payload); err != nil {
+		return JSONProblem(ctx, http.StatusBadRequest, "Invalid body", err.Error())
+	}
+	p, err := naming.NewPath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameter", "invalid path: %s", err)
+	}
+	tpl, status, err := a.loadObjectTemplate(ctx, payload.Template)
+	if err != nil {
+		return JSONProblemf(ctx, status, "Load template", "%s", err)
+	}
+	var params map[string]string
+	if payload.Params != nil {
+		params = *payload.Params
+	}
+	raw, err := tpl.Render(params)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Render template", "%s: %s", p, err)
+	}
+	b, err := xconfig.MarshalDocument(raw, xconfig.FormatINI)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Render template", "%s: %s", p, err)
+	}
+	return ctx.JSON(http.StatusOK, api.ObjectTemplateRender{Data: string(b)})
+}
+
+func (a *DaemonAPI) PostObjectTemplateUpgrade(ctx echo.Context, namespace string, kind naming.Kind, name string) error {
+	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+		return err
+	}
+	log := LogHandler(ctx, "PostObjectTemplateUpgrade")
+	var payload api.PostObjectTemplateUpgrade
+	if err := ctx.Bind(&payload); err != nil {
+		return JSONProblem(ctx, http.StatusBadRequest, "Invalid body", err.Error())
+	}
+	p, err := naming.NewPath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameter", "invalid path: %s", err)
+	}
+	log = naming.LogWithPath(log, p)
+
+	if instance.ConfigData.Get(p, a.localhost) == nil {
+		for nodename := range instance.ConfigData.GetByPath(p) {
+			return a.proxy(ctx, nodename, func(c *client.T) (*http.Response, error) {
+				return c.PostObjectTemplateUpgrade(ctx.Request().Context(), namespace, kind, name, payload)
+			})
+		}
+		return JSONProblemf(ctx, http.StatusNotFound, "Not found", "object not found: %s", p)
+	}
+
+	installedData, err := os.ReadFile(p.ConfigFile())
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Read config", "%s", err)
+	}
+	o, err := object.New(p, object.WithConfigData(installedData), object.WithVolatile(true))
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Load config", "%s", err)
+	}
+	config := o.(object.Configurer).Config()
+	installed := config.Raw()
+	id := config.GetString(key.New("DEFAULT", "id"))
+	templateName := config.GetString(key.New("DEFAULT", objecttemplate.KeywordTemplate))
+	if templateName == "" {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Upgrade template", "%s was not created from a template", p)
+	}
+	params, err := objecttemplate.ParseRecordedParams(config.GetString(key.New("DEFAULT", objecttemplate.KeywordTemplateParams)))
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Upgrade template", "%s: recorded params: %s", p, err)
+	}
+	if payload.Params != nil {
+		for k, v := range *payload.Params {
+			params[k] = v
+		}
+	}
+
+	tpl, status, err := a.loadObjectTemplate(ctx, templateName)
+	if err != nil {
+		return JSONProblemf(ctx, status, "Load template", "%s", err)
+	}
+	raw, err := tpl.Render(params)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Render template", "%s: %s", p, err)
+	}
+	b, err := xconfig.MarshalDocument(raw, xconfig.FormatINI)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Render template", "%s: %s", p, err)
+	}
+	data, err := normalizeObjectConfigData(ctx, p, b, id)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Render template", "%s: %s", p, err)
+	}
+
+	item := api.ObjectApplyItem{
+		Path:   p.String(),
+		Action: api.Unchanged,
+	}
+	if sameRawConfig(installed, data) {
+		return ctx.JSON(http.StatusOK, item)
+	}
+	item.Action = api.Update
+	if b, err = xconfig.MarshalDocument(data, xconfig.FormatINI); err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Render template", "%s: %s", p, err)
+	}
+	diff := confighistory.Diff(p.String(), installedData, p.String(), b)
+	item.Diff = &diff
+	if payload.DryRun != nil && *payload.DryRun {
+		return ctx.JSON(http.StatusOK, item)
+	}
+	log.Infof("upgrade %s config from template %s", p, templateName)
+	if status, title, err := a.installObjectConfigData(ctx, p, b, fmt.Sprintf("upgrade from template %s", templateName)); err != nil {
+		return JSONProblemf(ctx, status, title, "%s", err)
+	}
+	return ctx.JSON(http.StatusOK, item)
+}
+
+// loadObjectTemplate returns the template name, read from the local
+// template files, or from the templates cfg object keys of the local
+// instance or of a peer instance. The returned status is the http status
+// code to respond on error.
+func (a *DaemonAPI) loadObjectTemplate(ctx echo.Context, name string) (*objecttemplate.T, int, error) {
+	_, err := objecttemplate.ReadFile(name)
+	switch {
+	case err == nil, instance.ConfigData.Get(objecttemplate.CfgPath, a.localhost) != nil:
+		tpl, err := objecttemplate.Load(name)
+		switch {
+		case errors.Is(err, objecttemplate.ErrNotFound):
+			return nil, http.StatusNotFound, err
+		case err != nil:
+			return nil, http.StatusInternalServerError, err
+		}
+		return tpl, http.StatusOK, nil
+	case !errors.Is(err, objecttemplate.ErrNotFound):
+		return nil, http.StatusBadRequest, err
+	}
+	p := objecttemplate.CfgPath
+	for nodename := range instance.ConfigData.GetByPath(p) {
+		c, err := newProxyClient(ctx, nodename)
+		if err != nil {
+			return nil, http.StatusInternalServerError, err
+		}
+		params := api.GetObjectKVStoreEntryParams{Key: name}
+		resp, err := c.GetObjectKVStoreEntryWithResponse(ctx.Request().Context(), p.Namespace, p.Kind, p.Name, &params)
+		switch {
+		case err != nil:
+			return nil, http.StatusInternalServerError, err
+		case resp.StatusCode() == http.StatusNotFound:
+			return nil, http.StatusNotFound, fmt.Errorf("%w: %s", objecttemplate.ErrNotFound, name)
+		case resp.StatusCode() != http.StatusOK:
+			return nil, http.StatusInternalServerError, problemError(nodename, resp.Status(), resp.Body)
+		}
+		tpl, err := objecttemplate.Parse(name, resp.Body)
+		if err != nil {
+			return nil, http.StatusInternalServerError, err
+		}
+		return tpl, http.StatusOK, nil
+	}
+	return nil, http.StatusNotFound, fmt.Errorf("%w: %s", objecttemplate.ErrNotFound, name)
+}
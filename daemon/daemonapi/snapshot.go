@@ -0,0 +1,46 @@
+package daemonapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/pool"
+)
+
+// localVol returns the local vol object identified by the request path
+// parameters, or writes the problem response and returns a nil object.
+func localVol(ctx echo.Context, namespace string, kind naming.Kind, name string) (object.Vol, error) {
+	p, err := naming.NewPath(namespace, kind, name)
+	if err != nil {
+		return nil, JSONProblemf(ctx, http.StatusBadRequest, "Bad request path", fmt.Sprint(err))
+	}
+	if p.Kind != naming.KindVol {
+		return nil, JSONProblemf(ctx, http.StatusBadRequest, "Bad request path", "%s is not a vol object", p)
+	}
+	if !p.Exists() {
+		return nil, JSONProblemf(ctx, http.StatusNotFound, "Object not found", "%s", p)
+	}
+	o, err := object.NewVol(p)
+	if err != nil {
+		return nil, JSONProblemf(ctx, http.StatusInternalServerError, "New object", "%s", err)
+	}
+	return o, nil
+}
+
+// snapshotProblem writes the problem response of a snapshot operation
+// error.
+func snapshotProblem(ctx echo.Context, title string, err error) error {
+	switch {
+	case errors.Is(err, pool.ErrSnapshotNotFound):
+		return JSONProblemf(ctx, http.StatusNotFound, title, "%s", err)
+	case errors.Is(err, pool.ErrSnapshotExists):
+		return JSONProblemf(ctx, http.StatusConflict, title, "%s", err)
+	default:
+		return JSONProblemf(ctx, http.StatusInternalServerError, title, "%s", err)
+	}
+}
@@ -11,6 +11,7 @@ import (
 //	  if clusterData ops exists (changes)
 //	     increase gen
 //	     refresh local node gen
+//	     refresh the gen of the changed local instances
 //
 //		 when hb mode is patch
 //		  if changes
@@ -26,6 +27,10 @@ func (d *data) commitPendingOps() (changes bool) {
 		changes = true
 		d.gen++
 		d.hbGens[d.localNode][d.localNode] = d.gen
+		for p := range d.pendingInstPaths {
+			d.instGen[p] = d.gen
+		}
+		clear(d.pendingInstPaths)
 		if _, ok := d.clusterData.Cluster.Node[d.localNode]; !ok {
 			d.log.Warnf("commitPendingOps -> d.clusterData.Cluster.Node[%s] is absent", d.localNode)
 		} else {
@@ -37,11 +42,11 @@ func (d *data) commitPendingOps() (changes bool) {
 		if changes {
 			// add new eventQueue entry created for gen in event queue with events
 			d.eventQueue[strconv.FormatUint(d.gen, 10)] = d.pendingEvs
-			d.pendingEvs = []event.Event{}
+			d.resetPendingEvs()
 		}
 		d.purgeAppliedPatchQueue()
 	default:
-		d.pendingEvs = []event.Event{}
+		d.resetPendingEvs()
 		d.eventQueue = make(map[string][]event.Event)
 	}
 	d.hbGens[d.localNode][d.localNode] = d.gen
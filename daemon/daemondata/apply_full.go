@@ -1,6 +1,7 @@
 package daemondata
 
 import (
+	"fmt"
 	"reflect"
 	"time"
 
@@ -19,16 +20,57 @@ func (d *data) applyNodeData(msg *hbtype.Msg) error {
 	local := d.localNode
 	d.log.Debugf("applyNodeData %s", remote)
 
-	d.clusterData.Cluster.Node[remote] = msg.NodeData
+	nodeData := msg.NodeData
+	if msg.Delta != nil {
+		instances, err := d.mergeDeltaInstances(remote, msg)
+		if err != nil {
+			// announce we need the whole node data
+			d.hbBases[remote] = 0
+			return fmt.Errorf("apply delta: %w", err)
+		}
+		nodeData.Instance = instances
+	}
+
+	d.clusterData.Cluster.Node[remote] = nodeData
 	d.hbGens[local][remote] = msg.NodeData.Status.Gen[remote]
+	d.hbBases[remote] = msg.NodeData.Status.Gen[remote]
 	d.clusterData.Cluster.Node[local].Status.Gen[remote] = msg.NodeData.Status.Gen[remote]
 
-	d.bus.Pub(&msgbus.NodeDataUpdated{Node: remote, Value: msg.NodeData}, peerLabel, labelFromPeer)
+	d.bus.Pub(&msgbus.NodeDataUpdated{Node: remote, Value: nodeData}, peerLabel, labelFromPeer)
 
 	d.pubPeerDataChanges(remote)
 	return nil
 }
 
+// mergeDeltaInstances returns the <remote> instances of the full message
+// <msg> node data delta: the instances of the message, and the unchanged
+// instances of the node data applied locally.
+//
+// The delta applies only if the local node data was received from the same
+// peer daemon run, at a gen not older than the delta base.
+func (d *data) mergeDeltaInstances(remote string, msg *hbtype.Msg) (map[string]instance.Instance, error) {
+	current, ok := d.clusterData.Cluster.Node[remote]
+	switch {
+	case !ok:
+		return nil, fmt.Errorf("no %s node data", remote)
+	case d.hbBases[remote] < msg.Delta.Base:
+		return nil, fmt.Errorf("applied gen %d is older than the delta base %d", d.hbBases[remote], msg.Delta.Base)
+	case current.Daemon.Pid != msg.NodeData.Daemon.Pid || !current.Daemon.StartedAt.Equal(msg.NodeData.Daemon.StartedAt):
+		return nil, fmt.Errorf("applied node data is from a previous daemon run")
+	}
+	instances := make(map[string]instance.Instance, len(msg.Delta.Instances))
+	for _, p := range msg.Delta.Instances {
+		if inst, ok := msg.NodeData.Instance[p]; ok {
+			instances[p] = inst
+		} else if inst, ok := current.Instance[p]; ok {
+			instances[p] = inst
+		} else {
+			return nil, fmt.Errorf("unchanged instance %s not found", p)
+		}
+	}
+	return instances, nil
+}
+
 func (d *data) refreshPreviousUpdated(peer string) *remoteInfo {
 	c := d.clusterData.Cluster.Node[peer]
 	result := remoteInfo{
@@ -46,10 +88,12 @@ func (d *data) refreshPreviousUpdated(peer string) *remoteInfo {
 	}
 	result.nmonUpdated = nmonUpdated
 
+	result.clusterSecretUpdated = c.Daemon.ClusterSecret.UpdatedAt
 	result.collectorUpdated = c.Daemon.Collector.UpdatedAt
 	result.daemondataUpdated = c.Daemon.Daemondata.UpdatedAt
 	result.dnsUpdated = c.Daemon.Dns.UpdatedAt
 	result.listenerUpdated = c.Daemon.Listener.UpdatedAt
+	result.notifierUpdated = c.Daemon.Notifier.UpdatedAt
 	result.runnerImon = c.Daemon.RunnerImon.UpdatedAt
 	result.scheduler = c.Daemon.Scheduler.UpdatedAt
 
@@ -86,10 +130,12 @@ func (d *data) pubPeerDataChanges(peer string) {
 	d.pubMsgFromNodeConfigDiffForNode(peer)
 	d.pubMsgFromNodeStatusDiffForNode(peer)
 	d.pubMsgFromNodeStatsDiffForNode(peer)
+	d.pubMsgFromNodeClusterSecretDiffForNode(peer, current)
 	d.pubMsgFromNodeCollectorDiffForNode(peer, current)
 	d.pubMsgFromNodeDaemondataDiffForNode(peer, current)
 	d.pubMsgFromNodeDnsDiffForNode(peer, current)
 	d.pubMsgFromNodeListenerDiffForNode(peer, current)
+	d.pubMsgFromNodeNotifierDiffForNode(peer, current)
 	d.pubMsgFromNodeRunnerImonDiffForNode(peer, current)
 	d.pubMsgFromNodeSchedulerDiffForNode(peer, current)
 	d.pubMsgFromNodeMonitorDiffForNode(peer, current)
@@ -210,6 +256,22 @@ func (d *data) pubMsgFromNodeStatusDiffForNode(peer string) {
 	}
 }
 
+func (d *data) pubMsgFromNodeClusterSecretDiffForNode(peer string, current *remoteInfo) {
+	if current == nil {
+		return
+	}
+	prevTimes, hasPrev := d.previousRemoteInfo[peer]
+	if !hasPrev || current.clusterSecretUpdated.After(prevTimes.clusterSecretUpdated) {
+		found := d.clusterData.Cluster.Node[peer].Daemon.ClusterSecret
+		daemonsubsystem.DataClusterSecret.Set(peer, found.DeepCopy())
+		d.bus.Pub(&msgbus.DaemonClusterSecretUpdated{Node: peer, Value: *found.DeepCopy()},
+			pubsub.Label{"node", peer},
+			labelFromPeer,
+		)
+		return
+	}
+}
+
 func (d *data) pubMsgFromNodeCollectorDiffForNode(peer string, current *remoteInfo) {
 	if current == nil {
 		return
@@ -274,6 +336,22 @@ func (d *data) pubMsgFromNodeListenerDiffForNode(peer string, current *remoteInf
 	}
 }
 
+func (d *data) pubMsgFromNodeNotifierDiffForNode(peer string, current *remoteInfo) {
+	if current == nil {
+		return
+	}
+	prevTimes, hasPrev := d.previousRemoteInfo[peer]
+	if !hasPrev || current.notifierUpdated.After(prevTimes.notifierUpdated) {
+		found := d.clusterData.Cluster.Node[peer].Daemon.Notifier
+		daemonsubsystem.DataNotifier.Set(peer, found.DeepCopy())
+		d.bus.Pub(&msgbus.DaemonNotifierUpdated{Node: peer, Value: *found.DeepCopy()},
+			pubsub.Label{"node", peer},
+			labelFromPeer,
+		)
+		return
+	}
+}
+
 func (d *data) pubMsgFromNodeRunnerImonDiffForNode(peer string, current *remoteInfo) {
 	if current == nil {
 		return
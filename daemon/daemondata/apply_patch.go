@@ -112,6 +112,7 @@ func (d *data) applyMsgEvents(msg *hbtype.Msg) error {
 		}
 
 		d.hbPatchMsgUpdated[remote] = msg.UpdatedAt
+		d.hbBases[remote] = gen
 
 		pendingNodeGen = gen
 	}
@@ -132,6 +133,9 @@ func (d *data) setCacheAndPublish(ev event.Event) error {
 
 	switch c := msg.(type) {
 	// daemon
+	case *msgbus.DaemonClusterSecretUpdated:
+		daemonsubsystem.DataClusterSecret.Set(c.Node, &c.Value)
+		d.bus.Pub(c, labelFromPeer)
 	case *msgbus.DaemonCollectorUpdated:
 		daemonsubsystem.DataCollector.Set(c.Node, &c.Value)
 		d.bus.Pub(c, labelFromPeer)
@@ -147,6 +151,9 @@ func (d *data) setCacheAndPublish(ev event.Event) error {
 	case *msgbus.DaemonListenerUpdated:
 		daemonsubsystem.DataListener.Set(c.Node, &c.Value)
 		d.bus.Pub(c, labelFromPeer)
+	case *msgbus.DaemonNotifierUpdated:
+		daemonsubsystem.DataNotifier.Set(c.Node, &c.Value)
+		d.bus.Pub(c, labelFromPeer)
 	case *msgbus.DaemonRunnerImonUpdated:
 		daemonsubsystem.DataRunnerImon.Set(c.Node, &c.Value)
 		d.bus.Pub(c, labelFromPeer)
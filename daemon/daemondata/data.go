@@ -13,6 +13,7 @@ import (
 
 	"github.com/opensvc/om3/core/event"
 	"github.com/opensvc/om3/core/hbtype"
+	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/core/node"
 	"github.com/opensvc/om3/daemon/msgbus"
 	"github.com/opensvc/om3/util/durationlog"
@@ -44,6 +45,18 @@ type (
 		hbMessageType string        // latest created hb message type
 		localNode     string
 
+		// pendingEvLastKey is the compaction key of the last pending
+		// event, see compactionKey.
+		pendingEvLastKey string
+
+		// pendingInstPaths are the paths of the local instances changed by
+		// the pending events.
+		pendingInstPaths map[string]struct{}
+
+		// instGen maps the paths of the local instances to the gen of
+		// their latest change, used to compute the full message delta.
+		instGen map[string]uint64
+
 		// cluster nodes from local cluster config, it is updated from
 		// msgbus.ClusterConfigUpdated {NodesAdded, NodesRemoved}
 		clusterNodes map[string]struct{}
@@ -79,6 +92,16 @@ type (
 		// It is used to drop outdated patch messages
 		hbPatchMsgUpdated map[string]time.Time
 
+		// hbBases maps the peers to the gen of their node data fully
+		// applied locally. Unlike hbGens, it is not reset when a full
+		// message is needed, and it is announced to the peers as the base
+		// of their full message delta.
+		hbBases map[string]uint64
+
+		// hbPeerBases maps the peers to the gen of the local node data
+		// they announced as fully applied.
+		hbPeerBases map[string]uint64
+
 		// needMsg is set to true when a peer node doesn't know localnode current data gen
 		// set to false after a hb message is created
 		needMsg bool
@@ -91,12 +114,14 @@ type (
 
 	// remoteInfo struct holds information about remote node used to publish diff on full message received
 	remoteInfo struct {
-		collectorUpdated  time.Time
-		daemondataUpdated time.Time
-		dnsUpdated        time.Time
-		listenerUpdated   time.Time
-		runnerImon        time.Time
-		scheduler         time.Time
+		clusterSecretUpdated time.Time
+		collectorUpdated     time.Time
+		daemondataUpdated    time.Time
+		dnsUpdated           time.Time
+		listenerUpdated      time.Time
+		notifierUpdated      time.Time
+		runnerImon           time.Time
+		scheduler            time.Time
 
 		nmonUpdated       time.Time
 		nodeStats         node.Stats
@@ -134,6 +159,12 @@ var (
 			Help: "The total number of daemondata on receive queue operations",
 		},
 		[]string{"operation"})
+
+	forwardEventCompactedTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "opensvc_daemondata_forward_event_compacted_total",
+			Help: "The total number of pending events for peers replaced by a more recent event",
+		})
 )
 
 func PropagationInterval() time.Duration {
@@ -389,11 +420,13 @@ func (d *data) startSubscriptions(qs pubsub.QueueSizer) {
 	sub.AddFilter(&msgbus.ClusterConfigUpdated{}, d.labelLocalNode)
 	sub.AddFilter(&msgbus.ClusterStatusUpdated{}, d.labelLocalNode)
 
+	sub.AddFilter(&msgbus.DaemonClusterSecretUpdated{}, d.labelLocalNode)
 	sub.AddFilter(&msgbus.DaemonCollectorUpdated{}, d.labelLocalNode)
 	sub.AddFilter(&msgbus.DaemonDataUpdated{}, d.labelLocalNode)
 	sub.AddFilter(&msgbus.DaemonDnsUpdated{}, d.labelLocalNode)
 	sub.AddFilter(&msgbus.DaemonHeartbeatUpdated{}, d.labelLocalNode)
 	sub.AddFilter(&msgbus.DaemonListenerUpdated{}, d.labelLocalNode)
+	sub.AddFilter(&msgbus.DaemonNotifierUpdated{}, d.labelLocalNode)
 	sub.AddFilter(&msgbus.DaemonRunnerImonUpdated{}, d.labelLocalNode)
 	sub.AddFilter(&msgbus.DaemonSchedulerUpdated{}, d.labelLocalNode)
 	sub.AddFilter(&msgbus.DaemonStatusUpdated{}, d.labelLocalNode)
@@ -432,23 +465,84 @@ func marshalEventData(v any) json.RawMessage {
 
 func (d *data) forwardEvent(i event.Kinder) {
 	eventID++
-	d.pendingEvs = append(d.pendingEvs, event.Event{
+	ev := event.Event{
 		Kind: i.Kind(),
 		ID:   eventID,
 		At:   time.Now(),
 		Data: marshalEventData(i),
-	})
+	}
+	k := compactionKey(i)
+	if n := len(d.pendingEvs); k != "" && n > 0 && k == d.pendingEvLastKey {
+		d.pendingEvs[n-1] = ev
+		forwardEventCompactedTotal.Inc()
+		return
+	}
+	d.pendingEvs = append(d.pendingEvs, ev)
+	d.pendingEvLastKey = k
+}
+
+// markInstanceChanged records the path of the local instance changed by
+// the event i, so the instance is sent in the next full message delta.
+func (d *data) markInstanceChanged(i any) {
+	var p naming.Path
+	switch c := i.(type) {
+	case *msgbus.InstanceConfigDeleted:
+		p = c.Path
+	case *msgbus.InstanceConfigUpdated:
+		p = c.Path
+	case *msgbus.InstanceMonitorDeleted:
+		p = c.Path
+	case *msgbus.InstanceMonitorUpdated:
+		p = c.Path
+	case *msgbus.InstanceStatusDeleted:
+		p = c.Path
+	case *msgbus.InstanceStatusUpdated:
+		p = c.Path
+	default:
+		return
+	}
+	d.pendingInstPaths[p.String()] = struct{}{}
+}
+
+// resetPendingEvs drops the local events for peers not yet committed to
+// eventQueue.
+func (d *data) resetPendingEvs() {
+	d.pendingEvs = []event.Event{}
+	d.pendingEvLastKey = ""
+}
+
+// compactionKey returns the key of the per instance or per node state
+// replaced as a whole by the local event i, or an empty string if i must
+// not be compacted.
+//
+// The last pending event is replaced when the next event forwarded has the
+// same key, so a state changing many times in a row between two commits is
+// sent once to the peers. Only adjacent events are compacted, to preserve
+// the order of the events observed by the peers. The compaction is limited
+// to the status events, the monitor events must not be compacted because
+// the peers react to the intermediate states.
+func compactionKey(i any) string {
+	switch c := i.(type) {
+	case *msgbus.InstanceStatusUpdated:
+		return c.Kind() + ":" + c.Path.String()
+	case *msgbus.NodeStatsUpdated:
+		return c.Kind()
+	default:
+		return ""
+	}
 }
 
 // localEventMustBeForwarded returns true when local event i must be forwarded to peers
 func localEventMustBeForwarded(i interface{}) bool {
 	switch i.(type) {
 	// daemon...
+	case *msgbus.DaemonClusterSecretUpdated:
 	case *msgbus.DaemonCollectorUpdated:
 	case *msgbus.DaemonDataUpdated:
 	case *msgbus.DaemonDnsUpdated:
 	case *msgbus.DaemonHeartbeatUpdated:
 	case *msgbus.DaemonListenerUpdated:
+	case *msgbus.DaemonNotifierUpdated:
 	case *msgbus.DaemonRunnerImonUpdated:
 	case *msgbus.DaemonSchedulerUpdated:
 	case *msgbus.DaemonStatusUpdated:
@@ -496,6 +590,7 @@ func (d *data) onSubEvent(i interface{}) {
 	if localEventMustBeForwarded(i) {
 		if k, ok := i.(event.Kinder); ok {
 			d.forwardEvent(k)
+			d.markInstanceChanged(i)
 		}
 	}
 
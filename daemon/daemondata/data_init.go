@@ -57,6 +57,10 @@ func newData() *data {
 		hbGens:             map[string]map[string]uint64{localNode: {localNode: 0}},
 		hbMessageType:      initialMsgType,
 		hbPatchMsgUpdated:  make(map[string]time.Time),
+		hbBases:            make(map[string]uint64),
+		hbPeerBases:        make(map[string]uint64),
+		instGen:            make(map[string]uint64),
+		pendingInstPaths:   make(map[string]struct{}),
 		localNode:          localNode,
 		clusterNodes:       map[string]struct{}{localNode: {}},
 		clusterData:        msgbus.NewClusterData(status.DeepCopy()),
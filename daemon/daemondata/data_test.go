@@ -0,0 +1,137 @@
+package daemondata
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/clusterdump"
+	"github.com/opensvc/om3/core/event"
+	"github.com/opensvc/om3/core/hbtype"
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/daemon/daemonsubsystem"
+	"github.com/opensvc/om3/daemon/msgbus"
+)
+
+func TestForwardEventCompaction(t *testing.T) {
+	d := &data{}
+	d.resetPendingEvs()
+	p1 := naming.Path{Name: "svc1", Kind: naming.KindSvc}
+	p2 := naming.Path{Name: "svc2", Kind: naming.KindSvc}
+
+	d.forwardEvent(&msgbus.InstanceStatusUpdated{Path: p1, Node: "node1"})
+	d.forwardEvent(&msgbus.InstanceStatusUpdated{Path: p1, Node: "node1"})
+	d.forwardEvent(&msgbus.InstanceMonitorUpdated{Path: p1, Node: "node1"})
+	d.forwardEvent(&msgbus.InstanceStatusUpdated{Path: p1, Node: "node1"})
+	d.forwardEvent(&msgbus.InstanceStatusUpdated{Path: p2, Node: "node1"})
+	d.forwardEvent(&msgbus.InstanceStatusUpdated{Path: p1, Node: "node1"})
+	d.forwardEvent(&msgbus.InstanceMonitorUpdated{Path: p1, Node: "node1"})
+	d.forwardEvent(&msgbus.InstanceMonitorUpdated{Path: p1, Node: "node1"})
+
+	type evPath struct {
+		Path naming.Path `json:"path"`
+	}
+	events := func() []string {
+		l := make([]string, 0)
+		for _, e := range d.pendingEvs {
+			var v evPath
+			require.NoError(t, json.Unmarshal(e.Data, &v))
+			l = append(l, e.Kind+" "+v.Path.String())
+		}
+		return l
+	}
+	require.Equal(t,
+		[]string{
+			"InstanceStatusUpdated svc1",
+			"InstanceMonitorUpdated svc1",
+			"InstanceStatusUpdated svc1",
+			"InstanceStatusUpdated svc2",
+			"InstanceStatusUpdated svc1",
+			"InstanceMonitorUpdated svc1",
+			"InstanceMonitorUpdated svc1",
+		},
+		events(),
+		"only the adjacent status events of the same instance are compacted, the events order is preserved")
+	require.Equal(t, d.pendingEvs[1].ID-1, d.pendingEvs[0].ID, "the compacted event is the most recent")
+
+	d.resetPendingEvs()
+	require.Equal(t, []event.Event{}, d.pendingEvs)
+	require.Empty(t, d.pendingEvLastKey)
+}
+
+func TestFullMessageDelta(t *testing.T) {
+	startedAt := time.Now()
+	newNode := func(gen uint64, paths ...string) node.Node {
+		n := node.Node{
+			Instance: make(map[string]instance.Instance),
+			Status:   node.Status{Gen: map[string]uint64{"node1": gen}},
+			Daemon:   daemonsubsystem.Daemon{Pid: 10, StartedAt: startedAt},
+		}
+		for _, p := range paths {
+			n.Instance[p] = instance.Instance{Status: &instance.Status{UpdatedAt: time.Unix(int64(gen), 0)}}
+		}
+		return n
+	}
+
+	sender := &data{
+		localNode:        "node1",
+		gen:              5,
+		hbGens:           map[string]map[string]uint64{"node1": {"node1": 5}, "node2": {"node1": 3}, "node3": {"node1": 5}},
+		hbPeerBases:      map[string]uint64{"node2": 3},
+		instGen:          map[string]uint64{"svc1": 2, "svc2": 4, "svc3": 1},
+		pendingInstPaths: map[string]struct{}{"svc3": {}},
+	}
+	require.Equal(t, uint64(3), sender.deltaBase(), "the up to date peers are ignored")
+
+	msg := &hbtype.Msg{Kind: "full", Nodename: "node1", NodeData: newNode(5, "svc1", "svc2", "svc3", "svc4")}
+	msg.Delta = sender.deltaNodeData(&msg.NodeData, 3)
+	require.Equal(t, []string{"svc1", "svc2", "svc3", "svc4"}, msg.Delta.Instances)
+	require.NotContains(t, msg.NodeData.Instance, "svc1", "svc1 is unchanged since the base")
+	require.Contains(t, msg.NodeData.Instance, "svc2", "svc2 is changed since the base")
+	require.Contains(t, msg.NodeData.Instance, "svc3", "svc3 has pending changes")
+	require.Contains(t, msg.NodeData.Instance, "svc4", "svc4 has an unknown gen")
+
+	newReceiver := func(base uint64, current node.Node) *data {
+		return &data{
+			localNode: "node2",
+			hbBases:   map[string]uint64{"node1": base},
+			clusterData: msgbus.NewClusterData(&clusterdump.Data{
+				Cluster: clusterdump.Cluster{Node: map[string]node.Node{"node1": current}},
+			}),
+		}
+	}
+
+	t.Run("delta applied", func(t *testing.T) {
+		// svc0 is deleted, svc1 is kept from the applied node data
+		r := newReceiver(4, newNode(4, "svc0", "svc1", "svc2"))
+		instances, err := r.mergeDeltaInstances("node1", msg)
+		require.NoError(t, err)
+		require.Len(t, instances, 4)
+		require.NotContains(t, instances, "svc0")
+		require.Equal(t, time.Unix(4, 0), instances["svc1"].Status.UpdatedAt)
+		require.Equal(t, time.Unix(5, 0), instances["svc2"].Status.UpdatedAt)
+	})
+
+	t.Run("applied gen older than the base", func(t *testing.T) {
+		r := newReceiver(2, newNode(2, "svc1", "svc2"))
+		_, err := r.mergeDeltaInstances("node1", msg)
+		require.ErrorContains(t, err, "older than the delta base")
+	})
+
+	t.Run("node data of a previous daemon run", func(t *testing.T) {
+		current := newNode(4, "svc1", "svc2")
+		current.Daemon.Pid = 9
+		r := newReceiver(4, current)
+		_, err := r.mergeDeltaInstances("node1", msg)
+		require.ErrorContains(t, err, "previous daemon run")
+	})
+
+	t.Run("peer needing the whole node data", func(t *testing.T) {
+		sender.hbGens["node4"] = map[string]uint64{"node1": 0}
+		require.Equal(t, uint64(0), sender.deltaBase())
+	})
+}
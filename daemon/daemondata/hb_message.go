@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/opensvc/om3/core/hbtype"
+	"github.com/opensvc/om3/core/node"
 )
 
 type (
@@ -85,6 +86,12 @@ func (d *data) getHbMessage() (hbtype.Msg, error) {
 		Gen:       d.deepCopyLocalGens(),
 		UpdatedAt: time.Now(),
 	}
+	if len(d.hbBases) > 0 {
+		msg.Bases = make(map[string]uint64, len(d.hbBases))
+		for n, gen := range d.hbBases {
+			msg.Bases[n] = gen
+		}
+	}
 	switch d.hbMessageType {
 	case "patch":
 		events, err := d.eventQueue.deepCopy()
@@ -105,6 +112,9 @@ func (d *data) getHbMessage() (hbtype.Msg, error) {
 		}
 		nodeData := d.clusterData.Cluster.Node[d.localNode]
 		msg.NodeData = *nodeData.DeepCopy()
+		if base := d.deltaBase(); base > 0 {
+			msg.Delta = d.deltaNodeData(&msg.NodeData, base)
+		}
 		d.setHbMsgPatchLength(d.localNode, 0)
 		return msg, nil
 	case "ping":
@@ -117,6 +127,48 @@ func (d *data) getHbMessage() (hbtype.Msg, error) {
 	}
 }
 
+// deltaBase returns the local gen the full message instances can be a
+// delta of: the oldest local gen announced as fully applied by the peers
+// not up to date. It returns 0 if one of these peers needs the whole node
+// data, like the peers running an older agent or with no local node data.
+func (d *data) deltaBase() uint64 {
+	var base uint64
+	for peer, peerGens := range d.hbGens {
+		if peer == d.localNode || peerGens[d.localNode] == d.gen {
+			continue
+		}
+		peerBase := d.hbPeerBases[peer]
+		if peerBase == 0 || peerBase > d.gen {
+			// unknown, or announced for a previous daemon run
+			return 0
+		}
+		if base == 0 || peerBase < base {
+			base = peerBase
+		}
+	}
+	return base
+}
+
+// deltaNodeData removes from <nodeData> the local instances unchanged since
+// the <base> gen, and returns the delta describing the removal.
+func (d *data) deltaNodeData(nodeData *node.Node, base uint64) *hbtype.MsgDelta {
+	delta := &hbtype.MsgDelta{
+		Base:      base,
+		Instances: make([]string, 0, len(nodeData.Instance)),
+	}
+	for p := range nodeData.Instance {
+		delta.Instances = append(delta.Instances, p)
+		if _, ok := d.pendingInstPaths[p]; ok {
+			continue
+		}
+		if gen, ok := d.instGen[p]; ok && gen <= base {
+			delete(nodeData.Instance, p)
+		}
+	}
+	sort.Strings(delta.Instances)
+	return delta
+}
+
 // deepCopy return clone of p
 func (p eventQueue) deepCopy() (result eventQueue, err error) {
 	var b []byte
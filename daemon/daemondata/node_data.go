@@ -102,11 +102,13 @@ func (d *data) dropPeer(peer string) {
 		d.bus.Pub(&msgbus.NodeMonitorDeleted{Node: peer}, peerLabels...)
 
 		daemonsubsystem.DropNode(peer)
+		d.bus.Pub(&msgbus.DaemonClusterSecretUpdated{Node: peer}, peerLabels...)
 		d.bus.Pub(&msgbus.DaemonCollectorUpdated{Node: peer}, peerLabels...)
 		d.bus.Pub(&msgbus.DaemonDataUpdated{Node: peer}, peerLabels...)
 		d.bus.Pub(&msgbus.DaemonDnsUpdated{Node: peer}, peerLabels...)
 		d.bus.Pub(&msgbus.DaemonHeartbeatUpdated{Node: peer}, peerLabels...)
 		d.bus.Pub(&msgbus.DaemonListenerUpdated{Node: peer}, peerLabels...)
+		d.bus.Pub(&msgbus.DaemonNotifierUpdated{Node: peer}, peerLabels...)
 		d.bus.Pub(&msgbus.DaemonRunnerImonUpdated{Node: peer}, peerLabels...)
 		d.bus.Pub(&msgbus.DaemonSchedulerUpdated{Node: peer}, peerLabels...)
 	}
@@ -115,6 +117,8 @@ func (d *data) dropPeer(peer string) {
 	delete(d.hbGens, peer)
 	delete(d.hbGens[d.localNode], peer)
 	delete(d.hbPatchMsgUpdated, peer)
+	delete(d.hbBases, peer)
+	delete(d.hbPeerBases, peer)
 	delete(d.hbMsgPatchLength, peer)
 	delete(d.hbMsgType, peer)
 	delete(d.previousRemoteInfo, peer)
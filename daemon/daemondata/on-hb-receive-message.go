@@ -7,6 +7,7 @@ import (
 )
 
 func (d *data) onReceiveHbMsg(msg *hbtype.Msg) {
+	d.hbPeerBases[msg.Nodename] = msg.Bases[d.localNode]
 	switch msg.Kind {
 	case "patch":
 		d.setFromPeerMsg(msg.Nodename, msg.Kind, len(msg.Events), msg.Gen)
@@ -43,7 +44,11 @@ func (d *data) onReceiveHbMsg(msg *hbtype.Msg) {
 		if err := d.applyNodeData(msg); err != nil {
 			d.log.Errorf("apply message %s node data from %s gens: %v: %s", msg.Kind, msg.Nodename, msg.Gen, err)
 		}
-		onReceiveQueueOperationTotal.With(prometheus.Labels{"operation": "full"}).Inc()
+		if msg.Delta != nil {
+			onReceiveQueueOperationTotal.With(prometheus.Labels{"operation": "full_delta"}).Inc()
+		} else {
+			onReceiveQueueOperationTotal.With(prometheus.Labels{"operation": "full"}).Inc()
+		}
 	case "ping":
 		d.setFromPeerMsg(msg.Nodename, msg.Kind, 0, msg.Gen)
 		// cleanup previous applied full info
@@ -0,0 +1,211 @@
+/*
+Package daemonmetrics exposes the cluster, node, object and resource states
+cached by the daemon as prometheus gauges.
+
+The gauges are computed from the daemondata caches when the /metrics
+handler is scraped, so they are always consistent with the "om mon" view.
+
+The object metrics are labelled with the object path and its namespace,
+kind and name components. The node metrics are labelled with the nodename.
+The state metrics use the "state set" convention: one series with value 1
+is exported for the current state, labelled with the state name.
+*/
+package daemonmetrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/schedule"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/daemon/daemonsubsystem"
+	"github.com/opensvc/om3/util/file"
+)
+
+type (
+	// Collector implements the prometheus.Collector interface.
+	Collector struct{}
+)
+
+var (
+	pathLabels = []string{"path", "namespace", "kind", "name"}
+
+	descNodeMonitorState = prometheus.NewDesc(
+		"opensvc_node_monitor_state",
+		"The node monitor state.",
+		[]string{"node", "state"}, nil)
+
+	descNodeFrozen = prometheus.NewDesc(
+		"opensvc_node_frozen",
+		"1 if the node is frozen.",
+		[]string{"node"}, nil)
+
+	descNodeArbitratorVote = prometheus.NewDesc(
+		"opensvc_node_arbitrator_vote",
+		"1 if the arbitrator is reachable from the node, so gives its vote on split.",
+		[]string{"node", "arbitrator"}, nil)
+
+	descHeartbeatPeerBeating = prometheus.NewDesc(
+		"opensvc_heartbeat_peer_beating",
+		"1 if the heartbeat stream of the node is beating with the peer.",
+		[]string{"node", "hb", "type", "peer"}, nil)
+
+	descHeartbeatPeerLast = prometheus.NewDesc(
+		"opensvc_heartbeat_peer_last_timestamp_seconds",
+		"The unix time of the last heartbeat stream exchange with the peer.",
+		[]string{"node", "hb", "type", "peer"}, nil)
+
+	descObjectAvail = prometheus.NewDesc(
+		"opensvc_object_avail",
+		"The object availability status.",
+		append(pathLabels, "status"), nil)
+
+	descObjectOverall = prometheus.NewDesc(
+		"opensvc_object_overall",
+		"The object overall status.",
+		append(pathLabels, "status"), nil)
+
+	descObjectPlacementState = prometheus.NewDesc(
+		"opensvc_object_placement_state",
+		"The object placement state.",
+		append(pathLabels, "state"), nil)
+
+	descInstanceFrozen = prometheus.NewDesc(
+		"opensvc_instance_frozen",
+		"1 if the object instance is frozen.",
+		append(pathLabels, "node"), nil)
+
+	descInstanceResourceStatus = prometheus.NewDesc(
+		"opensvc_instance_resource_status",
+		"The object instance resource status.",
+		append(pathLabels, "node", "rid", "status"), nil)
+
+	descScheduleLastRun = prometheus.NewDesc(
+		"opensvc_schedule_last_run_timestamp_seconds",
+		"The unix time of the last run of the scheduled job.",
+		append(pathLabels, "node", "action", "key"), nil)
+
+	descScheduleLastSuccess = prometheus.NewDesc(
+		"opensvc_schedule_last_success_timestamp_seconds",
+		"The unix time of the last successful run of the scheduled job.",
+		append(pathLabels, "node", "action", "key"), nil)
+
+	descs = []*prometheus.Desc{
+		descNodeMonitorState,
+		descNodeFrozen,
+		descNodeArbitratorVote,
+		descHeartbeatPeerBeating,
+		descHeartbeatPeerLast,
+		descObjectAvail,
+		descObjectOverall,
+		descObjectPlacementState,
+		descInstanceFrozen,
+		descInstanceResourceStatus,
+		descScheduleLastRun,
+		descScheduleLastSuccess,
+	}
+)
+
+// New returns a Collector.
+func New() *Collector {
+	return &Collector{}
+}
+
+// Describe implements prometheus.Collector.
+func (t *Collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range descs {
+		ch <- desc
+	}
+}
+
+// Collect implements prometheus.Collector.
+func (t *Collector) Collect(ch chan<- prometheus.Metric) {
+	t.collectNodes(ch)
+	t.collectHeartbeats(ch)
+	t.collectObjects(ch)
+	t.collectInstances(ch)
+	t.collectSchedules(ch)
+}
+
+func (t *Collector) collectNodes(ch chan<- prometheus.Metric) {
+	for _, e := range node.MonitorData.GetAll() {
+		ch <- prometheus.MustNewConstMetric(descNodeMonitorState, prometheus.GaugeValue, 1, e.Node, e.Value.State.String())
+	}
+	for _, e := range node.StatusData.GetAll() {
+		ch <- prometheus.MustNewConstMetric(descNodeFrozen, prometheus.GaugeValue, boolValue(e.Value.IsFrozen()), e.Node)
+		for name, arbitrator := range e.Value.Arbitrators {
+			ch <- prometheus.MustNewConstMetric(descNodeArbitratorVote, prometheus.GaugeValue, boolValue(arbitrator.Status == status.Up), e.Node, name)
+		}
+	}
+}
+
+func (t *Collector) collectHeartbeats(ch chan<- prometheus.Metric) {
+	for _, e := range daemonsubsystem.DataHeartbeat.GetAll() {
+		for _, stream := range e.Value.Streams {
+			for peer, peerStatus := range stream.Peers {
+				ch <- prometheus.MustNewConstMetric(descHeartbeatPeerBeating, prometheus.GaugeValue, boolValue(peerStatus.IsBeating), e.Node, stream.ID, stream.Type, peer)
+				ch <- prometheus.MustNewConstMetric(descHeartbeatPeerLast, prometheus.GaugeValue, timeValue(peerStatus.LastAt), e.Node, stream.ID, stream.Type, peer)
+			}
+		}
+	}
+}
+
+func (t *Collector) collectObjects(ch chan<- prometheus.Metric) {
+	for _, e := range object.StatusData.GetAll() {
+		labels := pathLabelValues(e.Path)
+		ch <- prometheus.MustNewConstMetric(descObjectAvail, prometheus.GaugeValue, 1, append(labels, e.Value.Avail.String())...)
+		ch <- prometheus.MustNewConstMetric(descObjectOverall, prometheus.GaugeValue, 1, append(labels, e.Value.Overall.String())...)
+		ch <- prometheus.MustNewConstMetric(descObjectPlacementState, prometheus.GaugeValue, 1, append(labels, e.Value.PlacementState.String())...)
+	}
+}
+
+func (t *Collector) collectInstances(ch chan<- prometheus.Metric) {
+	for _, e := range instance.StatusData.GetAll() {
+		labels := append(pathLabelValues(e.Path), e.Node)
+		ch <- prometheus.MustNewConstMetric(descInstanceFrozen, prometheus.GaugeValue, boolValue(e.Value.IsFrozen()), labels...)
+		for rid, resourceStatus := range e.Value.Resources {
+			ch <- prometheus.MustNewConstMetric(descInstanceResourceStatus, prometheus.GaugeValue, 1, append(labels, rid, resourceStatus.Status.String())...)
+		}
+	}
+}
+
+func (t *Collector) collectSchedules(ch chan<- prometheus.Metric) {
+	for _, e := range schedule.TableData.GetAll() {
+		for _, entry := range e.Value.Jobs() {
+			labels := append(pathLabelValues(entry.Path), entry.Node, entry.Action, entry.Key)
+			ch <- prometheus.MustNewConstMetric(descScheduleLastRun, prometheus.GaugeValue, timeValue(entry.LastRunAt), labels...)
+			if entry.LastSuccessFile != "" {
+				ch <- prometheus.MustNewConstMetric(descScheduleLastSuccess, prometheus.GaugeValue, timeValue(file.ModTime(entry.LastSuccessFile)), labels...)
+			}
+		}
+	}
+}
+
+// pathLabelValues returns the values of the pathLabels for p. The labels
+// of the node, whose path is zero, are empty.
+func pathLabelValues(p naming.Path) []string {
+	if p.IsZero() {
+		return []string{"", "", "", ""}
+	}
+	return []string{p.String(), p.Namespace, p.Kind.String(), p.Name}
+}
+
+func boolValue(v bool) float64 {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+// timeValue returns tm as a unix time in seconds, or 0 if tm is zero.
+func timeValue(tm time.Time) float64 {
+	if tm.IsZero() {
+		return 0
+	}
+	return float64(tm.UnixNano()) / float64(time.Second)
+}
@@ -0,0 +1,81 @@
+package daemonmetrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/placement"
+	"github.com/opensvc/om3/core/resource"
+	"github.com/opensvc/om3/core/status"
+)
+
+func TestCollector(t *testing.T) {
+	node.InitData()
+	object.InitData()
+	instance.InitData()
+
+	p := naming.Path{Namespace: "test", Kind: naming.KindSvc, Name: "foo"}
+	node.MonitorData.Set("n1", &node.Monitor{State: node.MonitorStateIdle})
+	node.StatusData.Set("n1", &node.Status{
+		FrozenAt: time.Now(),
+		Arbitrators: map[string]node.ArbitratorStatus{
+			"arb1": {Status: status.Up},
+		},
+	})
+	object.StatusData.Set(p, &object.Status{
+		Avail:          status.Up,
+		Overall:        status.Warn,
+		PlacementState: placement.Optimal,
+	})
+	instance.StatusData.Set(p, "n1", &instance.Status{
+		Resources: instance.ResourceStatuses{
+			"fs#1": resource.Status{Status: status.Down},
+		},
+	})
+
+	expected := `
+# HELP opensvc_instance_frozen 1 if the object instance is frozen.
+# TYPE opensvc_instance_frozen gauge
+opensvc_instance_frozen{kind="svc",name="foo",namespace="test",node="n1",path="test/svc/foo"} 0
+# HELP opensvc_instance_resource_status The object instance resource status.
+# TYPE opensvc_instance_resource_status gauge
+opensvc_instance_resource_status{kind="svc",name="foo",namespace="test",node="n1",path="test/svc/foo",rid="fs#1",status="down"} 1
+# HELP opensvc_node_arbitrator_vote 1 if the arbitrator is reachable from the node, so gives its vote on split.
+# TYPE opensvc_node_arbitrator_vote gauge
+opensvc_node_arbitrator_vote{arbitrator="arb1",node="n1"} 1
+# HELP opensvc_node_frozen 1 if the node is frozen.
+# TYPE opensvc_node_frozen gauge
+opensvc_node_frozen{node="n1"} 1
+# HELP opensvc_node_monitor_state The node monitor state.
+# TYPE opensvc_node_monitor_state gauge
+opensvc_node_monitor_state{node="n1",state="idle"} 1
+# HELP opensvc_object_avail The object availability status.
+# TYPE opensvc_object_avail gauge
+opensvc_object_avail{kind="svc",name="foo",namespace="test",path="test/svc/foo",status="up"} 1
+# HELP opensvc_object_overall The object overall status.
+# TYPE opensvc_object_overall gauge
+opensvc_object_overall{kind="svc",name="foo",namespace="test",path="test/svc/foo",status="warn"} 1
+# HELP opensvc_object_placement_state The object placement state.
+# TYPE opensvc_object_placement_state gauge
+opensvc_object_placement_state{kind="svc",name="foo",namespace="test",path="test/svc/foo",state="optimal"} 1
+`
+	err := testutil.CollectAndCompare(New(), strings.NewReader(expected),
+		"opensvc_instance_frozen",
+		"opensvc_instance_resource_status",
+		"opensvc_node_arbitrator_vote",
+		"opensvc_node_frozen",
+		"opensvc_node_monitor_state",
+		"opensvc_object_avail",
+		"opensvc_object_overall",
+		"opensvc_object_placement_state",
+	)
+	require.NoError(t, err)
+}
@@ -6,7 +6,7 @@ import (
 
 type (
 	Cacher interface {
-		Collector | Dns | Daemondata | Heartbeat | Listener | RunnerImon | Scheduler
+		ClusterSecret | Collector | Dns | Daemondata | Heartbeat | Listener | Notifier | RunnerImon | Scheduler
 	}
 
 	CacheElement[T Cacher] struct {
@@ -22,6 +22,9 @@ type (
 )
 
 var (
+	// DataClusterSecret is the package data holder for all nodes ClusterSecret
+	DataClusterSecret *CacheData[ClusterSecret]
+
 	// DataCollector is the package data holder for all nodes Collector
 	DataCollector *CacheData[Collector]
 
@@ -37,6 +40,9 @@ var (
 	// DataListener is the package data holder for all nodes Listener
 	DataListener *CacheData[Listener]
 
+	// DataNotifier is the package data holder for all nodes Notifier
+	DataNotifier *CacheData[Notifier]
+
 	// DataListener is the package data holder for all nodes RunnerImon
 	DataRunnerImon *CacheData[RunnerImon]
 
@@ -51,11 +57,13 @@ func NewData[T Cacher]() *CacheData[T] {
 }
 
 func DropNode(nodename string) {
+	DataClusterSecret.Unset(nodename)
 	DataCollector.Unset(nodename)
 	DataDns.Unset(nodename)
 	DataDaemondata.Unset(nodename)
 	DataHeartbeat.Unset(nodename)
 	DataListener.Unset(nodename)
+	DataNotifier.Unset(nodename)
 	DataRunnerImon.Unset(nodename)
 	DataScheduler.Unset(nodename)
 }
@@ -98,11 +106,13 @@ func (c *CacheData[T]) GetAll() []CacheElement[T] {
 
 // InitData reset package daemondef data, it can be used for tests.
 func InitData() {
+	DataClusterSecret = NewData[ClusterSecret]()
 	DataCollector = NewData[Collector]()
 	DataDns = NewData[Dns]()
 	DataDaemondata = NewData[Daemondata]()
 	DataHeartbeat = NewData[Heartbeat]()
 	DataListener = NewData[Listener]()
+	DataNotifier = NewData[Notifier]()
 	DataRunnerImon = NewData[RunnerImon]()
 	DataScheduler = NewData[Scheduler]()
 }
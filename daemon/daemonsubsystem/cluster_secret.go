@@ -0,0 +1,45 @@
+package daemonsubsystem
+
+import (
+	"time"
+)
+
+type (
+	// ClusterSecret defines model for the daemon cluster secret subsystem,
+	// which is responsible for the cluster secret rotation steps.
+	//
+	// The State is one of:
+	//
+	//	idle: no rotation in progress
+	//	distributed: the node accepts the next secret
+	//	switched: the node encrypts with the new secret, and re-encrypts
+	//	  the sec and usr keys still encrypted with the previous secret
+	//	reencrypted: the node waits for the previous secret retirement
+	ClusterSecret struct {
+		Status
+
+		// Current, Next and Previous are the fingerprints of the cluster
+		// secrets known by the node. The secrets are never exposed.
+		Current  string `json:"current"`
+		Next     string `json:"next"`
+		Previous string `json:"previous"`
+
+		// Reencrypted is the number of sec and usr keys the node
+		// re-encrypted with the current secret since the last switch.
+		Reencrypted int `json:"reencrypted"`
+
+		// Waiting is the list of nodes the leader waits for before the
+		// next rotation step.
+		Waiting []string `json:"waiting"`
+
+		// RetireAt is the time the leader retires the previous secret. It
+		// is set when all nodes have switched and re-encrypted.
+		RetireAt time.Time `json:"retire_at"`
+	}
+)
+
+func (c *ClusterSecret) DeepCopy() *ClusterSecret {
+	v := *c
+	v.Waiting = append([]string{}, c.Waiting...)
+	return &v
+}
@@ -54,6 +54,14 @@ type (
 	HeartbeatStreamPeerStatus struct {
 		IsBeating bool      `json:"is_beating"`
 		LastAt    time.Time `json:"last_at"`
+
+		// Latency is the last measured round trip time of a message, for
+		// the heartbeat drivers with acknowledged messages.
+		Latency time.Duration `json:"latency,omitempty"`
+
+		// Reconnects is the number of stream reconnections, for the
+		// heartbeat drivers with persistent streams.
+		Reconnects int `json:"reconnects,omitempty"`
 	}
 )
 
@@ -14,6 +14,11 @@ type (
 
 	// Daemon defines model for Daemon.
 	Daemon struct {
+		// ClusterSecret describes the OpenSVC daemon cluster secret
+		// subsystem state, which is responsible for the cluster secret
+		// rotation steps.
+		ClusterSecret ClusterSecret `json:"cluster_secret"`
+
 		// Collector DaemonCollector describes the OpenSVC daemon collector subsystem state,
 		// which is responsible for communicating with the collector on behalf
 		// of the cluster. Only one node on the cluster is the collector speaker
@@ -38,6 +43,11 @@ type (
 
 		Nodename string `json:"nodename"`
 
+		// Notifier describes the OpenSVC daemon notifier subsystem state,
+		// which is responsible for sending the notifications defined by
+		// the notify#N cluster config sections.
+		Notifier Notifier `json:"notifier"`
+
 		// Pid the main daemon process id
 		// it is sent on the full hb message, then not anymore changed
 		Pid int `json:"pid"`
@@ -74,14 +84,16 @@ type (
 
 func (d *Daemon) DeepCopy() *Daemon {
 	return &Daemon{
-		Pid:        d.Pid,
-		StartedAt:  d.StartedAt,
-		Collector:  *d.Collector.DeepCopy(),
-		Daemondata: *d.Daemondata.DeepCopy(),
-		Dns:        *d.Dns.DeepCopy(),
-		Heartbeat:  *d.Heartbeat.DeepCopy(),
-		Listener:   *d.Listener.DeepCopy(),
-		RunnerImon: *d.RunnerImon.DeepCopy(),
-		Scheduler:  *d.Scheduler.DeepCopy(),
+		Pid:           d.Pid,
+		StartedAt:     d.StartedAt,
+		ClusterSecret: *d.ClusterSecret.DeepCopy(),
+		Collector:     *d.Collector.DeepCopy(),
+		Daemondata:    *d.Daemondata.DeepCopy(),
+		Dns:           *d.Dns.DeepCopy(),
+		Heartbeat:     *d.Heartbeat.DeepCopy(),
+		Listener:      *d.Listener.DeepCopy(),
+		Notifier:      *d.Notifier.DeepCopy(),
+		RunnerImon:    *d.RunnerImon.DeepCopy(),
+		Scheduler:     *d.Scheduler.DeepCopy(),
 	}
 }
@@ -0,0 +1,54 @@
+package daemonsubsystem
+
+import (
+	"time"
+)
+
+type (
+	// Notifier defines model for the daemon notifier subsystem, which is
+	// responsible for sending the notifications defined by the notify#N
+	// cluster config sections.
+	Notifier struct {
+		Status
+
+		// Notifiers is the list of the configured notify#N delivery status
+		Notifiers []NotifierStatus `json:"notifiers"`
+	}
+
+	// NotifierStatus describes the delivery status of a notify#N section.
+	NotifierStatus struct {
+		Status
+
+		// Type is the notifier type: webhook or command
+		Type string `json:"type"`
+
+		// Sent is the count of delivered notifications
+		Sent uint64 `json:"sent"`
+
+		// Failed is the count of notifications not delivered after all
+		// retries
+		Failed uint64 `json:"failed"`
+
+		// Deduplicated is the count of notifications not sent because
+		// the same notification was sent during the deduplication window
+		Deduplicated uint64 `json:"deduplicated"`
+
+		// Dropped is the count of notifications not sent because the
+		// delivery queue was full
+		Dropped uint64 `json:"dropped"`
+
+		// Pending is the number of notifications waiting for delivery
+		Pending int `json:"pending"`
+
+		LastSentAt  time.Time `json:"last_sent_at"`
+		LastErrorAt time.Time `json:"last_error_at"`
+		LastError   string    `json:"last_error"`
+	}
+)
+
+func (c *Notifier) DeepCopy() *Notifier {
+	return &Notifier{
+		Status:    c.Status,
+		Notifiers: append([]NotifierStatus{}, c.Notifiers...),
+	}
+}
@@ -9,9 +9,11 @@ import (
 
 	"github.com/opensvc/om3/core/client"
 	"github.com/opensvc/om3/core/cluster"
+	"github.com/opensvc/om3/core/confighistory"
 	"github.com/opensvc/om3/core/freeze"
 	"github.com/opensvc/om3/core/instance"
 	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/node"
 	"github.com/opensvc/om3/core/object"
 	"github.com/opensvc/om3/daemon/daemonenv"
 	"github.com/opensvc/om3/daemon/daemonsubsystem"
@@ -547,11 +549,27 @@ func (t *Manager) onRemoteConfigFetched(c *msgbus.RemoteFileConfig) {
 			c.Err <- err
 		} else {
 			log.Infof("cfg: install %s config fetched from node %s", c.Path, c.Node)
+			t.recordConfig(c.Path, c.Node)
 		}
 		c.Err <- nil
 	}
 }
 
+// recordConfig records the object p config installed from node peer in
+// the object config history.
+func (t *Manager) recordConfig(p naming.Path, peer string) {
+	size := confighistory.DefaultMax
+	if cfg := node.ConfigData.Get(t.localhost); cfg != nil {
+		size = cfg.ConfigHistorySize
+	}
+	comment := fmt.Sprintf("fetched from node %s", peer)
+	if rev, recorded, err := confighistory.New(p, size).RecordFile("", comment); err != nil {
+		t.objectLogger(p).Warnf("cfg: record %s config revision: %s", p, err)
+	} else if recorded {
+		t.objectLogger(p).Debugf("cfg: recorded %s config %s", p, rev)
+	}
+}
+
 func (t *Manager) inScope(cfg *instance.Config) bool {
 	return inList(t.localhost, cfg.Scope)
 }
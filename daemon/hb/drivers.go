@@ -5,5 +5,6 @@ import (
 	_ "github.com/opensvc/om3/daemon/hb/hbdisk"
 	_ "github.com/opensvc/om3/daemon/hb/hbmcast"
 	_ "github.com/opensvc/om3/daemon/hb/hbrelay"
+	_ "github.com/opensvc/om3/daemon/hb/hbtls"
 	_ "github.com/opensvc/om3/daemon/hb/hbucast"
 )
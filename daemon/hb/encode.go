@@ -0,0 +1,156 @@
+package hb
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/opensvc/om3/core/hbtype"
+	"github.com/opensvc/om3/core/omcrypto"
+)
+
+type (
+	// msgEncoder encodes a hb message for the registered transmitters. The
+	// encodings are cached, so the transmitters using the same codec share
+	// them.
+	msgEncoder struct {
+		msg     hbtype.Msg
+		factory *omcrypto.Factory
+		cache   map[string][]byte
+	}
+)
+
+var (
+	// msgSizeBuckets ranges from 256B to 4MB
+	msgSizeBuckets = prometheus.ExponentialBuckets(256, 4, 8)
+
+	msgSize = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "opensvc_hb_message_size_bytes",
+			Help:    "The size of the encoded hb messages, at the raw, compressed and encrypted stages",
+			Buckets: msgSizeBuckets,
+		},
+		[]string{"kind", "codec", "stage"})
+
+	msgFallbackTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "opensvc_hb_message_fallback_total",
+			Help: "The total number of hb message encoding fallbacks on transmitter size limit",
+		},
+		[]string{"hb", "fallback"})
+)
+
+func newMsgEncoder(msg hbtype.Msg, factory *omcrypto.Factory) *msgEncoder {
+	return &msgEncoder{
+		msg:     msg,
+		factory: factory,
+		cache:   make(map[string][]byte),
+	}
+}
+
+// encode returns the <msg> encrypted message compressed with <codec>. The
+// <variant> identifies the <msg> variant in the cache.
+func (t *msgEncoder) encode(variant string, msg hbtype.Msg, codec string) ([]byte, error) {
+	cacheKey := variant + "/" + codec
+	if b, ok := t.cache[cacheKey]; ok {
+		return b, nil
+	}
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("marshal failure %s for msg %v", err, msg)
+	}
+	rawSize := len(b)
+	factory := *t.factory
+	factory.Codec = codec
+	b, compressedSize, err := factory.EncryptWithSize(b)
+	if err != nil {
+		return nil, err
+	}
+	msgSize.With(prometheus.Labels{"kind": msg.Kind, "codec": codec, "stage": "raw"}).Observe(float64(rawSize))
+	msgSize.With(prometheus.Labels{"kind": msg.Kind, "codec": codec, "stage": "compressed"}).Observe(float64(compressedSize))
+	msgSize.With(prometheus.Labels{"kind": msg.Kind, "codec": codec, "stage": "encrypted"}).Observe(float64(len(b)))
+	t.cache[cacheKey] = b
+	return b, nil
+}
+
+// encodeFor returns the message to send on the <txID> transmitter, compressed
+// with <codec>. The <accepted> codecs are the codecs all the transmitter
+// peers can decode.
+//
+// When the message exceeds the transmitter <maxSize>, the fallbacks are:
+//
+//	drop the events of a full message, the peers can apply its node data
+//	compress with the accepted codec producing the smallest message
+//
+// An error is returned if the message still exceeds <maxSize>.
+func (t *msgEncoder) encodeFor(txID, codec string, accepted []string, maxSize int) ([]byte, error) {
+	b, err := t.encode("msg", t.msg, codec)
+	if err != nil {
+		return nil, err
+	}
+	if maxSize <= 0 || len(b) <= maxSize {
+		return b, nil
+	}
+	variant, msg := "msg", t.msg
+	if msg.Kind == "full" && len(msg.Events) > 0 {
+		variant = "full-without-events"
+		msg.Events = nil
+		msgFallbackTotal.With(prometheus.Labels{"hb": txID, "fallback": "drop_events"}).Inc()
+		if b, err = t.encode(variant, msg, codec); err != nil {
+			return nil, err
+		}
+		if len(b) <= maxSize {
+			return b, nil
+		}
+	}
+	for _, other := range accepted {
+		if other == codec {
+			continue
+		}
+		if ob, err := t.encode(variant, msg, other); err == nil && len(ob) < len(b) {
+			b, codec = ob, other
+		}
+	}
+	if len(b) <= maxSize {
+		msgFallbackTotal.With(prometheus.Labels{"hb": txID, "fallback": "codec"}).Inc()
+		return b, nil
+	}
+	msgFallbackTotal.With(prometheus.Labels{"hb": txID, "fallback": "drop"}).Inc()
+	return nil, fmt.Errorf("%s message size %d exceeds the %d bytes limit with the %s codec", msg.Kind, len(b), maxSize, codec)
+}
+
+// acceptedCodecs returns the local codecs all the <peers> announced they can
+// decode. The DefaultCodec is always accepted, because the peers not
+// announcing their codecs can decode it.
+func acceptedCodecs(peers []string, peerCodecs map[string][]string) []string {
+	l := make([]string, 0)
+	for _, codec := range omcrypto.Codecs() {
+		if codec == omcrypto.DefaultCodec {
+			l = append(l, codec)
+			continue
+		}
+		accepted := true
+		for _, peer := range peers {
+			if !slices.Contains(peerCodecs[peer], codec) {
+				accepted = false
+				break
+			}
+		}
+		if accepted {
+			l = append(l, codec)
+		}
+	}
+	return l
+}
+
+// negotiateCodec returns the <wanted> codec if <accepted>, or the
+// DefaultCodec.
+func negotiateCodec(wanted string, accepted []string) string {
+	if wanted != "" && slices.Contains(accepted, wanted) {
+		return wanted
+	}
+	return omcrypto.DefaultCodec
+}
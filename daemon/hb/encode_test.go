@@ -0,0 +1,89 @@
+package hb
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/event"
+	"github.com/opensvc/om3/core/hbtype"
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/core/omcrypto"
+)
+
+func TestNegotiateCodec(t *testing.T) {
+	peerCodecs := map[string][]string{
+		"node2": {"gzip", "none", "zlib"},
+		"node3": {"none", "zlib"},
+	}
+	require.Equal(t, []string{"gzip", "none", "zlib"}, acceptedCodecs([]string{"node2"}, peerCodecs))
+	require.Equal(t, []string{"none", "zlib"}, acceptedCodecs([]string{"node2", "node3"}, peerCodecs))
+	require.Equal(t, []string{"zlib"}, acceptedCodecs([]string{"node2", "node4"}, peerCodecs),
+		"a peer not announcing its codecs only accepts the default codec")
+
+	require.Equal(t, "gzip", negotiateCodec("gzip", []string{"gzip", "zlib"}))
+	require.Equal(t, "zlib", negotiateCodec("gzip", []string{"none", "zlib"}))
+	require.Equal(t, "zlib", negotiateCodec("", []string{"gzip", "zlib"}))
+}
+
+func TestMsgEncoderFallback(t *testing.T) {
+	factory := &omcrypto.Factory{NodeName: "node1", ClusterName: "test", Key: "0123456789abcdef0123456789abcdef"}
+	decoder := &omcrypto.Factory{NodeName: "node2", ClusterName: "test", Key: factory.Key}
+
+	// random data are not compressible
+	random := func(size int) string {
+		b := make([]byte, size)
+		_, _ = rand.Read(b)
+		return hex.EncodeToString(b)
+	}
+	msg := hbtype.Msg{
+		Kind:     "full",
+		Nodename: "node1",
+		Gen:      map[string]uint64{"node1": 2},
+		Events: map[string][]event.Event{
+			"2": {{Kind: "InstanceStatusUpdated", ID: 1, Data: json.RawMessage(`"` + random(10000) + `"`)}},
+		},
+		NodeData: node.Node{Instance: map[string]instance.Instance{random(1000): {}}},
+	}
+	decode := func(b []byte) hbtype.Msg {
+		var decoded hbtype.Msg
+		plain, err := decoder.Decrypt(b)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(plain, &decoded))
+		return decoded
+	}
+	accepted := []string{"gzip", "none", "zlib"}
+
+	t.Run("no size limit", func(t *testing.T) {
+		b, err := newMsgEncoder(msg, factory).encodeFor("hb#1.tx", "none", accepted, 0)
+		require.NoError(t, err)
+		require.Len(t, decode(b).Events, 1)
+	})
+
+	t.Run("full message events dropped", func(t *testing.T) {
+		b, err := newMsgEncoder(msg, factory).encodeFor("hb#1.tx", "none", accepted, 20000)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(b), 20000)
+		decoded := decode(b)
+		require.Len(t, decoded.Events, 0)
+		require.Equal(t, msg.NodeData.Instance, decoded.NodeData.Instance)
+	})
+
+	t.Run("message too large", func(t *testing.T) {
+		_, err := newMsgEncoder(msg, factory).encodeFor("hb#1.tx", "none", accepted, 1000)
+		require.ErrorContains(t, err, "exceeds the 1000 bytes limit")
+	})
+
+	t.Run("encodings shared between transmitters", func(t *testing.T) {
+		encoder := newMsgEncoder(msg, factory)
+		b1, err := encoder.encodeFor("hb#1.tx", "zlib", accepted, 0)
+		require.NoError(t, err)
+		b2, err := encoder.encodeFor("hb#2.tx", "zlib", accepted, 0)
+		require.NoError(t, err)
+		require.Equal(t, b1, b2)
+	})
+}
@@ -107,6 +107,15 @@ type (
 		PeerStatus daemonsubsystem.HeartbeatStreamPeerStatus
 	}
 
+	// CmdSetPeerStats is a command to set the stream stats of a hb peer
+	// for a node, preserving its beating status
+	CmdSetPeerStats struct {
+		Nodename   string
+		HbID       string
+		Latency    time.Duration
+		Reconnects int
+	}
+
 	// CmdAddWatcher is a command to run new instance of a hb watcher for a remote
 	CmdAddWatcher struct {
 		HbID     string
@@ -301,7 +310,11 @@ func (c *C) run() {
 				o.result <- events
 			case GetPeerStatus:
 				if foundHeartbeat, ok := heartbeat[o.HbID]; ok {
-					o.result <- foundHeartbeat.Peers
+					peers := make(map[string]daemonsubsystem.HeartbeatStreamPeerStatus)
+					for k, v := range foundHeartbeat.Peers {
+						peers[k] = v
+					}
+					o.result <- peers
 				} else {
 					o.result <- make(map[string]daemonsubsystem.HeartbeatStreamPeerStatus)
 				}
@@ -309,9 +322,23 @@ func (c *C) run() {
 				hbID := o.HbID
 				peerNode := o.Nodename
 				if foundHeartbeat, ok := heartbeat[hbID]; ok {
-					foundHeartbeat.Peers[peerNode] = o.PeerStatus
+					peerStatus := o.PeerStatus
+					if current, ok := foundHeartbeat.Peers[peerNode]; ok {
+						// the stats are maintained by CmdSetPeerStats
+						peerStatus.Latency = current.Latency
+						peerStatus.Reconnects = current.Reconnects
+					}
+					foundHeartbeat.Peers[peerNode] = peerStatus
 					heartbeat[hbID] = foundHeartbeat
 				}
+			case CmdSetPeerStats:
+				if foundHeartbeat, ok := heartbeat[o.HbID]; ok {
+					if peerStatus, ok := foundHeartbeat.Peers[o.Nodename]; ok {
+						peerStatus.Latency = o.Latency
+						peerStatus.Reconnects = o.Reconnects
+						foundHeartbeat.Peers[o.Nodename] = peerStatus
+					}
+				}
 			case CmdAddWatcher:
 				hbID := o.HbID
 				peerNode := o.Nodename
@@ -9,6 +9,7 @@ import (
 
 	"github.com/opensvc/om3/daemon/daemonctx"
 	"github.com/opensvc/om3/daemon/daemondata"
+	"github.com/opensvc/om3/daemon/daemonsubsystem"
 	"github.com/opensvc/om3/daemon/hbcache"
 	"github.com/opensvc/om3/daemon/msgbus"
 	"github.com/opensvc/om3/util/plog"
@@ -244,3 +245,33 @@ func TestCmdSetPeerSuccessCreatesPublishHbNodePing(t *testing.T) {
 		require.NoError(t, testCtrl.Stop(), "unexpected controller stop error")
 	})
 }
+
+func TestCmdSetPeerStatsIsPreservedOnBeatingChange(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = bootstrapDaemon(ctx, t)
+
+	pubDelay = 10 * time.Millisecond
+	testCtrl := setupCtrl(ctx)
+	defer func() {
+		require.NoError(t, testCtrl.Stop(), "unexpected controller stop error")
+	}()
+
+	getPeerStatus := func() daemonsubsystem.HeartbeatStreamPeerStatus {
+		result := make(chan map[string]daemonsubsystem.HeartbeatStreamPeerStatus)
+		testCtrl.cmd <- GetPeerStatus{HbID: "hb#1.tx", result: result}
+		return (<-result)["node2"]
+	}
+
+	testCtrl.cmd <- CmdRegister{ID: "hb#1.tx", Type: "tls"}
+	testCtrl.cmd <- CmdAddWatcher{HbID: "hb#1.tx", Nodename: "node2", Ctx: ctx, Timeout: time.Second}
+	testCtrl.cmd <- CmdSetPeerStats{HbID: "hb#1.tx", Nodename: "node2", Latency: time.Millisecond, Reconnects: 2}
+	testCtrl.cmd <- CmdSetPeerSuccess{HbID: "hb#1.tx", Nodename: "node2", Success: true}
+
+	require.Eventually(t, func() bool {
+		return getPeerStatus().IsBeating
+	}, time.Second, 10*time.Millisecond, "peer is not beating")
+	peerStatus := getPeerStatus()
+	require.Equal(t, time.Millisecond, peerStatus.Latency)
+	require.Equal(t, 2, peerStatus.Reconnects)
+}
@@ -0,0 +1,89 @@
+package hbdisk
+
+import (
+	"sort"
+	"time"
+
+	"github.com/opensvc/om3/util/plog"
+)
+
+type (
+	// Disk gives access to the per node data slots of a device sliced with
+	// the hb disk layout, for components other than the heartbeat needing
+	// a per node storage on a shared device.
+	Disk struct {
+		base
+	}
+)
+
+// NewDisk returns a Disk for the device <path>.
+func NewDisk(path string, log *plog.Logger) *Disk {
+	return &Disk{
+		base: base{
+			log: log,
+			device: device{
+				path: path,
+			},
+		},
+	}
+}
+
+// Open opens the device and loads the slots allocated to <nodes>. The
+// missing slots are allocated in the sorted <nodes> order, so nodes opening
+// a blank device concurrently agree on the allocation.
+func (t *Disk) Open(nodes []string) error {
+	if err := t.device.open(); err != nil {
+		return err
+	}
+	if err := t.LoadPeerConfig(nodes); err != nil {
+		_ = t.Close()
+		return err
+	}
+	l := append([]string{}, nodes...)
+	sort.Strings(l)
+	for _, nodename := range l {
+		if _, err := t.GetPeer(nodename); err != nil {
+			_ = t.Close()
+			return err
+		}
+	}
+	return nil
+}
+
+// IsOpen returns true if the device is open.
+func (t *Disk) IsOpen() bool {
+	return t.file != nil
+}
+
+// Close closes the device.
+func (t *Disk) Close() error {
+	if t.file == nil {
+		return nil
+	}
+	err := t.file.Close()
+	t.file = nil
+	return err
+}
+
+// Read returns the message stored in the <nodename> data slot, and the
+// time it was written.
+func (t *Disk) Read(nodename string) ([]byte, time.Time, error) {
+	meta, err := t.GetPeer(nodename)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	c, err := t.ReadDataSlot(meta.Slot)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return c.Msg, c.Updated, nil
+}
+
+// Write stores <b> in the <nodename> data slot.
+func (t *Disk) Write(nodename string, b []byte) error {
+	meta, err := t.GetPeer(nodename)
+	if err != nil {
+		return err
+	}
+	return t.WriteDataSlot(meta.Slot, b)
+}
@@ -6,11 +6,9 @@ import (
 	"sync"
 	"time"
 
-	"github.com/opensvc/om3/core/cluster"
 	"github.com/opensvc/om3/core/hbtype"
 	"github.com/opensvc/om3/core/omcrypto"
 	"github.com/opensvc/om3/daemon/hb/hbctrl"
-	"github.com/opensvc/om3/util/hostname"
 	"github.com/opensvc/om3/util/plog"
 )
 
@@ -31,8 +29,6 @@ type (
 		cmdC   chan<- any
 		msgC   chan<- *hbtype.Msg
 		cancel func()
-
-		encryptDecrypter *omcrypto.Factory
 	}
 )
 
@@ -69,13 +65,6 @@ func (t *rx) Start(cmdC chan<- any, msgC chan<- *hbtype.Msg) error {
 	t.msgC = msgC
 	t.cancel = cancel
 
-	clusterConfig := cluster.ConfigData.Get()
-	t.encryptDecrypter = &omcrypto.Factory{
-		NodeName:    hostname.Hostname(),
-		ClusterName: clusterConfig.Name,
-		Key:         clusterConfig.Secret(),
-	}
-
 	for _, node := range t.nodes {
 		cmdC <- hbctrl.CmdAddWatcher{
 			HbID:     t.id,
@@ -135,7 +124,7 @@ func (t *rx) recv(nodename string) {
 		t.log.Debugf("recv: node %s data slot %d has not been updated for %s", nodename, meta.Slot, elapsed)
 		return
 	}
-	b, msgNodename, err := t.encryptDecrypter.DecryptWithNode(c.Msg)
+	b, msgNodename, err := omcrypto.NewClusterFactory().DecryptWithNode(c.Msg)
 	if err != nil {
 		t.log.Debugf("recv: decrypting node %s data slot %d: %s", nodename, meta.Slot, err)
 		return
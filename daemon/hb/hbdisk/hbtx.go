@@ -50,6 +50,11 @@ func (t *tx) Stop() error {
 	return nil
 }
 
+// MaxMsgSize implements the MsgSizeLimiter interface for tx
+func (t *tx) MaxMsgSize() int {
+	return MaxMsgSize
+}
+
 // Start implements the Start function of Transmitter interface for tx
 func (t *tx) Start(cmdC chan<- interface{}, msgC <-chan []byte) error {
 	if err := t.base.device.open(); err != nil {
@@ -107,7 +112,7 @@ func (t *tx) send(b []byte) {
 		t.log.Debugf("send can't get peer for localhost: %s", err)
 		return
 	}
-	if t.base.WriteDataSlot(meta.Slot, b); err != nil { // TODO write timeout?
+	if err := t.base.WriteDataSlot(meta.Slot, b); err != nil { // TODO write timeout?
 		t.log.Debugf("send can't write data slot: %s", err)
 		return
 	} else {
@@ -64,6 +64,10 @@ var (
 	// SlotSize is the data size reserved for a single node
 	SlotSize = 1024 * 1024
 
+	// MaxMsgSize is the maximum size of a message fitting in a data slot,
+	// once base64 encoded in its capsule.
+	MaxMsgSize = (SlotSize - 1024) / 4 * 3
+
 	// MaxSlots is maximum number of slots that can fit in MetaSize
 	MaxSlots = MetaSize / PageSize
 )
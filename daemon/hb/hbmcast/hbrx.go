@@ -10,7 +10,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/opensvc/om3/core/cluster"
 	"github.com/opensvc/om3/core/hbtype"
 	"github.com/opensvc/om3/core/omcrypto"
 	"github.com/opensvc/om3/daemon/hb/hbctrl"
@@ -35,8 +34,6 @@ type (
 		cmdC   chan<- interface{}
 		msgC   chan<- *hbtype.Msg
 		cancel func()
-
-		encryptDecrypter *omcrypto.Factory
 	}
 	assembly map[string]msgMap
 	msgMap   map[string]dataMap
@@ -72,12 +69,6 @@ func (t *rx) Start(cmdC chan<- interface{}, msgC chan<- *hbtype.Msg) error {
 	t.cancel = cancel
 	t.log.Infof("starting")
 	t.assembly = make(assembly)
-	clusterConfig := cluster.ConfigData.Get()
-	t.encryptDecrypter = &omcrypto.Factory{
-		NodeName:    hostname.Hostname(),
-		ClusterName: clusterConfig.Name,
-		Key:         clusterConfig.Secret(),
-	}
 	started := make(chan bool)
 	t.Add(1)
 	go func() {
@@ -203,7 +194,7 @@ func (t *rx) recv(src *net.UDPAddr, n int, b []byte) {
 	} else {
 		encMsg = chunks[1]
 	}
-	b, err := t.encryptDecrypter.Decrypt(encMsg)
+	b, err := omcrypto.NewClusterFactory().Decrypt(encMsg)
 	if err != nil {
 		t.log.Debugf("recv: decrypting msg from %s: %s: %s", s, hex.Dump(encMsg), err)
 		return
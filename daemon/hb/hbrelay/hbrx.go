@@ -13,7 +13,6 @@ import (
 	"github.com/opensvc/om3/core/omcrypto"
 	"github.com/opensvc/om3/daemon/api"
 	"github.com/opensvc/om3/daemon/hb/hbctrl"
-	"github.com/opensvc/om3/util/hostname"
 	"github.com/opensvc/om3/util/plog"
 )
 
@@ -37,8 +36,6 @@ type (
 		cmdC   chan<- any
 		msgC   chan<- *hbtype.Msg
 		cancel func()
-
-		encryptDecrypter *omcrypto.Factory
 	}
 )
 
@@ -70,13 +67,6 @@ func (t *rx) Start(cmdC chan<- any, msgC chan<- *hbtype.Msg) error {
 	t.cancel = cancel
 	ticker := time.NewTicker(t.interval)
 
-	clusterConfig := cluster.ConfigData.Get()
-	t.encryptDecrypter = &omcrypto.Factory{
-		NodeName:    hostname.Hostname(),
-		ClusterName: clusterConfig.Name,
-		Key:         clusterConfig.Secret(),
-	}
-
 	for _, node := range t.nodes {
 		cmdC <- hbctrl.CmdAddWatcher{
 			HbID:     t.id,
@@ -154,7 +144,7 @@ func (t *rx) recv(nodename string) {
 		t.log.Debugf("recv: node %s data has not been updated for %s", nodename, elapsed)
 		return
 	}
-	b, msgNodename, err := t.encryptDecrypter.DecryptWithNode([]byte(c.Msg))
+	b, msgNodename, err := omcrypto.NewClusterFactory().DecryptWithNode([]byte(c.Msg))
 	if err != nil {
 		t.log.Debugf("recv: decrypting node %s: %s", nodename, err)
 		return
@@ -0,0 +1,75 @@
+package hbtls
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+type (
+	// frame is the unit multiplexed over a hb tls stream.
+	frame struct {
+		kind    byte
+		seq     uint64
+		payload []byte
+	}
+)
+
+const (
+	// frameHello is the first frame sent by the tx on a new stream. Its
+	// payload is the sender node name.
+	frameHello byte = iota + 1
+
+	// frameData is a frame sent by the tx. Its payload is an encrypted hb
+	// message.
+	frameData
+
+	// frameAck is the frame sent by the rx when a frameData is received.
+	// Its seq is the seq of the acknowledged frameData.
+	frameAck
+)
+
+const (
+	// frameHeaderSize is the size of the kind, seq and payload length
+	// header preceding the payload.
+	frameHeaderSize = 1 + 8 + 4
+)
+
+var (
+	// frameMaxSize is the max payload size (max kind=full msg size)
+	frameMaxSize = 10000000
+)
+
+// writeFrame writes the frame header and payload with a single write.
+func writeFrame(w io.Writer, f frame) error {
+	b := make([]byte, frameHeaderSize+len(f.payload))
+	b[0] = f.kind
+	binary.BigEndian.PutUint64(b[1:9], f.seq)
+	binary.BigEndian.PutUint32(b[9:13], uint32(len(f.payload)))
+	copy(b[frameHeaderSize:], f.payload)
+	_, err := w.Write(b)
+	return err
+}
+
+// readFrame reads a frame header and payload.
+func readFrame(r io.Reader) (frame, error) {
+	var f frame
+	header := make([]byte, frameHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return f, err
+	}
+	f.kind = header[0]
+	f.seq = binary.BigEndian.Uint64(header[1:9])
+	size := int(binary.BigEndian.Uint32(header[9:13]))
+	if size > frameMaxSize {
+		return f, fmt.Errorf("frame payload size %d exceeds %d", size, frameMaxSize)
+	}
+	if size == 0 {
+		return f, nil
+	}
+	f.payload = make([]byte, size)
+	if _, err := io.ReadFull(r, f.payload); err != nil {
+		return f, err
+	}
+	return f, nil
+}
@@ -0,0 +1,318 @@
+package hbtls
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/core/hbtype"
+	"github.com/opensvc/om3/core/omcrypto"
+	"github.com/opensvc/om3/daemon/hb/hbctrl"
+	"github.com/opensvc/om3/util/plog"
+)
+
+type (
+	// rx holds a hb tls receiver
+	rx struct {
+		sync.WaitGroup
+		ctx      context.Context
+		id       string
+		nodes    map[string]string
+		pins     map[string][]string
+		addr     string
+		port     string
+		timeout  time.Duration
+		interval time.Duration
+		keyStore keyStore
+
+		name   string
+		log    *plog.Logger
+		cmdC   chan<- interface{}
+		msgC   chan<- *hbtype.Msg
+		cancel func()
+
+		// mu protects conns and accepted
+		mu sync.Mutex
+
+		// conns is the current stream of each peer
+		conns map[string]*tls.Conn
+
+		// accepted is the number of streams accepted from each peer
+		accepted map[string]int
+	}
+)
+
+// ID implements the ID function of the Receiver interface for rx
+func (t *rx) ID() string {
+	return t.id
+}
+
+// Stop implements the Stop function of the Receiver interface for rx
+func (t *rx) Stop() error {
+	t.log.Debugf("cancelling")
+	t.cancel()
+	for node := range t.nodes {
+		t.cmdC <- hbctrl.CmdDelWatcher{
+			HbID:     t.id,
+			Nodename: node,
+		}
+	}
+	t.Wait()
+	t.log.Debugf("wait done")
+	return nil
+}
+
+// Start implements the Start function of the Receiver interface for rx
+//
+// streams from unexpected nodes or with unexpected certificates are
+// dropped.
+func (t *rx) Start(cmdC chan<- interface{}, msgC chan<- *hbtype.Msg) error {
+	ctx, cancel := context.WithCancel(t.ctx)
+	t.cmdC = cmdC
+	t.msgC = msgC
+	t.cancel = cancel
+	t.log.Infof("starting: timeout %s", t.timeout)
+	if _, _, _, err := t.keyStore.Load(); err != nil {
+		t.log.Errorf("load tls material failed: %s", err)
+		return err
+	}
+	listener, err := net.Listen("tcp", net.JoinHostPort(t.addr, t.port))
+	if err != nil {
+		t.log.Errorf("listen failed: %s", err)
+		return err
+	}
+	listener = tls.NewListener(listener, serverConfig(t.keyStore))
+	for node := range t.nodes {
+		cmdC <- hbctrl.CmdAddWatcher{
+			HbID:     t.id,
+			Nodename: node,
+			Ctx:      ctx,
+			Timeout:  t.timeout,
+		}
+	}
+	t.Add(1)
+	go func() {
+		defer t.Done()
+		t.janitor(ctx, listener)
+	}()
+	started := make(chan bool)
+	t.Add(1)
+	go func() {
+		defer t.Done()
+		t.log.Infof("listen to %s", listener.Addr())
+		started <- true
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				if errors.Is(err, net.ErrClosed) {
+					break
+				}
+				t.log.Errorf("listener accept: %s", err)
+				continue
+			}
+			t.Add(1)
+			go t.handle(ctx, conn.(*tls.Conn))
+		}
+		t.log.Infof("stopped %s", listener.Addr())
+	}()
+	<-started
+	t.log.Infof("started %s", t.addr)
+	return nil
+}
+
+// janitor closes the listener and the streams when ctx is done, and closes
+// the streams when the tls material is rotated, so the peers re-establish
+// them with the new certificates.
+func (t *rx) janitor(ctx context.Context, listener net.Listener) {
+	_, _, sig, _ := t.keyStore.Load()
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			t.log.Debugf("closing listener")
+			_ = listener.Close()
+			t.closeConns()
+			t.log.Debugf("closed listener")
+			return
+		case <-ticker.C:
+			_, _, newSig, err := t.keyStore.Load()
+			if err != nil {
+				t.log.Warnf("load tls material: %s", err)
+				continue
+			}
+			if newSig != sig {
+				t.log.Infof("tls material rotated, close the streams")
+				sig = newSig
+				t.closeConns()
+			}
+		}
+	}
+}
+
+func (t *rx) closeConns() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, conn := range t.conns {
+		_ = conn.Close()
+	}
+}
+
+// handle verifies the stream peer, then acknowledges and forwards the
+// received messages until the stream fails.
+func (t *rx) handle(ctx context.Context, conn *tls.Conn) {
+	defer t.Done()
+	defer func() {
+		_ = conn.Close()
+	}()
+	nodename, err := t.hello(ctx, conn)
+	if err != nil {
+		t.log.Warnf("drop stream from %s: %s", conn.RemoteAddr(), err)
+		return
+	}
+	t.register(nodename, conn)
+	defer t.unregister(nodename, conn)
+	for {
+		if err := conn.SetReadDeadline(time.Now().Add(t.timeout)); err != nil {
+			t.log.Debugf("set read deadline for %s: %s", nodename, err)
+			return
+		}
+		f, err := readFrame(conn)
+		if err != nil {
+			t.log.Debugf("read from %s: %s", nodename, err)
+			return
+		}
+		if f.kind != frameData {
+			t.log.Warnf("drop stream from %s: unexpected frame kind %d", nodename, f.kind)
+			return
+		}
+		msg, err := t.decode(nodename, f.payload)
+		if err != nil {
+			t.log.Warnf("decode message from %s: %s", nodename, err)
+			continue
+		}
+		if err := conn.SetWriteDeadline(time.Now().Add(t.timeout)); err != nil {
+			t.log.Debugf("set write deadline for %s: %s", nodename, err)
+			return
+		}
+		if err := writeFrame(conn, frame{kind: frameAck, seq: f.seq}); err != nil {
+			t.log.Debugf("ack to %s: %s", nodename, err)
+			return
+		}
+		t.cmdC <- hbctrl.CmdSetPeerSuccess{
+			Nodename: nodename,
+			HbID:     t.id,
+			Success:  true,
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case t.msgC <- msg:
+		}
+	}
+}
+
+// hello completes the tls handshake and reads the hello frame. It returns
+// the peer node name, after verifying it is an expected node, with a pinned
+// certificate.
+func (t *rx) hello(ctx context.Context, conn *tls.Conn) (string, error) {
+	if err := conn.SetDeadline(time.Now().Add(t.timeout)); err != nil {
+		return "", err
+	}
+	if err := conn.HandshakeContext(ctx); err != nil {
+		return "", fmt.Errorf("handshake: %w", err)
+	}
+	f, err := readFrame(conn)
+	if err != nil {
+		return "", fmt.Errorf("read hello: %w", err)
+	}
+	if f.kind != frameHello {
+		return "", fmt.Errorf("unexpected frame kind %d", f.kind)
+	}
+	nodename := string(f.payload)
+	if _, ok := t.nodes[nodename]; !ok {
+		return "", fmt.Errorf("unexpected node %s", nodename)
+	}
+	peerCertificates := conn.ConnectionState().PeerCertificates
+	if len(peerCertificates) == 0 {
+		return "", errors.New("no peer certificate")
+	}
+	if err := checkPins(peerCertificates[0], t.pins[nodename]); err != nil {
+		return "", fmt.Errorf("node %s: %w", nodename, err)
+	}
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		return "", err
+	}
+	return nodename, nil
+}
+
+// decode decrypts a data frame payload with the cluster secrets, and
+// verifies the message was encrypted by the stream peer.
+func (t *rx) decode(nodename string, b []byte) (*hbtype.Msg, error) {
+	b, msgNodename, err := omcrypto.NewClusterFactory().DecryptWithNode(b)
+	if err != nil {
+		return nil, err
+	}
+	if msgNodename != nodename {
+		return nil, fmt.Errorf("message encrypted by unexpected node %s", msgNodename)
+	}
+	msg := hbtype.Msg{}
+	if err := json.Unmarshal(b, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// register sets <conn> as the current stream of the peer, closing the
+// previous one, and updates the peer reconnect counter.
+func (t *rx) register(nodename string, conn *tls.Conn) {
+	t.mu.Lock()
+	if previous, ok := t.conns[nodename]; ok {
+		_ = previous.Close()
+	}
+	t.conns[nodename] = conn
+	t.accepted[nodename]++
+	reconnects := t.accepted[nodename] - 1
+	t.mu.Unlock()
+	t.log.Infof("stream from %s %s accepted", nodename, conn.RemoteAddr())
+	t.cmdC <- hbctrl.CmdSetPeerStats{
+		Nodename:   nodename,
+		HbID:       t.id,
+		Reconnects: reconnects,
+	}
+}
+
+func (t *rx) unregister(nodename string, conn *tls.Conn) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.conns[nodename] == conn {
+		delete(t.conns, nodename)
+	}
+}
+
+func newRx(ctx context.Context, name string, nodes map[string]string, pins map[string][]string, addr, port string, timeout, interval time.Duration, ks keyStore) *rx {
+	id := name + ".rx"
+	return &rx{
+		ctx:      ctx,
+		id:       id,
+		nodes:    nodes,
+		pins:     pins,
+		addr:     addr,
+		port:     port,
+		timeout:  timeout,
+		interval: interval,
+		keyStore: ks,
+		conns:    make(map[string]*tls.Conn),
+		accepted: make(map[string]int),
+		log: plog.NewDefaultLogger().Attr("pkg", "daemon/hb/hbtls").
+			Attr("hb_func", "rx").
+			Attr("hb_name", name).
+			Attr("hb_id", id).
+			WithPrefix("daemon: hb: tls: rx: " + name + ": "),
+	}
+}
@@ -0,0 +1,343 @@
+package hbtls
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/daemon/hb/hbctrl"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/plog"
+)
+
+type (
+	// tx holds a hb tls transmitter
+	tx struct {
+		sync.WaitGroup
+		ctx      context.Context
+		id       string
+		nodes    map[string]string
+		pins     map[string][]string
+		port     string
+		interval time.Duration
+		timeout  time.Duration
+		keyStore keyStore
+
+		name   string
+		log    *plog.Logger
+		cmdC   chan<- interface{}
+		cancel func()
+	}
+
+	// pendingAcks holds the send time of the data frames not yet
+	// acknowledged by the peer.
+	pendingAcks struct {
+		sync.Mutex
+		m map[uint64]time.Time
+	}
+)
+
+var (
+	// queueSize is the max number of messages queued for a peer
+	queueSize = 8
+)
+
+// ID implements the ID function of Transmitter interface for tx
+func (t *tx) ID() string {
+	return t.id
+}
+
+// Stop implements the Stop function of Transmitter interface for tx
+func (t *tx) Stop() error {
+	t.log.Debugf("cancelling")
+	t.cancel()
+	for node := range t.nodes {
+		t.cmdC <- hbctrl.CmdDelWatcher{
+			HbID:     t.id,
+			Nodename: node,
+		}
+	}
+	t.Wait()
+	t.log.Debugf("wait done")
+	return nil
+}
+
+// Start implements the Start function of Transmitter interface for tx
+func (t *tx) Start(cmdC chan<- interface{}, msgC <-chan []byte) error {
+	started := make(chan bool)
+	ctx, cancel := context.WithCancel(t.ctx)
+	t.cancel = cancel
+	t.cmdC = cmdC
+	t.Add(1)
+	go func() {
+		defer t.Done()
+		t.log.Infof("starting: timeout %s, interval: %s", t.timeout, t.interval)
+		queues := make(map[string]chan []byte)
+		for node, addr := range t.nodes {
+			cmdC <- hbctrl.CmdAddWatcher{
+				HbID:     t.id,
+				Nodename: node,
+				Ctx:      ctx,
+				Timeout:  t.timeout,
+			}
+			queue := make(chan []byte, queueSize)
+			queues[node] = queue
+			t.Add(1)
+			go t.stream(ctx, node, addr, queue)
+		}
+		started <- true
+		var b []byte
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+		var reason string
+		for {
+			select {
+			case <-ctx.Done():
+				t.log.Infof("stopped")
+				return
+			case b = <-msgC:
+				reason = "send msg"
+				ticker.Reset(t.interval)
+			case <-ticker.C:
+				reason = "send msg (interval)"
+			}
+			if len(b) == 0 {
+				continue
+			}
+			t.log.Debugf(reason)
+			for node, queue := range queues {
+				if enqueue(queue, b) {
+					t.log.Debugf("queue full for %s, drop oldest msg", node)
+				}
+			}
+		}
+	}()
+	<-started
+	t.log.Infof("started")
+	return nil
+}
+
+// enqueue pushes b to the queue, dropping the oldest queued messages if the
+// queue is full. It returns true if messages were dropped.
+func enqueue(queue chan []byte, b []byte) (dropped bool) {
+	for {
+		select {
+		case queue <- b:
+			return
+		default:
+		}
+		select {
+		case <-queue:
+			dropped = true
+		default:
+		}
+	}
+}
+
+// stream maintains the tls stream to the peer <node>, and sends the queued
+// messages until ctx is done.
+func (t *tx) stream(ctx context.Context, node, addr string, queue <-chan []byte) {
+	defer t.Done()
+	var (
+		connected  bool
+		reconnects int
+		latency    time.Duration
+	)
+	for {
+		conn, sig, err := t.dial(ctx, node, addr)
+		if err != nil {
+			t.log.Debugf("dial %s %s:%s: %s", node, addr, t.port, err)
+		} else {
+			if connected {
+				reconnects++
+				t.log.Infof("reconnected to %s %s:%s", node, addr, t.port)
+			} else {
+				t.log.Infof("connected to %s %s:%s", node, addr, t.port)
+			}
+			connected = true
+			t.setStats(node, latency, reconnects)
+			latency, err = t.serve(ctx, node, conn, sig, reconnects, queue)
+			if ctx.Err() != nil {
+				return
+			}
+			t.log.Infof("stream to %s %s:%s ended: %s", node, addr, t.port, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(t.interval):
+		}
+	}
+}
+
+// dial opens a tls stream to the peer and sends the hello frame. It also
+// returns the signature of the tls material used for the handshake.
+func (t *tx) dial(ctx context.Context, node, addr string) (*tls.Conn, string, error) {
+	_, _, sig, err := t.keyStore.Load()
+	if err != nil {
+		return nil, "", err
+	}
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: t.timeout},
+		Config:    clientConfig(t.keyStore, t.pins[node]),
+	}
+	c, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(addr, t.port))
+	if err != nil {
+		return nil, "", err
+	}
+	conn := c.(*tls.Conn)
+	if err := conn.SetWriteDeadline(time.Now().Add(t.timeout)); err != nil {
+		_ = conn.Close()
+		return nil, "", err
+	}
+	if err := writeFrame(conn, frame{kind: frameHello, payload: []byte(hostname.Hostname())}); err != nil {
+		_ = conn.Close()
+		return nil, "", err
+	}
+	return conn, sig, nil
+}
+
+// serve sends the queued messages to the peer until the stream fails, the
+// peer stops acknowledging, the tls material is rotated or ctx is done.
+// It closes the stream and returns the last measured latency.
+func (t *tx) serve(ctx context.Context, node string, conn *tls.Conn, sig string, reconnects int, queue <-chan []byte) (time.Duration, error) {
+	pending := &pendingAcks{m: make(map[uint64]time.Time)}
+	var latency time.Duration
+	var latencyMu sync.Mutex
+	errC := make(chan error, 1)
+	done := make(chan bool)
+	go func() {
+		defer close(done)
+		errC <- t.readAcks(conn, pending, func(d time.Duration) {
+			latencyMu.Lock()
+			latency = d
+			latencyMu.Unlock()
+			t.cmdC <- hbctrl.CmdSetPeerSuccess{
+				Nodename: node,
+				HbID:     t.id,
+				Success:  true,
+			}
+			t.setStats(node, d, reconnects)
+		})
+	}()
+	lastLatency := func() time.Duration {
+		latencyMu.Lock()
+		defer latencyMu.Unlock()
+		return latency
+	}
+	defer func() {
+		_ = conn.Close()
+		<-done
+	}()
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+	var seq uint64
+	for {
+		select {
+		case <-ctx.Done():
+			return lastLatency(), ctx.Err()
+		case err := <-errC:
+			return lastLatency(), err
+		case <-ticker.C:
+			if oldest := pending.oldest(); !oldest.IsZero() && time.Since(oldest) > t.timeout {
+				return lastLatency(), fmt.Errorf("no ack since %s", oldest)
+			}
+			if _, _, newSig, err := t.keyStore.Load(); err == nil && newSig != sig {
+				return lastLatency(), errors.New("tls material rotated")
+			}
+		case b := <-queue:
+			seq++
+			pending.add(seq, time.Now())
+			if err := conn.SetWriteDeadline(time.Now().Add(t.timeout)); err != nil {
+				return lastLatency(), err
+			}
+			if err := writeFrame(conn, frame{kind: frameData, seq: seq, payload: b}); err != nil {
+				return lastLatency(), err
+			}
+		}
+	}
+}
+
+// readAcks reads the ack frames from the peer, and calls onAck with the
+// round trip time of the acknowledged data frames. It returns when the
+// stream is closed or fails.
+func (t *tx) readAcks(conn *tls.Conn, pending *pendingAcks, onAck func(time.Duration)) error {
+	for {
+		f, err := readFrame(conn)
+		if err != nil {
+			return err
+		}
+		if f.kind != frameAck {
+			return fmt.Errorf("unexpected frame kind %d", f.kind)
+		}
+		if sentAt, ok := pending.ack(f.seq); ok {
+			onAck(time.Since(sentAt))
+		}
+	}
+}
+
+func (t *tx) setStats(node string, latency time.Duration, reconnects int) {
+	t.cmdC <- hbctrl.CmdSetPeerStats{
+		Nodename:   node,
+		HbID:       t.id,
+		Latency:    latency,
+		Reconnects: reconnects,
+	}
+}
+
+func (t *pendingAcks) add(seq uint64, sentAt time.Time) {
+	t.Lock()
+	defer t.Unlock()
+	t.m[seq] = sentAt
+}
+
+// ack forgets the data frames up to <seq>, and returns the send time of the
+// <seq> data frame.
+func (t *pendingAcks) ack(seq uint64) (time.Time, bool) {
+	t.Lock()
+	defer t.Unlock()
+	sentAt, ok := t.m[seq]
+	for k := range t.m {
+		if k <= seq {
+			delete(t.m, k)
+		}
+	}
+	return sentAt, ok
+}
+
+// oldest returns the send time of the oldest data frame not yet
+// acknowledged, or the zero time.
+func (t *pendingAcks) oldest() time.Time {
+	t.Lock()
+	defer t.Unlock()
+	var oldest time.Time
+	for _, sentAt := range t.m {
+		if oldest.IsZero() || sentAt.Before(oldest) {
+			oldest = sentAt
+		}
+	}
+	return oldest
+}
+
+func newTx(ctx context.Context, name string, nodes map[string]string, pins map[string][]string, port string, timeout, interval time.Duration, ks keyStore) *tx {
+	id := name + ".tx"
+	return &tx{
+		ctx:      ctx,
+		id:       id,
+		nodes:    nodes,
+		pins:     pins,
+		port:     port,
+		interval: interval,
+		timeout:  timeout,
+		keyStore: ks,
+		log: plog.NewDefaultLogger().Attr("pkg", "daemon/hb/hbtls").
+			Attr("hb_func", "tx").
+			Attr("hb_name", name).
+			Attr("hb_id", id).
+			WithPrefix("daemon: hb: tls: tx: " + name + ": "),
+	}
+}
@@ -0,0 +1,236 @@
+package hbtls
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/opensvc/om3/core/cluster"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+)
+
+type (
+	// keyStore provides the local certificate and the pool of the CA
+	// certificates trusted to sign the peer certificates.
+	keyStore interface {
+		// Load returns the certificate, the CA pool and a signature
+		// changing when any of them is rotated.
+		Load() (*tls.Certificate, *x509.CertPool, string, error)
+	}
+
+	// secKeyStore is a keyStore reading the certificate from a sec object
+	// and the CA pool from the cluster CA sec objects. The sec objects are
+	// re-read only when their configuration file changes.
+	secKeyStore struct {
+		certPath naming.Path
+
+		mu   sync.Mutex
+		sig  string
+		cert *tls.Certificate
+		pool *x509.CertPool
+	}
+)
+
+var (
+	caPath = naming.Path{Name: "ca", Namespace: "system", Kind: naming.KindSec}
+)
+
+func newSecKeyStore(certPath naming.Path) *secKeyStore {
+	return &secKeyStore{certPath: certPath}
+}
+
+// Load implements the Load function of the keyStore interface for
+// secKeyStore.
+func (t *secKeyStore) Load() (*tls.Certificate, *x509.CertPool, string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	caPaths := t.caPaths()
+	sig := secSignature(append([]naming.Path{t.certPath}, caPaths...))
+	if sig == t.sig && t.cert != nil {
+		return t.cert, t.pool, t.sig, nil
+	}
+	cert, err := loadCertificate(t.certPath)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	pool, err := loadPool(caPaths)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	t.cert, t.pool, t.sig = cert, pool, sig
+	return t.cert, t.pool, t.sig, nil
+}
+
+func (t *secKeyStore) caPaths() []naming.Path {
+	l := []naming.Path{caPath}
+	for _, s := range cluster.ConfigData.Get().CASecPaths {
+		p, err := naming.ParsePath(s)
+		if err != nil {
+			continue
+		}
+		l = append(l, p)
+	}
+	return l
+}
+
+// secSignature returns a string changing when any of the <paths>
+// configuration file is changed.
+func secSignature(paths []naming.Path) string {
+	l := make([]string, len(paths))
+	for i, p := range paths {
+		if info, err := os.Stat(p.ConfigFile()); err == nil {
+			l[i] = fmt.Sprintf("%s:%d:%d", p, info.ModTime().UnixNano(), info.Size())
+		} else {
+			l[i] = p.String()
+		}
+	}
+	return strings.Join(l, " ")
+}
+
+func loadCertificate(p naming.Path) (*tls.Certificate, error) {
+	sec, err := object.NewSec(p, object.WithVolatile(true))
+	if err != nil {
+		return nil, fmt.Errorf("load certificate from %s: %w", p, err)
+	}
+	chain, err := sec.DecodeKey("certificate_chain")
+	if err != nil {
+		return nil, fmt.Errorf("load certificate from %s: %w", p, err)
+	}
+	key, err := sec.DecodeKey("private_key")
+	if err != nil {
+		return nil, fmt.Errorf("load certificate from %s: %w", p, err)
+	}
+	cert, err := tls.X509KeyPair(chain, key)
+	if err != nil {
+		return nil, fmt.Errorf("load certificate from %s: %w", p, err)
+	}
+	return &cert, nil
+}
+
+func loadPool(paths []naming.Path) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	for _, p := range paths {
+		if !p.Exists() {
+			continue
+		}
+		sec, err := object.NewSec(p, object.WithVolatile(true))
+		if err != nil {
+			return nil, fmt.Errorf("load ca from %s: %w", p, err)
+		}
+		chain, err := sec.DecodeKey("certificate_chain")
+		if err != nil {
+			return nil, fmt.Errorf("load ca from %s: %w", p, err)
+		}
+		pool.AppendCertsFromPEM(chain)
+	}
+	return pool, nil
+}
+
+// clientConfig returns the tls config of a tx stream to a peer, verifying
+// the peer certificate is signed by a cluster CA and matches the <pins>.
+//
+// The standard verification is disabled because the peer certificate
+// names are not expected to match the peer addresses.
+func clientConfig(ks keyStore, pins []string) *tls.Config {
+	return &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: true,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _, _, err := ks.Load()
+			return cert, err
+		},
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			leaf, err := verifyPeer(ks, rawCerts)
+			if err != nil {
+				return err
+			}
+			return checkPins(leaf, pins)
+		},
+	}
+}
+
+// serverConfig returns the tls config of the rx listener, requiring a peer
+// certificate signed by a cluster CA. The peer node is not known yet at
+// handshake time, so its pins are verified after the hello frame.
+func serverConfig(ks keyStore) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ClientAuth: tls.RequireAnyClientCert,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			cert, _, _, err := ks.Load()
+			return cert, err
+		},
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			_, err := verifyPeer(ks, rawCerts)
+			return err
+		},
+	}
+}
+
+// verifyPeer verifies the peer certificate chain is signed by a CA of the
+// keyStore pool, and returns the peer certificate.
+//
+// The extended key usages are not verified, because the certificates
+// generated by the sec objects are server auth only.
+func verifyPeer(ks keyStore, rawCerts [][]byte) (*x509.Certificate, error) {
+	if len(rawCerts) == 0 {
+		return nil, errors.New("no peer certificate")
+	}
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, b := range rawCerts {
+		cert, err := x509.ParseCertificate(b)
+		if err != nil {
+			return nil, fmt.Errorf("parse peer certificate: %w", err)
+		}
+		certs[i] = cert
+	}
+	_, pool, _, err := ks.Load()
+	if err != nil {
+		return nil, err
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	opts := x509.VerifyOptions{
+		Roots:         pool,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}
+	if _, err := certs[0].Verify(opts); err != nil {
+		return nil, fmt.Errorf("verify peer certificate: %w", err)
+	}
+	return certs[0], nil
+}
+
+// fingerprint returns the hex encoded sha256 sum of the certificate public
+// key. It does not change when a certificate is renewed with the same
+// private key.
+func fingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return hex.EncodeToString(sum[:])
+}
+
+// checkPins returns an error if <pins> is not empty and the certificate
+// fingerprint is not one of the pins.
+func checkPins(cert *x509.Certificate, pins []string) error {
+	if len(pins) == 0 {
+		return nil
+	}
+	s := fingerprint(cert)
+	for _, pin := range pins {
+		pin = strings.TrimPrefix(strings.ToLower(pin), "sha256:")
+		pin = strings.ReplaceAll(pin, ":", "")
+		if pin == s {
+			return nil
+		}
+	}
+	return fmt.Errorf("peer certificate fingerprint sha256:%s is not pinned", s)
+}
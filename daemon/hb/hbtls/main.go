@@ -0,0 +1,107 @@
+/*
+Package hbtls implement a hb driver over persistent mutual tls streams
+
+The tx keeps a tls stream to each peer rx, authenticated by certificates
+signed by the cluster CA. The peer certificates can also be pinned by
+their public key fingerprint.
+
+The tx multiplexes the hb messages over the stream as data frames, and the
+rx acknowledges each data frame, so the tx can measure the stream latency.
+The messages waiting for a slow or disconnected peer are queued in a
+bounded queue, dropping the oldest messages when full, so a peer never
+blocks the messages sent to the other peers.
+
+The certificates are reloaded when their sec objects change, and the
+streams are re-established to use them. The messages are still encrypted
+with the cluster secret, read on each message.
+*/
+package hbtls
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opensvc/om3/core/hbcfg"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/key"
+	"github.com/opensvc/om3/util/plog"
+)
+
+type (
+	T struct {
+		hbcfg.T
+	}
+)
+
+var (
+	defaultCertPath = naming.Path{Name: "cert", Namespace: "system", Kind: naming.KindSec}
+)
+
+func New() hbcfg.Confer {
+	t := &T{}
+	var i interface{} = t
+	return i.(hbcfg.Confer)
+}
+
+func init() {
+	hbcfg.Register("tls", New)
+}
+
+// Configure implements the Configure function of Confer interface for T
+func (t *T) Configure(ctx context.Context) {
+	log := plog.NewDefaultLogger().Attr("pkg", "daemon/hb/hbtls").Attr("hb_name", t.Name()).WithPrefix("daemon: hb: tls: " + t.Name() + ": configure: ")
+	interval := t.GetDuration("interval", 5*time.Second)
+	timeout := t.GetDuration("timeout", 15*time.Second)
+	if timeout < 2*interval+1*time.Second {
+		oldTimeout := timeout
+		timeout = interval*2 + 1*time.Second
+		log.Warnf("reajust timeout: %s => %s (<interval>*2+1s)", oldTimeout, timeout)
+	}
+	addr := t.GetString("addr")
+	portI := t.GetInt("port")
+	port := strconv.Itoa(portI)
+	certPath := defaultCertPath
+	if s := t.GetString("cert"); s != "" {
+		if p, err := naming.ParsePath(s); err != nil {
+			log.Warnf("invalid cert %s, use %s: %s", s, defaultCertPath, err)
+		} else {
+			certPath = p
+		}
+	}
+	nodes := t.GetStrings("nodes")
+	if len(nodes) == 0 {
+		k := key.T{Section: "cluster", Option: "nodes"}
+		nodes = t.Config().GetStrings(k)
+	}
+	peerList := hostname.OtherNodes(nodes)
+	peerMap := make(map[string]string)
+	pinMap := make(map[string][]string)
+	for _, peer := range peerList {
+		if s := t.GetStringAs("addr", peer); s != "" {
+			peerMap[peer] = s
+		} else {
+			peerMap[peer] = peer
+		}
+		if s := t.GetStringAs("pin", peer); s != "" {
+			pinMap[peer] = strings.Fields(s)
+		}
+	}
+	log.Debugf("timeout=%s interval=%s port=%s nodes=%s onodes=%s cert=%s", timeout, interval,
+		port, nodes, peerList, certPath)
+	t.SetNodes(peerList)
+	t.SetInterval(interval)
+	t.SetTimeout(timeout)
+	signature := fmt.Sprintf("type: hb.tls, port: %s nodes: %s timeout: %s interval: %s cert: %s pins: %v",
+		port, nodes, timeout, interval, certPath, pinMap)
+	t.SetSignature(signature)
+	name := t.Name()
+	ks := newSecKeyStore(certPath)
+	tx := newTx(ctx, name, peerMap, pinMap, port, timeout, interval, ks)
+	t.SetTx(tx)
+	rx := newRx(ctx, name, peerMap, pinMap, addr, port, timeout, interval, ks)
+	t.SetRx(rx)
+}
@@ -0,0 +1,250 @@
+package hbtls
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"math/big"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/cluster"
+	"github.com/opensvc/om3/core/hbtype"
+	"github.com/opensvc/om3/core/omcrypto"
+	"github.com/opensvc/om3/daemon/hb/hbctrl"
+	"github.com/opensvc/om3/util/hostname"
+)
+
+type (
+	testKeyStore struct {
+		sync.Mutex
+		cert *tls.Certificate
+		pool *x509.CertPool
+		sig  string
+	}
+
+	testCA struct {
+		cert *x509.Certificate
+		key  *ecdsa.PrivateKey
+	}
+)
+
+func (t *testKeyStore) Load() (*tls.Certificate, *x509.CertPool, string, error) {
+	t.Lock()
+	defer t.Unlock()
+	return t.cert, t.pool, t.sig, nil
+}
+
+func (t *testKeyStore) set(cert *tls.Certificate, pool *x509.CertPool, sig string) {
+	t.Lock()
+	defer t.Unlock()
+	t.cert, t.pool, t.sig = cert, pool, sig
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	b, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(b)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key}
+}
+
+func (ca *testCA) pool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	return pool
+}
+
+// issue returns a server auth only certificate, like the ones generated by
+// the sec objects.
+func (ca *testCA) issue(t *testing.T) *tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "node"},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	b, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(b)
+	require.NoError(t, err)
+	return &tls.Certificate{Certificate: [][]byte{b}, PrivateKey: key, Leaf: leaf}
+}
+
+func freePort(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = l.Close() }()
+	return strconv.Itoa(l.Addr().(*net.TCPAddr).Port)
+}
+
+func TestFrame(t *testing.T) {
+	var buff bytes.Buffer
+	require.NoError(t, writeFrame(&buff, frame{kind: frameData, seq: 42, payload: []byte("foo")}))
+	require.NoError(t, writeFrame(&buff, frame{kind: frameAck, seq: 42}))
+	f, err := readFrame(&buff)
+	require.NoError(t, err)
+	require.Equal(t, frame{kind: frameData, seq: 42, payload: []byte("foo")}, f)
+	f, err = readFrame(&buff)
+	require.NoError(t, err)
+	require.Equal(t, frame{kind: frameAck, seq: 42}, f)
+	_, err = readFrame(&buff)
+	require.Error(t, err)
+}
+
+func TestEnqueue(t *testing.T) {
+	queue := make(chan []byte, 2)
+	require.False(t, enqueue(queue, []byte("1")))
+	require.False(t, enqueue(queue, []byte("2")))
+	require.True(t, enqueue(queue, []byte("3")), "the oldest message must be dropped")
+	require.Equal(t, []byte("2"), <-queue)
+	require.Equal(t, []byte("3"), <-queue)
+}
+
+func TestCheckPins(t *testing.T) {
+	ca := newTestCA(t)
+	cert := ca.issue(t)
+	s := fingerprint(cert.Leaf)
+	require.NoError(t, checkPins(cert.Leaf, nil))
+	require.NoError(t, checkPins(cert.Leaf, []string{"sha256:" + s}))
+	require.NoError(t, checkPins(cert.Leaf, []string{"bad", s}))
+	require.Error(t, checkPins(cert.Leaf, []string{"sha256:bad"}))
+}
+
+func TestStream(t *testing.T) {
+	clusterConfig := &cluster.Config{Name: "test"}
+	clusterConfig.SetSecret("0123456789abcdef0123456789abcdef")
+	cluster.ConfigData.Set(clusterConfig)
+	encrypter := &omcrypto.Factory{
+		NodeName:    hostname.Hostname(),
+		ClusterName: clusterConfig.Name,
+		Key:         clusterConfig.Secret(),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ca := newTestCA(t)
+	ks := &testKeyStore{}
+	ks.set(ca.issue(t), ca.pool(), "1")
+
+	port := freePort(t)
+	interval := 50 * time.Millisecond
+	timeout := time.Second
+
+	cmdC := make(chan interface{})
+	var (
+		statsMu sync.Mutex
+		stats   = make(map[string]hbctrl.CmdSetPeerStats)
+	)
+	getStats := func(id string) hbctrl.CmdSetPeerStats {
+		statsMu.Lock()
+		defer statsMu.Unlock()
+		return stats[id]
+	}
+	go func() {
+		for i := range cmdC {
+			if c, ok := i.(hbctrl.CmdSetPeerStats); ok {
+				statsMu.Lock()
+				stats[c.HbID] = c
+				statsMu.Unlock()
+			}
+		}
+	}()
+
+	rx := newRx(ctx, "hb#1", map[string]string{hostname.Hostname(): "127.0.0.1"}, nil, "127.0.0.1", port, timeout, interval, ks)
+	tx := newTx(ctx, "hb#1", map[string]string{"peer": "127.0.0.1"}, nil, port, timeout, interval, ks)
+	msgC := make(chan *hbtype.Msg, 1000)
+	dataC := make(chan []byte)
+	require.NoError(t, rx.Start(cmdC, msgC))
+	require.NoError(t, tx.Start(cmdC, dataC))
+
+	send := func(gen uint64) {
+		b, err := json.Marshal(hbtype.Msg{Kind: "ping", Nodename: hostname.Hostname(), Gen: map[string]uint64{"peer": gen}})
+		require.NoError(t, err)
+		b, err = encrypter.Encrypt(b)
+		require.NoError(t, err)
+		dataC <- b
+	}
+
+	t.Run("messages are received and acknowledged", func(t *testing.T) {
+		send(1)
+		select {
+		case msg := <-msgC:
+			require.Equal(t, hostname.Hostname(), msg.Nodename)
+			require.Equal(t, uint64(1), msg.Gen["peer"])
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "message not received")
+		}
+		require.Eventually(t, func() bool {
+			return getStats("hb#1.tx").Latency > 0
+		}, 5*time.Second, 10*time.Millisecond, "latency not measured")
+	})
+
+	t.Run("rotated certificates re-establish the streams", func(t *testing.T) {
+		ks.set(ca.issue(t), ca.pool(), "2")
+		require.Eventually(t, func() bool {
+			select {
+			case <-msgC:
+			default:
+			}
+			return getStats("hb#1.tx").Reconnects > 0 && getStats("hb#1.rx").Reconnects > 0
+		}, 5*time.Second, 10*time.Millisecond, "streams not re-established")
+		send(2)
+		require.Eventually(t, func() bool {
+			select {
+			case msg := <-msgC:
+				return msg.Gen["peer"] == 2
+			default:
+				return false
+			}
+		}, 5*time.Second, 10*time.Millisecond, "message not received after rotation")
+	})
+
+	t.Run("untrusted certificates are rejected", func(t *testing.T) {
+		otherCA := newTestCA(t)
+		otherKs := &testKeyStore{}
+		otherKs.set(otherCA.issue(t), ca.pool(), "1")
+		conn, err := tls.Dial("tcp", "127.0.0.1:"+port, clientConfig(otherKs, nil))
+		if err == nil {
+			_ = conn.SetDeadline(time.Now().Add(time.Second))
+			_, err = readFrame(conn)
+			_ = conn.Close()
+		}
+		require.Error(t, err)
+	})
+
+	require.NoError(t, tx.Stop())
+	require.NoError(t, rx.Stop())
+	close(cmdC)
+	close(msgC)
+}
@@ -2,7 +2,6 @@ package hb
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"runtime"
@@ -10,7 +9,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/opensvc/om3/core/cluster"
 	"github.com/opensvc/om3/core/clusterhb"
 	"github.com/opensvc/om3/core/hbcfg"
 	"github.com/opensvc/om3/core/hbtype"
@@ -44,6 +42,11 @@ type (
 
 		ridSignature map[string]string
 
+		// peerCodecs are the codecs announced by the peers in their
+		// messages, used to negotiate the tx codecs.
+		peerCodecs   map[string][]string
+		peerCodecsMu sync.RWMutex
+
 		sub *pubsub.Subscription
 
 		// ctx is the main context for controller, and started hb drivers
@@ -58,6 +61,21 @@ type (
 		id string
 		// msgToSendQueue is the queue on which a tx fetch messages to send
 		msgToSendQueue chan []byte
+
+		// peers are the nodes the tx sends messages to
+		peers []string
+
+		// codec is the configured codec, used if all peers can decode it
+		codec string
+
+		// maxSize is the maximum size of the messages the tx can send,
+		// or 0 if unlimited
+		maxSize int
+
+		// negotiatedCodec and overflow are the encoding states of the
+		// last message, to log their changes.
+		negotiatedCodec string
+		overflow        bool
 	}
 )
 
@@ -72,6 +90,7 @@ func New(_ context.Context, opts ...funcopt.O) *T {
 	t.rxs = make(map[string]hbtype.Receiver)
 	t.readMsgQueue = make(chan *hbtype.Msg)
 	t.ridSignature = make(map[string]string)
+	t.peerCodecs = make(map[string][]string)
 	return t
 }
 
@@ -192,12 +211,25 @@ func (t *T) startHbTx(hb hbcfg.Confer) error {
 	select {
 	case <-t.msgToTxCtx.Done():
 		// don't hang up when context is done
-	case t.msgToTxRegister <- registerTxQueue{id: tx.ID(), msgToSendQueue: localDataC}:
+	case t.msgToTxRegister <- newRegisterTxQueue(hb, tx, localDataC):
 		t.txs[hb.Name()] = tx
 	}
 	return nil
 }
 
+func newRegisterTxQueue(hb hbcfg.Confer, tx hbtype.Transmitter, localDataC chan []byte) registerTxQueue {
+	c := registerTxQueue{
+		id:             tx.ID(),
+		msgToSendQueue: localDataC,
+		peers:          hb.Nodes(),
+		codec:          hb.Compression(),
+	}
+	if limiter, ok := tx.(hbtype.MsgSizeLimiter); ok {
+		c.maxSize = limiter.MaxMsgSize()
+	}
+	return c
+}
+
 func (t *T) startHbRx(hb hbcfg.Confer) error {
 	rx := hb.Rx()
 	if rx == nil {
@@ -253,7 +285,9 @@ func (t *T) rescanHb(ctx context.Context) error {
 	}
 	ridSignatureNew := make(map[string]string)
 	for rid, hb := range ridHb {
-		ridSignatureNew[rid] = hb.Signature()
+		// the compression is not part of the driver signatures, but the
+		// tx must be restarted to use the new codec.
+		ridSignatureNew[rid] = hb.Signature() + " compression: " + hb.Compression()
 	}
 
 	for rid := range t.ridSignature {
@@ -318,7 +352,7 @@ func (t *T) msgToTx(ctx context.Context) error {
 		defer t.wg.Done()
 		defer t.log.Infof("multiplexer message to hb tx drivers stopped")
 		t.log.Infof("multiplexer message to hb tx drivers started")
-		registeredTxMsgQueue := make(map[string]chan []byte)
+		registeredTxMsgQueue := make(map[string]*registerTxQueue)
 		defer func() {
 			// We have to async ask daemondata to not anymore write to hbSendQ
 			// async because daemon data can be waiting on running queueNewHbMsg():
@@ -348,32 +382,24 @@ func (t *T) msgToTx(ctx context.Context) error {
 				return
 			case c := <-t.msgToTxRegister:
 				t.log.Debugf("add %s to hb transmitters", c.id)
-				registeredTxMsgQueue[c.id] = c.msgToSendQueue
+				registeredTxMsgQueue[c.id] = &c
 			case txID := <-t.msgToTxUnregister:
 				t.log.Debugf("remove %s from hb transmitters", txID)
 				delete(registeredTxMsgQueue, txID)
 			case msg := <-msgC:
-				clusterConfig := cluster.ConfigData.Get()
-				encrypterDecrypter := &omcrypto.Factory{
-					NodeName:    hostname.Hostname(),
-					ClusterName: clusterConfig.Name,
-					Key:         clusterConfig.Secret(),
-				}
-				b, err := json.Marshal(msg)
-				if err != nil {
-					err = fmt.Errorf("marshal failure %s for msg %v", err, msg)
-					continue
-				}
-				b, err = encrypterDecrypter.Encrypt(b)
-				if err != nil {
-					continue
-				}
-				for _, txQueue := range registeredTxMsgQueue {
+				msg.Codecs = omcrypto.Codecs()
+				encoder := newMsgEncoder(msg, omcrypto.NewClusterFactory())
+				peerCodecs := t.getPeerCodecs()
+				for _, c := range registeredTxMsgQueue {
+					b, err := t.encodeForTx(encoder, c, peerCodecs)
+					if err != nil {
+						continue
+					}
 					select {
 					case <-ctx.Done():
 						// don't hang up when context is done
 						return
-					case txQueue <- b:
+					case c.msgToSendQueue <- b:
 					}
 				}
 			}
@@ -382,6 +408,48 @@ func (t *T) msgToTx(ctx context.Context) error {
 	return nil
 }
 
+// encodeForTx returns the message to send on the tx <c>, compressed with the
+// codec negotiated with the tx peers, and logs the negotiated codec and
+// message overflow changes.
+func (t *T) encodeForTx(encoder *msgEncoder, c *registerTxQueue, peerCodecs map[string][]string) ([]byte, error) {
+	accepted := acceptedCodecs(c.peers, peerCodecs)
+	codec := negotiateCodec(c.codec, accepted)
+	if codec != c.negotiatedCodec {
+		if c.codec != "" && codec != c.codec {
+			t.log.Infof("%s use the %s codec, the %s codec is not supported by all peers", c.id, codec, c.codec)
+		} else {
+			t.log.Infof("%s use the %s codec", c.id, codec)
+		}
+		c.negotiatedCodec = codec
+	}
+	b, err := encoder.encodeFor(c.id, codec, accepted, c.maxSize)
+	switch {
+	case err != nil && !c.overflow:
+		t.log.Warnf("%s can't send the message: %s", c.id, err)
+		c.overflow = true
+	case err == nil && c.overflow:
+		t.log.Infof("%s can send the messages again", c.id)
+		c.overflow = false
+	}
+	return b, err
+}
+
+func (t *T) getPeerCodecs() map[string][]string {
+	t.peerCodecsMu.RLock()
+	defer t.peerCodecsMu.RUnlock()
+	m := make(map[string][]string, len(t.peerCodecs))
+	for peer, codecs := range t.peerCodecs {
+		m[peer] = codecs
+	}
+	return m
+}
+
+func (t *T) setPeerCodecs(peer string, codecs []string) {
+	t.peerCodecsMu.Lock()
+	defer t.peerCodecsMu.Unlock()
+	t.peerCodecs[peer] = codecs
+}
+
 // msgFromRx get hbrx decoded messages from readMsgQueue, and
 // forward the decoded hb message to daemondata HBRecvMsgQ.
 //
@@ -433,6 +501,7 @@ func (t *T) msgFromRx(ctx context.Context) {
 				return
 			case dataMsgRecvQ <- msg:
 				t.log.Debugf("processed msg type %s from %s gens: %v", msg.Kind, msg.Nodename, msg.Gen)
+				t.setPeerCodecs(peer, msg.Codecs)
 				msgTimes[peer] = msg.UpdatedAt
 				count++
 			}
@@ -70,22 +70,28 @@ var (
 
 	errConfigFileCheck = errors.New("config file check")
 
-	keyApp              = key.New("DEFAULT", "app")
-	keyChildren         = key.New("DEFAULT", "children")
-	keyEnv              = key.New("DEFAULT", "env")
-	keyFlexMax          = key.New("DEFAULT", "flex_max")
-	keyFlexMin          = key.New("DEFAULT", "flex_min")
-	keyFlexTarget       = key.New("DEFAULT", "flex_target")
-	keyMonitorAction    = key.New("DEFAULT", "monitor_action")
-	keyNodes            = key.New("DEFAULT", "nodes")
-	keyOrchestrate      = key.New("DEFAULT", "orchestrate")
-	keyParents          = key.New("DEFAULT", "parents")
-	keyPool             = key.New("DEFAULT", "pool")
-	keyPlacement        = key.New("DEFAULT", "placement")
-	keyPreMonitorAction = key.New("DEFAULT", "pre_monitor_action")
-	keyPriority         = key.New("DEFAULT", "priority")
-	keySize             = key.New("DEFAULT", "size")
-	keyTopology         = key.New("DEFAULT", "topology")
+	keyApp               = key.New("DEFAULT", "app")
+	keyChildren          = key.New("DEFAULT", "children")
+	keyCPUReqs           = key.New("DEFAULT", "cpu_reqs")
+	keyEnv               = key.New("DEFAULT", "env")
+	keyFlexMax           = key.New("DEFAULT", "flex_max")
+	keyFlexMin           = key.New("DEFAULT", "flex_min")
+	keyFlexTarget        = key.New("DEFAULT", "flex_target")
+	keyHardAffinity      = key.New("DEFAULT", "hard_affinity")
+	keyHardAntiAffinity  = key.New("DEFAULT", "hard_anti_affinity")
+	keyMaintenanceWindow = key.New("DEFAULT", "maintenance_window")
+	keyMemReqs           = key.New("DEFAULT", "mem_reqs")
+	keyMonitorAction     = key.New("DEFAULT", "monitor_action")
+	keyNodes             = key.New("DEFAULT", "nodes")
+	keyOrchestrate       = key.New("DEFAULT", "orchestrate")
+	keyParents           = key.New("DEFAULT", "parents")
+	keyPool              = key.New("DEFAULT", "pool")
+	keyPlacement         = key.New("DEFAULT", "placement")
+	keyPreMonitorAction  = key.New("DEFAULT", "pre_monitor_action")
+	keyPriority          = key.New("DEFAULT", "priority")
+	keySize              = key.New("DEFAULT", "size")
+	keyStonith           = key.New("DEFAULT", "stonith")
+	keyTopology          = key.New("DEFAULT", "topology")
 )
 
 // Start launch goroutine instConfig worker for a local instance config
@@ -308,7 +314,12 @@ func (t *Manager) configFileCheck() error {
 	cfg.App = cf.GetString(keyApp)
 	cfg.Checksum = fmt.Sprintf("%x", checksum)
 	cfg.Children = t.getChildren(cf)
+	cfg.CPUReqs = t.getCPUReqs(cf)
 	cfg.Env = cf.GetString(keyEnv)
+	cfg.HardAffinity = cf.GetStrings(keyHardAffinity)
+	cfg.HardAntiAffinity = cf.GetStrings(keyHardAntiAffinity)
+	cfg.MaintenanceWindow = cf.GetString(keyMaintenanceWindow)
+	cfg.MemReqs = t.getMemReqs(cf)
 	cfg.MonitorAction = t.getMonitorAction(cf)
 	cfg.Orchestrate = t.getOrchestrate(cf)
 	cfg.Parents = t.getParents(cf)
@@ -317,6 +328,7 @@ func (t *Manager) configFileCheck() error {
 	cfg.Priority = t.getPriority(cf)
 	cfg.Resources = t.getResources(cf)
 	cfg.Scope = scope
+	cfg.Stonith = cf.GetBool(keyStonith)
 	cfg.Topology = t.getTopology(cf)
 	cfg.UpdatedAt = mtime
 	cfg.Size = cf.GetSize(keySize)
@@ -372,6 +384,24 @@ func (t *Manager) getParents(cf *xconfig.T) naming.Relations {
 	return naming.ParseRelations(l)
 }
 
+func (t *Manager) getCPUReqs(cf *xconfig.T) float64 {
+	v, err := cf.Eval(keyCPUReqs)
+	if err != nil {
+		return 0
+	}
+	if f, ok := v.(float64); ok {
+		return f
+	}
+	return 0
+}
+
+func (t *Manager) getMemReqs(cf *xconfig.T) int64 {
+	if i := cf.GetSize(keyMemReqs); i != nil {
+		return *i
+	}
+	return 0
+}
+
 func (t *Manager) getPlacementPolicy(cf *xconfig.T) placement.Policy {
 	s := cf.GetString(keyPlacement)
 	return placement.NewPolicy(s)
@@ -30,6 +30,7 @@ func (t *Manager) convergeGlobalExpectFromRemote() {
 		t.state.GlobalExpectUpdatedAt = t.instMonitor[mostRecentNode].GlobalExpectUpdatedAt
 		t.state.GlobalExpectOptions = t.instMonitor[mostRecentNode].GlobalExpectOptions
 		t.state.OrchestrationID = t.instMonitor[mostRecentNode].OrchestrationID
+		t.state.TraceParent = t.instMonitor[mostRecentNode].TraceParent
 		t.state.State = instance.MonitorStateIdle
 		strVal := t.instMonitor[mostRecentNode].GlobalExpect.String()
 		if strVal == "" {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/opensvc/om3/core/env"
 	"github.com/opensvc/om3/core/instance"
@@ -13,6 +14,7 @@ import (
 	"github.com/opensvc/om3/daemon/runner"
 	"github.com/opensvc/om3/util/command"
 	"github.com/opensvc/om3/util/pubsub"
+	"github.com/opensvc/om3/util/tracing"
 )
 
 var (
@@ -154,8 +156,22 @@ func (t *Manager) crmAction(title string, cmdArgs ...string) error {
 	return t.crmDefaultAction(title, cmdArgs...)
 }
 
-func (t *Manager) crmDefaultAction(title string, cmdArgs ...string) error {
+func (t *Manager) crmDefaultAction(title string, cmdArgs ...string) (err error) {
 	sid := uuid.New()
+	spanName := title
+	if spanName == "" && len(cmdArgs) > 1 {
+		spanName = cmdArgs[1]
+	}
+	ctx := tracing.ContextWithTraceParent(t.ctx, t.state.TraceParent)
+	ctx, span := tracing.StartSpan(ctx, "imon "+spanName,
+		attribute.String("path", t.path.String()),
+		attribute.String("node", t.localhost),
+		attribute.String("orchestration_id", t.state.OrchestrationID.String()),
+		attribute.String("global_expect", t.state.GlobalExpect.String()),
+		attribute.String("state", t.state.State.String()),
+		attribute.String("sid", sid.String()),
+	)
+	defer func() { tracing.EndSpan(span, err) }()
 	cmd := command.New(
 		command.WithName(cmdPath),
 		command.WithArgs(cmdArgs),
@@ -165,6 +181,7 @@ func (t *Manager) crmDefaultAction(title string, cmdArgs ...string) error {
 			env.ActionOrchestrationIDVar+"="+t.state.OrchestrationID.String(),
 			"OSVC_SESSION_ID="+sid.String(),
 		),
+		command.WithVarEnv(tracing.Environ(ctx)...),
 	)
 	labels := []pubsub.Label{t.labelLocalhost, t.labelPath, {"origin", "imon"}, {"sid", sid.String()}}
 	if title != "" {
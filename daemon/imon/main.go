@@ -76,6 +76,9 @@ type (
 
 		nodeMonitor   map[string]node.Monitor
 		nodeStats     map[string]node.Stats
+
+		// nodeStatsGen is incremented on each nodeStats change.
+		nodeStatsGen uint64
 		nodeStatus    map[string]node.Status
 		readyDuration time.Duration
 		scopeNodes    []string
@@ -89,6 +92,21 @@ type (
 		// priors is the list of peer instance nodenames that need restarting before we can restart locally
 		priors []string
 
+		// placementExplainKey identifies the placement inputs the
+		// placement explanation was computed for, including the
+		// generations of the node stats and placed instances data.
+		placementExplainKey string
+
+		// stonithWait is a map indexed by the lost peer nodenames where the
+		// instance was started, and not yet confirmed fenced. The ha
+		// takeover is blocked while the map is not empty.
+		stonithWait map[string]bool
+
+		// stonithDone is a map indexed by the lost peer nodenames where the
+		// fencing result was received before the peer instance status was
+		// dropped. It is consumed when the stonith wait is set.
+		stonithDone map[string]msgbus.NodeStonith
+
 		sub *pubsub.Subscription
 
 		pubsubBus *pubsub.Bus
@@ -130,6 +148,17 @@ type (
 		// It is used during enableDelayTimer():
 		// When false the delay timer is reset with delayDuration
 		delayTimerEnabled bool
+
+		// nodeMaintenanceWindow is the local node maintenance_window keyword value.
+		nodeMaintenanceWindow string
+
+		// maintenanceWindowTimer fires on the next maintenance window boundary.
+		maintenanceWindowTimer *time.Timer
+
+		// maintenanceWindowBlocked is a map indexed by the deferred action names
+		// to the end of the maintenance window that deferred them. It is used
+		// to announce a deferral once per window.
+		maintenanceWindowBlocked map[string]time.Time
 	}
 
 	// cmdOrchestrate can be used from post action go routines
@@ -197,6 +226,8 @@ func start(parent context.Context, qs pubsub.QueueSizer, p naming.Path, nodes []
 		nodeStats:     make(map[string]node.Stats),
 		nodeStatus:    make(map[string]node.Status),
 		priors:        make([]string, 0),
+		stonithWait:   make(map[string]bool),
+		stonithDone:   make(map[string]msgbus.NodeStonith),
 		localhost:     localhost,
 		scopeNodes:    nodes,
 		change:        true,
@@ -204,6 +235,8 @@ func start(parent context.Context, qs pubsub.QueueSizer, p naming.Path, nodes []
 
 		waitConvergedOrchestrationMsg: make(map[string]string),
 
+		maintenanceWindowBlocked: make(map[string]time.Time),
+
 		drainDuration: drainDuration,
 
 		updateLimiter: rate.NewLimiter(updateRate, int(updateRate)),
@@ -237,6 +270,7 @@ func (t *Manager) startSubscriptions(qs pubsub.QueueSizer) {
 	sub.AddFilter(&msgbus.NodeRejoin{}, t.labelLocalhost)
 	sub.AddFilter(&msgbus.NodeStatusUpdated{})
 	sub.AddFilter(&msgbus.NodeStatsUpdated{})
+	sub.AddFilter(&msgbus.NodeStonith{}, t.labelLocalhost)
 	sub.AddFilter(&msgbus.ObjectStatusUpdated{}, t.labelPath)
 	sub.AddFilter(&msgbus.ProgressInstanceMonitor{}, t.labelPath)
 	sub.AddFilter(&msgbus.SetInstanceMonitor{}, t.labelPath)
@@ -253,6 +287,9 @@ func (t *Manager) worker(initialNodes []string) {
 		t.instConfig = *iConfig
 		t.scopeNodes = append([]string{}, t.instConfig.Scope...)
 	}
+	if nConfig := node.ConfigData.Get(t.localhost); nConfig != nil {
+		t.nodeMaintenanceWindow = nConfig.MaintenanceWindow
+	}
 
 	// Initiate a CRM status refresh first, this will update our instance status cache
 	// as soon as possible.
@@ -274,6 +311,7 @@ func (t *Manager) worker(initialNodes []string) {
 	for _, v := range node.StatsData.GetAll() {
 		t.nodeStats[v.Node] = *v.Value
 	}
+	t.nodeStatsGen++
 	for _, v := range node.MonitorData.GetAll() {
 		t.nodeMonitor[v.Node] = *v.Value
 	}
@@ -296,9 +334,13 @@ func (t *Manager) worker(initialNodes []string) {
 	t.initRelationAvailStatus()
 	t.initResourceMonitor()
 	t.updateIsLeader()
+	t.updateMaintenanceWindow()
 	t.updateIfChange()
 
 	defer func() {
+		if t.maintenanceWindowTimer != nil {
+			t.maintenanceWindowTimer.Stop()
+		}
 		go func() {
 			err := t.sub.Stop()
 			if err != nil && !errors.Is(err, context.Canceled) {
@@ -360,6 +402,8 @@ func (t *Manager) worker(initialNodes []string) {
 				t.onNodeStatusUpdated(c)
 			case *msgbus.NodeStatsUpdated:
 				t.onNodeStatsUpdated(c)
+			case *msgbus.NodeStonith:
+				t.onNodeStonith(c)
 			}
 		case i := <-t.cmdC:
 			select {
@@ -370,6 +414,8 @@ func (t *Manager) worker(initialNodes []string) {
 			switch c := i.(type) {
 			case cmdOrchestrate:
 				t.needOrchestrate(c)
+			case cmdMaintenanceWindow:
+				t.onMaintenanceWindow()
 			}
 		case <-t.delayTimer.C:
 			t.onDelayTimer()
@@ -342,6 +342,7 @@ func (t *Manager) onInstanceConfigUpdated(srcNode string, srcCmd *msgbus.Instanc
 		}()
 		t.instConfig = srcCmd.Value
 		t.initResourceMonitor()
+		t.updateMaintenanceWindow()
 		janitorInstStatus(srcCmd.Value.Scope)
 		janitorRelations(srcCmd.Value.Children, "Child", t.state.Children)
 		janitorRelations(srcCmd.Value.Parents, "Parent", t.state.Parents)
@@ -351,9 +352,10 @@ func (t *Manager) onInstanceConfigUpdated(srcNode string, srcCmd *msgbus.Instanc
 }
 
 func (t *Manager) onMyInstanceStatusDeleted(c *msgbus.InstanceStatusDeleted) {
-	if _, ok := t.instStatus[c.Node]; ok {
+	if instStatus, ok := t.instStatus[c.Node]; ok {
 		t.log.Debugf("drop deleted instance status from node %s", c.Node)
 		delete(t.instStatus, c.Node)
+		t.stonithWaitIfStarted(c.Node, instStatus)
 	}
 }
 
@@ -622,6 +624,7 @@ func (t *Manager) onSetInstanceMonitor(c *msgbus.SetInstanceMonitor) {
 		}
 		t.state.OrchestrationID = c.Value.CandidateOrchestrationID
 		t.acceptedOrchestrationID = c.Value.CandidateOrchestrationID
+		t.state.TraceParent = c.Value.TraceParent
 		t.onChange()
 	} else {
 		t.pubsubBus.Pub(&msgbus.ObjectOrchestrationRefused{
@@ -638,12 +641,21 @@ func (t *Manager) onSetInstanceMonitor(c *msgbus.SetInstanceMonitor) {
 
 func (t *Manager) onNodeConfigUpdated(c *msgbus.NodeConfigUpdated) {
 	t.readyDuration = c.Value.ReadyPeriod
+	if t.nodeMaintenanceWindow != c.Value.MaintenanceWindow {
+		t.nodeMaintenanceWindow = c.Value.MaintenanceWindow
+		t.updateMaintenanceWindow()
+	}
 	t.orchestrate()
 	t.updateIfChange()
 }
 
 func (t *Manager) onNodeMonitorUpdated(c *msgbus.NodeMonitorUpdated) {
 	t.nodeMonitor[c.Node] = c.Value
+	if t.stonithWait[c.Node] {
+		t.log.Infof("peer %s is back, stop waiting for its fencing", c.Node)
+		delete(t.stonithWait, c.Node)
+	}
+	delete(t.stonithDone, c.Node)
 	t.onChange()
 }
 
@@ -654,7 +666,9 @@ func (t *Manager) onNodeStatusUpdated(c *msgbus.NodeStatusUpdated) {
 
 func (t *Manager) onNodeStatsUpdated(c *msgbus.NodeStatsUpdated) {
 	t.nodeStats[c.Node] = c.Value
-	if t.objStatus.PlacementPolicy == placement.Score {
+	t.nodeStatsGen++
+	switch t.objStatus.PlacementPolicy {
+	case placement.Score, placement.Balance:
 		t.onChange()
 	}
 }
@@ -757,6 +771,9 @@ func (t *Manager) isStartable() (bool, string) {
 	if t.isStarted() {
 		return false, "already started"
 	}
+	if len(t.stonithWait) > 0 {
+		return false, fmt.Sprintf("wait for the fencing of peer %s", strings.Join(t.stonithWaitPeers(), ","))
+	}
 	return true, "object is startable"
 }
 
@@ -805,6 +822,8 @@ func (t *Manager) sortCandidates(candidates []string) []string {
 		return t.sortWithShiftPolicy(candidates)
 	case placement.LastStart:
 		return t.sortWithLastStartPolicy(candidates)
+	case placement.Balance:
+		return t.sortWithBalancePolicy(candidates)
 	default:
 		return []string{}
 	}
@@ -959,7 +978,8 @@ func (t *Manager) IsNodeMonitorStatusRankable(node string) (bool, bool) {
 	return nodeMonitor.State.IsRankable(), true
 }
 
-func (t *Manager) newIsHALeader() bool {
+// haLeaderRankable returns the scope nodes that can be ranked as ha leader.
+func (t *Manager) haLeaderRankable() []string {
 	var candidates []string
 
 	for _, node := range t.scopeNodes {
@@ -983,8 +1003,10 @@ func (t *Manager) newIsHALeader() bool {
 		}
 		candidates = append(candidates, node)
 	}
-	candidates = t.sortCandidates(candidates)
+	return candidates
+}
 
+func (t *Manager) newIsHALeader(candidates []string) bool {
 	var maxLeaders int = 1
 	if t.objStatus.Topology == topology.Flex {
 		maxLeaders = t.objStatus.FlexTarget
@@ -1028,11 +1050,19 @@ func (t *Manager) updateIsLeader() {
 		t.change = true
 		t.state.IsLeader = isLeader
 	}
-	isHALeader := t.newIsHALeader()
+	rankable := t.haLeaderRankable()
+	candidates := t.sortCandidates(rankable)
+	isHALeader := t.newIsHALeader(candidates)
 	if isHALeader != t.state.IsHALeader {
 		t.change = true
 		t.state.IsHALeader = isHALeader
 	}
+	placementRank := stringslice.Index(t.localhost, candidates) + 1
+	if placementRank != t.state.PlacementRank {
+		t.change = true
+		t.state.PlacementRank = placementRank
+	}
+	t.updatePlacementExplain(rankable, candidates)
 	return
 }
 
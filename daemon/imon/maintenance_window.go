@@ -0,0 +1,129 @@
+package imon
+
+import (
+	"time"
+
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/schedule"
+	"github.com/opensvc/om3/daemon/msgbus"
+)
+
+type (
+	// cmdMaintenanceWindow is sent by the maintenance window timer when
+	// a window opens or closes.
+	cmdMaintenanceWindow struct{}
+)
+
+// updateMaintenanceWindow evaluates the open or next maintenance window from
+// the node and object maintenance_window keywords, stores it in the instance
+// monitor and arms a timer to re-evaluate the window on its next boundary.
+func (t *Manager) updateMaintenanceWindow() {
+	now := time.Now()
+	w, err := schedule.NextWindowOf(now, map[string]string{
+		"node.maintenance_window": t.nodeMaintenanceWindow,
+		"maintenance_window":      t.instConfig.MaintenanceWindow,
+	})
+	if err != nil {
+		t.log.Warnf("maintenance window: %s", err)
+	}
+
+	if t.maintenanceWindowTimer != nil {
+		t.maintenanceWindowTimer.Stop()
+		t.maintenanceWindowTimer = nil
+	}
+
+	var next time.Time
+	switch {
+	case w.Begin.IsZero():
+		if t.state.MaintenanceWindow != nil {
+			t.state.MaintenanceWindow = nil
+			t.change = true
+		}
+		return
+	case w.IsOpen(now):
+		next = w.End
+	default:
+		next = w.Begin
+	}
+
+	if !isSameMaintenanceWindow(t.state.MaintenanceWindow, &w, now) {
+		t.state.MaintenanceWindow = &w
+		t.change = true
+	}
+
+	t.maintenanceWindowTimer = time.AfterFunc(time.Until(next), func() {
+		select {
+		case <-t.ctx.Done():
+		case t.cmdC <- cmdMaintenanceWindow{}:
+		}
+	})
+}
+
+// isSameMaintenanceWindow returns true if the windows a and b are the same.
+// The Begin of open windows follows the evaluation time, so it is not
+// compared.
+func isSameMaintenanceWindow(a, b *schedule.Window, now time.Time) bool {
+	switch {
+	case a == nil && b == nil:
+		return true
+	case a == nil || b == nil:
+		return false
+	case a.Key != b.Key || a.Schedule != b.Schedule || !a.End.Equal(b.End):
+		return false
+	case a.IsOpen(now) && b.IsOpen(now):
+		return true
+	default:
+		return a.Begin.Equal(b.Begin)
+	}
+}
+
+func (t *Manager) onMaintenanceWindow() {
+	t.updateMaintenanceWindow()
+	if w := t.state.MaintenanceWindow; w == nil || !w.IsOpen(time.Now()) {
+		t.maintenanceWindowBlocked = make(map[string]time.Time)
+		t.log.Infof("maintenance window closed, resume deferred orchestrations")
+	}
+	t.onChange()
+}
+
+// isDeferredByMaintenanceWindow returns true if a maintenance window is
+// open on the local node. The first deferral of an action during a window
+// is logged and announced by a MaintenanceWindowBlocked event.
+func (t *Manager) isDeferredByMaintenanceWindow(action string) bool {
+	w := t.state.MaintenanceWindow
+	if w == nil || !w.IsOpen(time.Now()) {
+		return false
+	}
+	if end, ok := t.maintenanceWindowBlocked[action]; ok && end.Equal(w.End) {
+		return true
+	}
+	t.maintenanceWindowBlocked[action] = w.End
+	t.loggerWithState().Infof("%s deferred by %s %s until %s", action, w.Key, w.Schedule, w.End.Format(time.RFC3339))
+	t.pubsubBus.Pub(&msgbus.MaintenanceWindowBlocked{Node: t.localhost, Path: t.path, Action: action, Window: *w},
+		t.labelPath,
+		t.labelLocalhost,
+	)
+	return true
+}
+
+// isGivebackDeferred returns true if the giveback stop of the local instance
+// must wait for the end of a maintenance window open on the local node or on
+// a ha leader node, which would otherwise defer the start.
+func (t *Manager) isGivebackDeferred() bool {
+	if t.state.State != instance.MonitorStateIdle {
+		// the giveback is already in progress
+		return false
+	}
+	if t.isDeferredByMaintenanceWindow("giveback") {
+		return true
+	}
+	now := time.Now()
+	for node, v := range t.instMonitor {
+		if !v.IsHALeader || v.MaintenanceWindow == nil || !v.MaintenanceWindow.IsOpen(now) {
+			continue
+		}
+		t.log.Debugf("giveback deferred by the %s maintenance window on node %s", v.MaintenanceWindow.Key, node)
+		return true
+	}
+	return false
+}
@@ -631,6 +631,11 @@ func orchestrateTestFunc(t *testing.T, c tCase) {
 	t.Logf("verify ha leader")
 	assert.Equalf(t, c.expectedIsHALeader, evImon.Value.IsHALeader,
 		"expected IsHALeader %v found %v", c.expectedIsHALeader, evImon.Value.IsHALeader)
+	if c.expectedIsHALeader {
+		// the single node cluster ha leader is the first placement candidate
+		assert.Equalf(t, 1, evImon.Value.PlacementRank,
+			"expected PlacementRank 1 found %d", evImon.Value.PlacementRank)
+	}
 
 	t.Logf("verify calls")
 	assert.Equalf(t, c.expectedCrm, calls,
@@ -151,6 +151,7 @@ func (t *Manager) endOrchestration() {
 	t.state.GlobalExpectOptions = nil
 	t.state.OrchestrationIsDone = false
 	t.state.OrchestrationID = uuid.UUID{}
+	t.state.TraceParent = ""
 	t.clearPending()
 	t.updateIfChange()
 	if t.acceptedOrchestrationID != uuid.Nil {
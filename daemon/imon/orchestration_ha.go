@@ -53,6 +53,13 @@ func (t *Manager) orchestrateHAStart() {
 	if t.isLocalStarted() {
 		return
 	}
+	if t.state.IsHALeader && t.isDeferredByMaintenanceWindow("ha start") {
+		if t.pendingCancel != nil && t.state.State == instance.MonitorStateReady {
+			t.clearPending()
+			t.transitionTo(instance.MonitorStateIdle)
+		}
+		return
+	}
 	t.orchestrateStarted()
 }
 
@@ -8,6 +8,9 @@ func (t *Manager) orchestratePlaced() {
 	if t.state.IsHALeader {
 		t.orchestratePlacedStart()
 	} else {
+		if t.isGivebackDeferred() {
+			return
+		}
 		t.orchestratePlacedStop()
 	}
 }
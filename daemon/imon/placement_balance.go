@@ -0,0 +1,114 @@
+package imon
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/placement"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/core/topology"
+	"github.com/opensvc/om3/util/sizeconv"
+)
+
+// sortWithBalancePolicy sorts candidates by ascending projected usage and
+// drops the candidates violating the hard affinity rules or lacking the
+// capacity to honor the object requirements.
+func (t *Manager) sortWithBalancePolicy(candidates []string) []string {
+	return t.balanceCandidates(candidates).Eligible()
+}
+
+// balanceCandidates returns the candidates ranked by the balance placement
+// policy, with the explanation of each rank.
+//
+// The instances placed in the cluster are the up or warn instances known
+// by the instance status data.
+func (t *Manager) balanceCandidates(candidates []string) placement.Candidates {
+	req := placement.BalanceRequest{
+		Path:             t.path.String(),
+		MemMB:            uint64(t.instConfig.MemReqs / sizeconv.MiB),
+		CPU:              t.instConfig.CPUReqs,
+		HardAffinity:     normalizePaths(t.instConfig.HardAffinity),
+		HardAntiAffinity: normalizePaths(t.instConfig.HardAntiAffinity),
+	}
+	if t.objStatus.Topology == topology.Flex {
+		req.Target = t.objStatus.FlexTarget
+	}
+	for _, nodename := range candidates {
+		n := placement.BalanceNode{Name: nodename}
+		if stats, ok := t.nodeStats[nodename]; ok {
+			n.MemTotalMB = stats.MemTotalMB
+			n.MemAvailPct = stats.MemAvailPct
+			n.NumCPU = stats.NumCPU
+			n.Load15M = stats.Load15M
+		}
+		req.Nodes = append(req.Nodes, n)
+	}
+	for _, e := range instance.StatusData.GetAll() {
+		if e.Value == nil || !e.Value.Avail.Is(status.Up, status.Warn) {
+			continue
+		}
+		inst := placement.BalanceInstance{
+			Path: e.Path.String(),
+			Node: e.Node,
+		}
+		if cfg := instance.ConfigData.Get(e.Path, e.Node); cfg != nil {
+			inst.MemMB = uint64(cfg.MemReqs / sizeconv.MiB)
+			inst.CPU = cfg.CPUReqs
+		}
+		req.Placed = append(req.Placed, inst)
+	}
+	return placement.RankBalance(req)
+}
+
+// updatePlacementExplain sets the local instance monitor placement
+// explanation when the object uses a placement policy supporting
+// explanations, and unsets it otherwise.
+//
+// The explanation ranks the local node among the <rankable> nodes. It is
+// recomputed only when the policy, the <rankable> nodes, their ranked
+// <candidates>, the node stats or the placed instances change, as the
+// ranking walks all the cluster instances.
+func (t *Manager) updatePlacementExplain(rankable, candidates []string) {
+	key := fmt.Sprintf("%s %d %s %s", t.objStatus.PlacementPolicy, t.objStatus.FlexTarget, rankable, candidates)
+	if t.objStatus.PlacementPolicy == placement.Balance {
+		key += fmt.Sprintf(" %d %d %d", t.nodeStatsGen, instance.StatusData.Gen(), instance.ConfigData.Gen())
+	}
+	if key == t.placementExplainKey {
+		return
+	}
+	t.placementExplainKey = key
+	var explain *placement.Candidate
+	if t.objStatus.PlacementPolicy == placement.Balance {
+		nodes := rankable
+		if !slices.Contains(nodes, t.localhost) {
+			nodes = append(append([]string{}, nodes...), t.localhost)
+		}
+		if c, ok := t.balanceCandidates(nodes).Get(t.localhost); ok {
+			explain = &c
+		}
+	}
+	switch {
+	case explain == nil && t.state.PlacementExplain == nil:
+		return
+	case explain != nil && t.state.PlacementExplain != nil && *explain == *t.state.PlacementExplain:
+		return
+	}
+	t.change = true
+	t.state.PlacementExplain = explain
+}
+
+// normalizePaths returns the string representation of the parsable
+// object paths in l, so they can be compared to naming.Path.String().
+func normalizePaths(l []string) []string {
+	paths := make([]string, 0, len(l))
+	for _, s := range l {
+		p, err := naming.ParsePath(s)
+		if err != nil {
+			continue
+		}
+		paths = append(paths, p.String())
+	}
+	return paths
+}
@@ -0,0 +1,72 @@
+package imon
+
+import (
+	"sort"
+
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/core/topology"
+	"github.com/opensvc/om3/daemon/msgbus"
+)
+
+// stonithWaitIfStarted blocks the ha takeover until the <peer> node is
+// confirmed fenced, if the stonith keyword is set and the dropped <peer>
+// instance status was started.
+//
+// The fencing result may have been received before the peer instance
+// status was dropped, in which case it is applied immediately.
+func (t *Manager) stonithWaitIfStarted(peer string, instStatus instance.Status) {
+	if peer == t.localhost {
+		return
+	}
+	if !t.instConfig.Stonith || t.instConfig.Topology != topology.Failover {
+		return
+	}
+	if !instStatus.Avail.Is(status.Up, status.Warn) {
+		return
+	}
+	t.log.Warnf("instance was %s on dropped peer %s, wait for its fencing before takeover", instStatus.Avail, peer)
+	t.stonithWait[peer] = true
+	if c, ok := t.stonithDone[peer]; ok {
+		delete(t.stonithDone, peer)
+		t.onNodeStonith(&c)
+	}
+}
+
+// onNodeStonith unblocks the ha takeover when the fencing of a peer the
+// instance was started on is confirmed or skipped. When the fencing
+// failed, the local instance monitor transitions to start failed, so the
+// takeover needs an operator clear.
+//
+// The result for a peer not yet waited for is recorded for
+// stonithWaitIfStarted.
+func (t *Manager) onNodeStonith(c *msgbus.NodeStonith) {
+	if !t.stonithWait[c.Peer] {
+		t.stonithDone[c.Peer] = *c
+		return
+	}
+	delete(t.stonithWait, c.Peer)
+	switch {
+	case c.ErrS != "":
+		t.log.Errorf("fencing of peer %s failed, the takeover needs a clear: %s", c.Peer, c.ErrS)
+		if t.state.State == instance.MonitorStateIdle {
+			t.transitionTo(instance.MonitorStateStartFailed)
+		}
+	case c.Skipped:
+		t.log.Warnf("fencing of peer %s skipped, the takeover is allowed", c.Peer)
+	default:
+		t.log.Infof("peer %s is fenced, the takeover is allowed", c.Peer)
+	}
+	t.onChange()
+}
+
+// stonithWaitPeers returns the sorted list of peers not yet confirmed
+// fenced.
+func (t *Manager) stonithWaitPeers() []string {
+	l := make([]string, 0, len(t.stonithWait))
+	for peer := range t.stonithWait {
+		l = append(l, peer)
+	}
+	sort.Strings(l)
+	return l
+}
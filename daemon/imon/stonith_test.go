@@ -0,0 +1,69 @@
+package imon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/core/topology"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/util/plog"
+)
+
+func TestOnNodeStonith(t *testing.T) {
+	cases := map[string]struct {
+		msg           msgbus.NodeStonith
+		expectedState instance.MonitorState
+	}{
+		"fenced peer allows the takeover": {
+			msg:           msgbus.NodeStonith{Node: "node1", Peer: "node2", Type: "cmd"},
+			expectedState: instance.MonitorStateIdle,
+		},
+		"peer without stonith driver allows the takeover": {
+			msg:           msgbus.NodeStonith{Node: "node1", Peer: "node2", Skipped: true},
+			expectedState: instance.MonitorStateIdle,
+		},
+		"failed fencing blocks the takeover": {
+			msg:           msgbus.NodeStonith{Node: "node1", Peer: "node2", Type: "cmd", ErrS: "exit code 1"},
+			expectedState: instance.MonitorStateStartFailed,
+		},
+		"fencing not attempted without quorum blocks the takeover": {
+			msg:           msgbus.NodeStonith{Node: "node1", Peer: "node2", Type: "cmd", ErrS: "not attempted, the cluster is split and cluster.quorum is false"},
+			expectedState: instance.MonitorStateStartFailed,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			for _, before := range []bool{false, true} {
+				ctx, cancel := context.WithCancel(context.Background())
+				// a done context disables the state publication
+				cancel()
+				m := &Manager{
+					ctx:         ctx,
+					log:         plog.NewDefaultLogger(),
+					localhost:   "node1",
+					instConfig:  instance.Config{Stonith: true, Topology: topology.Failover},
+					state:       instance.Monitor{State: instance.MonitorStateIdle},
+					stonithWait: make(map[string]bool),
+					stonithDone: make(map[string]msgbus.NodeStonith),
+					delayTimer:  time.NewTimer(time.Hour),
+				}
+				msg := tc.msg
+				if before {
+					m.onNodeStonith(&msg)
+					m.stonithWaitIfStarted("node2", instance.Status{Avail: status.Up})
+				} else {
+					m.stonithWaitIfStarted("node2", instance.Status{Avail: status.Up})
+					require.Equal(t, []string{"node2"}, m.stonithWaitPeers())
+					m.onNodeStonith(&msg)
+				}
+				require.Empty(t, m.stonithWaitPeers())
+				require.Equal(t, tc.expectedState, m.state.State)
+			}
+		})
+	}
+}
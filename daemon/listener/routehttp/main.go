@@ -15,9 +15,11 @@ import (
 	"github.com/labstack/echo-contrib/echoprometheus"
 	"github.com/labstack/echo-contrib/pprof"
 	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/opensvc/om3/daemon/api"
 	"github.com/opensvc/om3/daemon/daemonapi"
+	"github.com/opensvc/om3/daemon/daemonmetrics"
 )
 
 type (
@@ -30,6 +32,11 @@ var (
 	mwProm = echoprometheus.NewMiddleware("opensvc_api")
 )
 
+func init() {
+	// expose the daemondata states on /metrics, beside the api metrics
+	prometheus.MustRegister(daemonmetrics.New())
+}
+
 // New returns *T with log, rootDaemon
 // it prepares middlewares and routes for Opensvc daemon listeners
 // when enableUI is true swagger-ui is serverd from /ui
@@ -45,6 +52,7 @@ func New(ctx context.Context, enableUI bool) *T {
 	e.Use(daemonapi.AuthMiddleware(ctx))
 	e.Use(daemonapi.LogUserMiddleware(ctx))
 	e.Use(daemonapi.LogRequestMiddleWare(ctx))
+	e.Use(daemonapi.TraceMiddleware(ctx))
 	api.RegisterHandlers(e, daemonapi.New(ctx))
 	g := e.Group("/public/ui")
 	if enableUI {
@@ -0,0 +1,7 @@
+package msgbus
+
+func (data *ClusterData) onDaemonClusterSecretUpdated(m *DaemonClusterSecretUpdated) {
+	v := data.Cluster.Node[m.Node]
+	v.Daemon.ClusterSecret = m.Value
+	data.Cluster.Node[m.Node] = v
+}
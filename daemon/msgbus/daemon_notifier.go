@@ -0,0 +1,7 @@
+package msgbus
+
+func (data *ClusterData) onDaemonNotifierUpdated(m *DaemonNotifierUpdated) {
+	v := data.Cluster.Node[m.Node]
+	v.Daemon.Notifier = m.Value
+	data.Cluster.Node[m.Node] = v
+}
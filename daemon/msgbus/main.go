@@ -20,6 +20,8 @@ func (data *ClusterData) ApplyMessage(m pubsub.Messager) {
 		data.onClusterStatusUpdated(c)
 	case *ClusterConfigUpdated:
 		data.onClusterConfigUpdated(c)
+	case *DaemonClusterSecretUpdated:
+		data.onDaemonClusterSecretUpdated(c)
 	case *DaemonCollectorUpdated:
 		data.onDaemonCollector(c)
 	case *DaemonDataUpdated:
@@ -30,6 +32,8 @@ func (data *ClusterData) ApplyMessage(m pubsub.Messager) {
 		data.onDaemonHeartbeatUpdated(c)
 	case *DaemonListenerUpdated:
 		data.onDaemonListenerUpdated(c)
+	case *DaemonNotifierUpdated:
+		data.onDaemonNotifierUpdated(c)
 	case *DaemonRunnerImonUpdated:
 		data.onDaemonRunnerImonUpdated(c)
 	case *DaemonSchedulerUpdated:
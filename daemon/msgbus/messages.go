@@ -39,6 +39,8 @@ import (
 	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/core/node"
 	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/rebalance"
+	"github.com/opensvc/om3/core/schedule"
 	"github.com/opensvc/om3/daemon/daemonsubsystem"
 	"github.com/opensvc/om3/util/errcontext"
 	"github.com/opensvc/om3/util/pubsub"
@@ -51,6 +53,16 @@ var (
 
 		"ClusterConfigUpdated": func() any { return &ClusterConfigUpdated{} },
 
+		"ClusterRebalanceEnded": func() any { return &ClusterRebalanceEnded{} },
+
+		"ClusterRebalanceMoveEnded": func() any { return &ClusterRebalanceMoveEnded{} },
+
+		"ClusterRebalanceMoveStarted": func() any { return &ClusterRebalanceMoveStarted{} },
+
+		"ClusterRebalanceRequested": func() any { return &ClusterRebalanceRequested{} },
+
+		"ClusterRebalanceStarted": func() any { return &ClusterRebalanceStarted{} },
+
 		"ClusterStatusUpdated": func() any { return &ClusterStatusUpdated{} },
 
 		"ConfigFileRemoved": func() any { return &ConfigFileRemoved{} },
@@ -61,6 +73,8 @@ var (
 
 		"ClientUnsubscribed": func() any { return &ClientUnsubscribed{} },
 
+		"DaemonClusterSecretUpdated": func() any { return &DaemonClusterSecretUpdated{} },
+
 		"DaemonCollectorUpdated": func() any { return &DaemonCollectorUpdated{} },
 
 		"DaemonCtl": func() any { return &DaemonCtl{} },
@@ -73,6 +87,8 @@ var (
 
 		"DaemonListenerUpdated": func() any { return &DaemonListenerUpdated{} },
 
+		"DaemonNotifierUpdated": func() any { return &DaemonNotifierUpdated{} },
+
 		"DaemonRunnerImonUpdated": func() any { return &DaemonRunnerImonUpdated{} },
 
 		"DaemonSchedulerUpdated": func() any { return &DaemonSchedulerUpdated{} },
@@ -146,6 +162,8 @@ var (
 
 		"Log": func() any { return &Log{} },
 
+		"MaintenanceWindowBlocked": func() any { return &MaintenanceWindowBlocked{} },
+
 		"NodeConfigUpdated": func() any { return &NodeConfigUpdated{} },
 
 		"NodeDataUpdated": func() any { return &NodeDataUpdated{} },
@@ -172,6 +190,8 @@ var (
 
 		"NodeSplitAction": func() any { return &NodeSplitAction{} },
 
+		"NodeStonith": func() any { return &NodeStonith{} },
+
 		"NodeStatusUpdated": func() any { return &NodeStatusUpdated{} },
 
 		"ObjectCreated": func() any { return &ObjectCreated{} },
@@ -277,12 +297,68 @@ type (
 		NodesRemoved []string       `json:"nodes_removed" yaml:"nodes_removed"`
 	}
 
+	// ClusterRebalanceEnded is published by the rebalancer when all the
+	// moves of a rebalance plan are ended.
+	ClusterRebalanceEnded struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string    `json:"node" yaml:"node"`
+		ID         uuid.UUID `json:"id" yaml:"id"`
+		Done       int       `json:"done" yaml:"done"`
+		Failed     int       `json:"failed" yaml:"failed"`
+	}
+
+	// ClusterRebalanceMoveEnded is published by the rebalancer when a move
+	// of a rebalance plan is ended. ErrS is empty on success.
+	ClusterRebalanceMoveEnded struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string         `json:"node" yaml:"node"`
+		ID         uuid.UUID      `json:"id" yaml:"id"`
+		Move       rebalance.Move `json:"move" yaml:"move"`
+		ErrS       string         `json:"error,omitempty" yaml:"error,omitempty"`
+	}
+
+	// ClusterRebalanceMoveStarted is published by the rebalancer when a
+	// move of a rebalance plan is started.
+	ClusterRebalanceMoveStarted struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string         `json:"node" yaml:"node"`
+		ID         uuid.UUID      `json:"id" yaml:"id"`
+		Move       rebalance.Move `json:"move" yaml:"move"`
+	}
+
+	// ClusterRebalanceRequested is the message asking the rebalancer to
+	// apply a rebalance plan. Err is closed with an error if a rebalance is
+	// already running.
+	ClusterRebalanceRequested struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string                    `json:"node" yaml:"node"`
+		Plan       rebalance.Plan            `json:"plan" yaml:"plan"`
+		Err        errcontext.ErrCloseSender `json:"-" yaml:"-"`
+	}
+
+	// ClusterRebalanceStarted is published by the rebalancer when it starts
+	// to apply a rebalance plan.
+	ClusterRebalanceStarted struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string         `json:"node" yaml:"node"`
+		Plan       rebalance.Plan `json:"plan" yaml:"plan"`
+	}
+
 	ClusterStatusUpdated struct {
 		pubsub.Msg `yaml:",inline"`
 		Node       string             `json:"node" yaml:"node"`
 		Value      clusterdump.Status `json:"cluster_status" yaml:"cluster_status"`
 	}
 
+	// DaemonClusterSecretUpdated is published by the cluster secret
+	// subsystem on each cluster secret rotation step.
+	DaemonClusterSecretUpdated struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string `json:"node" yaml:"node"`
+
+		Value daemonsubsystem.ClusterSecret `json:"cluster_secret" yaml:"cluster_secret"`
+	}
+
 	DaemonCollectorUpdated struct {
 		pubsub.Msg `yaml:",inline"`
 		Node       string `json:"node" yaml:"node"`
@@ -323,6 +399,13 @@ type (
 		Value      daemonsubsystem.Listener `json:"listener" yaml:"listener"`
 	}
 
+	DaemonNotifierUpdated struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string `json:"node" yaml:"node"`
+
+		Value daemonsubsystem.Notifier `json:"notifier" yaml:"notifier"`
+	}
+
 	DaemonRunnerImonUpdated struct {
 		pubsub.Msg `yaml:",inline"`
 		Node       string `json:"node" yaml:"node"`
@@ -595,6 +678,23 @@ type (
 		Level      string `json:"level" yaml:"level"`
 	}
 
+	// MaintenanceWindowBlocked is published when an orchestration or a
+	// scheduled job is deferred until the end of a maintenance window.
+	MaintenanceWindowBlocked struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string `json:"node" yaml:"node"`
+
+		// Path is the object whose action is deferred. It is zero for
+		// the node scheduled jobs.
+		Path naming.Path `json:"path" yaml:"path"`
+
+		// Action is the deferred action, like "giveback", "ha start",
+		// "drain", "rebalance" or a scheduled job action.
+		Action string `json:"action" yaml:"action"`
+
+		Window schedule.Window `json:"window" yaml:"window"`
+	}
+
 	NodeConfigUpdated struct {
 		pubsub.Msg `yaml:",inline"`
 		Node       string      `json:"node" yaml:"node"`
@@ -661,6 +761,18 @@ type (
 		ProVoters       int    `json:"pro_voters" yaml:"pro_voters"`
 	}
 
+	// NodeStonith is published when the fencing of a lost peer node is done,
+	// or not attempted. ErrS is empty when the peer is confirmed fenced.
+	// Skipped is true when no stonith driver is configured for the peer.
+	NodeStonith struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string `json:"node" yaml:"node"`
+		Peer       string `json:"peer" yaml:"peer"`
+		Type       string `json:"type" yaml:"type"`
+		Skipped    bool   `json:"skipped,omitempty" yaml:"skipped,omitempty"`
+		ErrS       string `json:"error,omitempty" yaml:"error,omitempty"`
+	}
+
 	NodeStatsUpdated struct {
 		pubsub.Msg `yaml:",inline"`
 		Node       string     `json:"node" yaml:"node"`
@@ -844,6 +956,26 @@ func (e *ClusterConfigUpdated) Kind() string {
 	return "ClusterConfigUpdated"
 }
 
+func (e *ClusterRebalanceEnded) Kind() string {
+	return "ClusterRebalanceEnded"
+}
+
+func (e *ClusterRebalanceMoveEnded) Kind() string {
+	return "ClusterRebalanceMoveEnded"
+}
+
+func (e *ClusterRebalanceMoveStarted) Kind() string {
+	return "ClusterRebalanceMoveStarted"
+}
+
+func (e *ClusterRebalanceRequested) Kind() string {
+	return "ClusterRebalanceRequested"
+}
+
+func (e *ClusterRebalanceStarted) Kind() string {
+	return "ClusterRebalanceStarted"
+}
+
 func (e *ClusterStatusUpdated) Kind() string {
 	return "ClusterStatusUpdated"
 }
@@ -872,6 +1004,10 @@ func (e *ClientUnsubscribed) String() string {
 	return fmt.Sprintf("%s %s", e.Name, e.Time)
 }
 
+func (e *DaemonClusterSecretUpdated) Kind() string {
+	return "DaemonClusterSecretUpdated"
+}
+
 func (e *DaemonCollectorUpdated) Kind() string {
 	return "DaemonCollectorUpdated"
 }
@@ -896,6 +1032,10 @@ func (e *DaemonListenerUpdated) Kind() string {
 	return "DaemonListenerUpdated"
 }
 
+func (e *DaemonNotifierUpdated) Kind() string {
+	return "DaemonNotifierUpdated"
+}
+
 func (e *DaemonRunnerImonUpdated) Kind() string {
 	return "DaemonRunnerImonUpdated"
 }
@@ -1056,6 +1196,10 @@ func (e *Log) Kind() string {
 	return "Log"
 }
 
+func (e *MaintenanceWindowBlocked) Kind() string {
+	return "MaintenanceWindowBlocked"
+}
+
 func (e *NodeConfigUpdated) Kind() string {
 	return "NodeConfigUpdated"
 }
@@ -1092,6 +1236,10 @@ func (e *NodeSplitAction) Kind() string {
 	return "NodeSplitAction"
 }
 
+func (e *NodeStonith) Kind() string {
+	return "NodeStonith"
+}
+
 func (e *NodeStatsUpdated) Kind() string {
 	return "NodeStatsUpdated"
 }
@@ -1186,6 +1334,11 @@ func (e *ZoneRecordUpdated) Kind() string {
 	return "ZoneRecordUpdated"
 }
 
+func NewClusterRebalanceRequestedWithErr(ctx context.Context, nodename string, plan rebalance.Plan) (*ClusterRebalanceRequested, errcontext.ErrReceiver) {
+	err := errcontext.New(ctx)
+	return &ClusterRebalanceRequested{Node: nodename, Plan: plan, Err: err}, err
+}
+
 func NewSetInstanceMonitorWithErr(ctx context.Context, p naming.Path, nodename string, value instance.MonitorUpdate) (*SetInstanceMonitor, errcontext.ErrReceiver) {
 	err := errcontext.New(ctx)
 	return &SetInstanceMonitor{Path: p, Node: nodename, Value: value, Err: err}, err
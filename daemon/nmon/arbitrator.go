@@ -3,15 +3,18 @@ package nmon
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/opensvc/om3/core/cluster"
 	"github.com/opensvc/om3/core/node"
 	"github.com/opensvc/om3/core/status"
 	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/daemon/qdisk"
 	"github.com/opensvc/om3/util/key"
 )
 
@@ -20,17 +23,45 @@ type (
 		Name     string `json:"name"`
 		URI      string `json:"uri"`
 		Insecure bool
+
+		// qdisk is the lease of a qdisk arbitrator.
+		qdisk *qdisk.T
+
+		// cancel stops the qdisk lease renewal.
+		cancel context.CancelFunc
 	}
 )
 
+var (
+	// defaultQdiskLease is the qdisk arbitrator lease duration used when
+	// the lease keyword is not set.
+	defaultQdiskLease = 10 * time.Second
+)
+
 // setArbitratorConfig load config to sets arbitrators
 func (t *Manager) setArbitratorConfig() {
+	for _, a := range t.arbitrators {
+		if a.cancel != nil {
+			a.cancel()
+		}
+		if a.qdisk != nil {
+			_ = a.qdisk.Close()
+		}
+	}
 	arbitrators := make(map[string]arbitratorConfig)
 	for _, s := range t.config.SectionStrings() {
 		if !strings.HasPrefix(s, "arbitrator#") {
 			continue
 		}
 		name := strings.TrimPrefix(s, "arbitrator#")
+		if t.config.GetString(key.New(s, "type")) == "qdisk" {
+			if a, err := t.newQdiskArbitratorConfig(s, name); err != nil {
+				t.log.Warnf("ignored arbitrator %s: %s", s, err)
+			} else {
+				arbitrators[name] = a
+			}
+			continue
+		}
 		a := arbitratorConfig{
 			Name:     name,
 			URI:      t.config.GetString(key.New(s, "uri")),
@@ -49,18 +80,57 @@ func (t *Manager) setArbitratorConfig() {
 	t.arbitrators = arbitrators
 }
 
+// newQdiskArbitratorConfig returns the config of the qdisk arbitrator
+// defined in the <section> node.conf section, and starts its lease
+// renewal, so the lease is held between the arbitrator checks.
+func (t *Manager) newQdiskArbitratorConfig(section, name string) (arbitratorConfig, error) {
+	dev := t.config.GetString(key.New(section, "dev"))
+	if dev == "" {
+		return arbitratorConfig{}, fmt.Errorf("empty dev")
+	}
+	lease := defaultQdiskLease
+	if d := t.config.GetDuration(key.New(section, "lease")); d != nil && *d > 0 {
+		lease = *d
+	}
+	nodes := t.config.GetStrings(key.New("cluster", "nodes"))
+	log := t.log.Attr("arbitrator", name).WithPrefix(fmt.Sprintf("%sarbitrator#%s: ", t.log.Prefix(), name))
+	q := qdisk.New(dev, t.localhost, nodes, lease, log)
+	ctx, cancel := context.WithCancel(t.ctx)
+	go q.Renew(ctx)
+	return arbitratorConfig{
+		Name:   name,
+		URI:    dev,
+		qdisk:  q,
+		cancel: cancel,
+	}, nil
+}
+
 // getStatusArbitrators checks all arbitrators and returns result
 func (t *Manager) getStatusArbitrators() map[string]node.ArbitratorStatus {
 	type res struct {
-		name string
-		err  error
+		name  string
+		err   error
+		lease *node.ArbitratorLease
 	}
 	ctx, cancel := context.WithTimeout(t.ctx, arbitratorCheckDuration)
 	defer cancel()
 	c := make(chan res, len(t.arbitrators))
 	for _, a := range t.arbitrators {
 		go func(a arbitratorConfig) {
-			c <- res{name: a.Name, err: t.arbitratorCheck(ctx, a)}
+			if a.qdisk == nil {
+				c <- res{name: a.Name, err: t.arbitratorCheck(ctx, a)}
+				return
+			}
+			lease, err := a.qdisk.Acquire(ctx)
+			r := res{name: a.Name, err: err}
+			if lease.Holder != "" {
+				r.lease = &node.ArbitratorLease{
+					Holder:    lease.Holder,
+					TakenAt:   lease.TakenAt,
+					RenewedAt: lease.RenewedAt,
+				}
+			}
+			c <- r
 		}(a)
 	}
 	result := make(map[string]node.ArbitratorStatus)
@@ -69,7 +139,10 @@ func (t *Manager) getStatusArbitrators() map[string]node.ArbitratorStatus {
 		name := r.name
 		url := t.arbitrators[name].URI
 		aStatus := status.Up
-		if r.err != nil {
+		if errors.Is(r.err, qdisk.ErrHeld) {
+			t.log.Debugf("arbitrator#%s is down: %s", name, r.err)
+			aStatus = status.Down
+		} else if r.err != nil {
 			t.log.Warnf("arbitrator#%s is down", name)
 			t.log.Debugf("arbitrator#%s is down: %s", name, r.err)
 			aStatus = status.Down
@@ -79,7 +152,7 @@ func (t *Manager) getStatusArbitrators() map[string]node.ArbitratorStatus {
 				ErrS: r.err.Error(),
 			})
 		}
-		result[name] = node.ArbitratorStatus{URL: url, Status: aStatus}
+		result[name] = node.ArbitratorStatus{URL: url, Status: aStatus, Lease: r.lease}
 	}
 	return result
 }
@@ -78,6 +78,14 @@ type (
 		rejoinTicker *time.Ticker
 		startedAt    time.Time
 
+		// maintenanceWindowTimer fires on the next maintenance window boundary.
+		maintenanceWindowTimer *time.Timer
+
+		// maintenanceWindowBlocked is a map indexed by the deferred action names
+		// with the end of the maintenance window that deferred them. It
+		// avoids announcing the same deferral more than once per window.
+		maintenanceWindowBlocked map[string]time.Time
+
 		pendingCtx    context.Context
 		pendingCancel context.CancelFunc
 
@@ -168,6 +176,8 @@ func NewManager(drainDuration time.Duration, subQS pubsub.QueueSizer) *Manager {
 		localhost:   localhost,
 		change:      true,
 		nodeMonitor: make(map[string]node.Monitor),
+
+		maintenanceWindowBlocked: make(map[string]time.Time),
 		nodeStatus: node.Status{
 			Agent:    version.Version(),
 			FrozenAt: time.Now(), // ensure initial frozen
@@ -388,6 +398,7 @@ func (t *Manager) worker() {
 	t.updateIfChange()
 	defer t.bus.Pub(&msgbus.NodeMonitorDeleted{Node: t.localhost}, t.labelLocalhost)
 	defer node.MonitorData.Unset(t.localhost)
+	defer t.stopMaintenanceWindowTimer()
 
 	t.getAndUpdateStatusArbitrator()
 
@@ -452,6 +463,8 @@ func (t *Manager) worker() {
 			switch c := i.(type) {
 			case cmdOrchestrate:
 				t.onOrchestrate(c)
+			case cmdMaintenanceWindow:
+				t.onMaintenanceWindow()
 			}
 		case <-statsTicker.C:
 			t.updateStats()
@@ -546,7 +559,9 @@ func (t *Manager) clearPending() {
 }
 
 func (t *Manager) getStats() (node.Stats, error) {
-	stats := node.Stats{}
+	stats := node.Stats{
+		NumCPU: runtime.NumCPU(),
+	}
 	if runtime.GOOS != "linux" {
 		return stats, nil
 	}
@@ -687,6 +702,7 @@ func (t *Manager) loadAndPublishConfig() error {
 
 	node.ConfigData.Set(t.localhost, t.nodeConfig.DeepCopy())
 	t.bus.Pub(&msgbus.NodeConfigUpdated{Node: t.localhost, Value: t.nodeConfig}, t.labelLocalhost)
+	t.updateMaintenanceWindow()
 
 	localNodeInfo := t.cacheNodesInfo[t.localhost]
 	t.bus.Pub(&msgbus.NodeStatusLabelsUpdated{Node: t.localhost, Value: localNodeInfo.Labels.DeepCopy()}, t.labelLocalhost)
@@ -60,6 +60,7 @@ func (t *Manager) onConfigFileUpdated(_ *msgbus.ConfigFileUpdated) {
 		t.log.Errorf("load and publish config from node config file updated event: %s", err)
 		return
 	}
+	t.updateIfChange()
 
 	// env might have changed. nmon is responsible for updating nodes_info.json
 	t.saveNodesInfo()
@@ -70,7 +71,9 @@ func (t *Manager) onConfigFileUpdated(_ *msgbus.ConfigFileUpdated) {
 
 func (t *Manager) getNodeConfig() node.Config {
 	var (
+		keyConfigHistorySize      = key.New("node", "config_history_size")
 		keyMaintenanceGracePeriod = key.New("node", "maintenance_grace_period")
+		keyMaintenanceWindow      = key.New("node", "maintenance_window")
 		keyMaxParallel            = key.New("node", "max_parallel")
 		keyReadyPeriod            = key.New("node", "ready_period")
 		keyRejoinGracePeriod      = key.New("node", "rejoin_grace_period")
@@ -88,7 +91,9 @@ func (t *Manager) getNodeConfig() node.Config {
 		cfg.RejoinGracePeriod = *d
 	}
 	cfg.MaxParallel = t.config.GetInt(keyMaxParallel)
+	cfg.ConfigHistorySize = t.config.GetInt(keyConfigHistorySize)
 	cfg.Env = t.config.GetString(keyEnv)
+	cfg.MaintenanceWindow = t.config.GetString(keyMaintenanceWindow)
 	cfg.SplitAction = t.config.GetString(keySplitAction)
 
 	if cfg.MaxParallel == 0 {
@@ -243,10 +248,17 @@ func (t *Manager) onForgetPeer(c *msgbus.ForgetPeer) {
 
 	if len(t.livePeers) > len(t.clusterConfig.Nodes)/2 {
 		t.log.Infof("forget %s peer %s, we still have nodes quorum %d > %d", forgetType, c.Node, len(t.livePeers), len(t.clusterConfig.Nodes)/2)
+		if forgetType == "lost" {
+			t.stonith(c.Node)
+		}
 		return
 	}
 	if !t.clusterConfig.Quorum {
 		t.log.Warnf("cluster is split, ignore as cluster.quorum is false")
+		if forgetType == "lost" {
+			// Don't fence without quorum: both split segments would fence each other.
+			t.stonithNotAttempted(c.Node, "the cluster is split and cluster.quorum is false")
+		}
 		return
 	}
 	if t.frozen {
@@ -263,6 +275,9 @@ func (t *Manager) onForgetPeer(c *msgbus.ForgetPeer) {
 	}
 	if votes > total/2 {
 		t.log.Warnf("cluster is split, we have quorum: %d+%d out of %d votes (%s + %s)", len(t.livePeers), len(arbitratorVotes), total, livePeers, arbitratorVotes)
+		if forgetType == "lost" {
+			t.stonith(c.Node)
+		}
 		return
 	}
 	action := t.nodeConfig.SplitAction
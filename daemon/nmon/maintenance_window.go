@@ -0,0 +1,90 @@
+package nmon
+
+import (
+	"time"
+
+	"github.com/opensvc/om3/core/schedule"
+	"github.com/opensvc/om3/daemon/msgbus"
+)
+
+type (
+	// cmdMaintenanceWindow is sent by the maintenance window timer when
+	// a window opens or closes.
+	cmdMaintenanceWindow struct{}
+)
+
+// updateMaintenanceWindow evaluates the open or next window of the node
+// maintenance_window keyword, stores it in the node monitor and arms a timer
+// to re-evaluate the window on its next boundary.
+func (t *Manager) updateMaintenanceWindow() {
+	now := time.Now()
+	w, err := schedule.NextWindow(now, "node.maintenance_window", t.nodeConfig.MaintenanceWindow)
+	if err != nil {
+		t.log.Warnf("maintenance window: %s", err)
+	}
+
+	t.stopMaintenanceWindowTimer()
+
+	var next time.Time
+	switch {
+	case w.Begin.IsZero():
+		if t.state.MaintenanceWindow != nil {
+			t.state.MaintenanceWindow = nil
+			t.change = true
+		}
+		return
+	case w.IsOpen(now):
+		next = w.End
+	default:
+		next = w.Begin
+	}
+
+	if previous := t.state.MaintenanceWindow; previous == nil || previous.IsOpen(now) != w.IsOpen(now) || !previous.End.Equal(w.End) || previous.Schedule != w.Schedule {
+		if w.IsOpen(now) {
+			t.log.Infof("maintenance window %s open until %s", w.Schedule, w.End.Format(time.RFC3339))
+		}
+		t.state.MaintenanceWindow = &w
+		t.change = true
+	}
+
+	t.maintenanceWindowTimer = time.AfterFunc(time.Until(next), func() {
+		select {
+		case <-t.ctx.Done():
+		case t.cmdC <- cmdMaintenanceWindow{}:
+		}
+	})
+}
+
+func (t *Manager) stopMaintenanceWindowTimer() {
+	if t.maintenanceWindowTimer != nil {
+		t.maintenanceWindowTimer.Stop()
+		t.maintenanceWindowTimer = nil
+	}
+}
+
+func (t *Manager) onMaintenanceWindow() {
+	t.updateMaintenanceWindow()
+	if w := t.state.MaintenanceWindow; (w == nil || !w.IsOpen(time.Now())) && len(t.maintenanceWindowBlocked) > 0 {
+		t.maintenanceWindowBlocked = make(map[string]time.Time)
+		t.log.Infof("maintenance window closed, resume deferred orchestrations")
+		t.orchestrate()
+	}
+	t.updateIfChange()
+}
+
+// isDeferredByMaintenanceWindow returns true if the node maintenance window
+// is open. The first deferral of an action during a window is logged and
+// announced by a MaintenanceWindowBlocked event.
+func (t *Manager) isDeferredByMaintenanceWindow(action string) bool {
+	w := t.state.MaintenanceWindow
+	if w == nil || !w.IsOpen(time.Now()) {
+		return false
+	}
+	if end, ok := t.maintenanceWindowBlocked[action]; ok && end.Equal(w.End) {
+		return true
+	}
+	t.maintenanceWindowBlocked[action] = w.End
+	t.log.Infof("%s deferred by %s %s until %s", action, w.Key, w.Schedule, w.End.Format(time.RFC3339))
+	t.bus.Pub(&msgbus.MaintenanceWindowBlocked{Node: t.localhost, Action: action, Window: *w}, t.labelLocalhost)
+	return true
+}
@@ -12,6 +12,9 @@ import (
 func (t *Manager) orchestrateDrained() {
 	switch t.state.State {
 	case node.MonitorStateIdle:
+		if t.isDeferredByMaintenanceWindow("drain") {
+			return
+		}
 		t.drainFromIdle()
 	case node.MonitorStateFrozen:
 		t.drainFromFrozen()
@@ -0,0 +1,84 @@
+package nmon
+
+import (
+	"errors"
+	"time"
+
+	"github.com/opensvc/om3/core/stonith"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/util/key"
+)
+
+var (
+	// stonithAttempts is the maximum number of fencing attempts of a lost
+	// peer, before its failure is published.
+	stonithAttempts = 3
+
+	// stonithRetryDelay is the delay between two fencing attempts.
+	stonithRetryDelay = 10 * time.Second
+)
+
+// stonith fences the lost <peer> node using the driver configured in the
+// node.conf stonith#<peer> section. The fencing runs in a goroutine, and
+// its result is published as a NodeStonith message, so the imon of the
+// objects known started on the peer can take over.
+//
+// A NodeStonith message is always published, with Skipped set if no
+// stonith driver is configured for the peer, or with ErrS set if the
+// fencing still fails after stonithAttempts attempts. See also
+// stonithNotAttempted.
+func (t *Manager) stonith(peer string) {
+	drv, err := stonith.New(t.config, peer)
+	if errors.Is(err, stonith.ErrNotConfigured) {
+		t.log.Infof("stonith lost peer %s: skipped, no %s section", peer, stonith.SectionName(peer))
+		t.bus.Pub(&msgbus.NodeStonith{Node: t.localhost, Peer: peer, Skipped: true}, t.labelLocalhost)
+		return
+	}
+	typ := t.config.GetString(key.New(stonith.SectionName(peer), "type"))
+	msg := &msgbus.NodeStonith{Node: t.localhost, Peer: peer, Type: typ}
+	if err != nil {
+		t.log.Errorf("stonith lost peer %s: %s", peer, err)
+		msg.ErrS = err.Error()
+		t.bus.Pub(msg, t.labelLocalhost)
+		return
+	}
+	go func() {
+		defer t.bus.Pub(msg, t.labelLocalhost)
+		for attempt := 1; ; attempt++ {
+			t.log.Warnf("stonith lost peer %s using the %s driver (attempt %d/%d)", peer, typ, attempt, stonithAttempts)
+			err := drv.Fence(t.ctx)
+			if err == nil {
+				t.log.Warnf("stonith lost peer %s: fenced", peer)
+				msg.ErrS = ""
+				return
+			}
+			t.log.Errorf("stonith lost peer %s: %s", peer, err)
+			msg.ErrS = err.Error()
+			if attempt >= stonithAttempts {
+				return
+			}
+			select {
+			case <-t.ctx.Done():
+				return
+			case <-time.After(stonithRetryDelay):
+			}
+		}
+	}()
+}
+
+// stonithNotAttempted publishes the NodeStonith message of the lost <peer>
+// node, not fenced because of <reason>.
+//
+// If a stonith driver is configured for the peer, ErrS is set, so the
+// takeover of the objects started on the peer stays blocked until an
+// operator clear. Skipped is only set if no stonith driver is configured.
+func (t *Manager) stonithNotAttempted(peer, reason string) {
+	if _, err := stonith.New(t.config, peer); errors.Is(err, stonith.ErrNotConfigured) {
+		t.log.Infof("stonith lost peer %s: skipped, no %s section", peer, stonith.SectionName(peer))
+		t.bus.Pub(&msgbus.NodeStonith{Node: t.localhost, Peer: peer, Skipped: true}, t.labelLocalhost)
+		return
+	}
+	typ := t.config.GetString(key.New(stonith.SectionName(peer), "type"))
+	t.log.Errorf("stonith lost peer %s: not attempted, %s", peer, reason)
+	t.bus.Pub(&msgbus.NodeStonith{Node: t.localhost, Peer: peer, Type: typ, ErrS: "not attempted, " + reason}, t.labelLocalhost)
+}
@@ -0,0 +1,117 @@
+package notifier
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/opensvc/om3/core/xconfig"
+	"github.com/opensvc/om3/util/key"
+)
+
+type (
+	// notifierConfig is the configuration of a notify#N section.
+	notifierConfig struct {
+		Name     string
+		Type     string
+		Filters  []filter
+		Selector string
+
+		// webhook type
+		URL         string
+		Headers     http.Header
+		Insecure    bool
+		ContentType string
+
+		// command type
+		Command []string
+
+		Template    *template.Template
+		Timeout     time.Duration
+		Retries     int
+		RetryDelay  time.Duration
+		DedupWindow time.Duration
+		LeaderOnly  bool
+	}
+)
+
+// getNotifierConfigs returns the configurations of the notify#N sections
+// of config, ignoring and logging the invalid sections.
+func (t *T) getNotifierConfigs(config *xconfig.T) []notifierConfig {
+	l := make([]notifierConfig, 0)
+	for _, s := range config.SectionStrings() {
+		if !strings.HasPrefix(s, "notify#") {
+			continue
+		}
+		c, err := newNotifierConfig(config, s)
+		if err != nil {
+			t.log.Warnf("ignored %s: %s", s, err)
+			continue
+		}
+		l = append(l, c)
+	}
+	return l
+}
+
+func newNotifierConfig(config *xconfig.T, section string) (notifierConfig, error) {
+	getDuration := func(option string) time.Duration {
+		if d := config.GetDuration(key.New(section, option)); d != nil {
+			return *d
+		}
+		return 0
+	}
+	c := notifierConfig{
+		Name:        section,
+		Type:        config.GetString(key.New(section, "type")),
+		Selector:    config.GetString(key.New(section, "selector")),
+		Timeout:     getDuration("timeout"),
+		Retries:     config.GetInt(key.New(section, "retries")),
+		RetryDelay:  getDuration("retry_delay"),
+		DedupWindow: getDuration("dedup_window"),
+		LeaderOnly:  config.GetBool(key.New(section, "leader_only")),
+	}
+	switch c.Type {
+	case "webhook":
+		c.URL = config.GetString(key.New(section, "url"))
+		if c.URL == "" {
+			return c, fmt.Errorf("empty url")
+		}
+		c.Insecure = config.GetBool(key.New(section, "insecure"))
+		c.ContentType = config.GetString(key.New(section, "content_type"))
+		c.Headers = make(http.Header)
+		for _, s := range config.GetStrings(key.New(section, "headers")) {
+			name, value, ok := strings.Cut(s, "=")
+			if !ok || name == "" {
+				return c, fmt.Errorf("invalid header %s: expected <name>=<value>", s)
+			}
+			c.Headers.Add(name, value)
+		}
+	case "command":
+		c.Command = config.GetStrings(key.New(section, "command"))
+		if len(c.Command) == 0 {
+			return c, fmt.Errorf("empty command")
+		}
+	default:
+		return c, fmt.Errorf("invalid type '%s'", c.Type)
+	}
+	for _, s := range config.GetStrings(key.New(section, "events")) {
+		f, err := parseFilter(s)
+		if err != nil {
+			return c, err
+		}
+		c.Filters = append(c.Filters, f)
+	}
+	if len(c.Filters) == 0 {
+		return c, fmt.Errorf("empty events")
+	}
+	if s := config.GetString(key.New(section, "template")); s != "" {
+		tmpl, err := template.New(section).Parse(s)
+		if err != nil {
+			return c, fmt.Errorf("invalid template: %w", err)
+		}
+		c.Template = tmpl
+	}
+	return c, nil
+}
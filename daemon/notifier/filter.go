@@ -0,0 +1,123 @@
+package notifier
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+type (
+	// filter selects the events to notify.
+	filter struct {
+		// Kind is the event kind, like ObjectStatusUpdated
+		Kind string
+
+		// Labels are the label values the event must have
+		Labels []pubsub.Label
+
+		// Data are the data field values the event must have
+		Data []dataFilter
+	}
+
+	// dataFilter matches the value of an event data field, addressed by
+	// its json path.
+	dataFilter struct {
+		Path  []string
+		Value string
+	}
+)
+
+// parseFilter returns the filter described by s.
+//
+// filter syntax is: kind[,label=value][,.data.path=value]*
+func parseFilter(s string) (f filter, err error) {
+	l := strings.Split(s, ",")
+	f.Kind = l[0]
+	if f.Kind == "" {
+		return f, fmt.Errorf("invalid filter expression %s: missing event kind", s)
+	}
+	if _, err := msgbus.KindToT(f.Kind); err != nil {
+		return f, fmt.Errorf("invalid filter expression %s: %w", s, err)
+	}
+	for _, elem := range l[1:] {
+		splitted := strings.SplitN(elem, "=", 2)
+		if len(splitted) != 2 || splitted[0] == "" || splitted[0] == "." {
+			return f, fmt.Errorf("invalid filter expression %s: %s", s, elem)
+		}
+		if strings.HasPrefix(splitted[0], ".") {
+			f.Data = append(f.Data, dataFilter{
+				Path:  strings.Split(splitted[0][1:], "."),
+				Value: splitted[1],
+			})
+		} else {
+			f.Labels = append(f.Labels, pubsub.Label{splitted[0], splitted[1]})
+		}
+	}
+	return f, nil
+}
+
+// match returns true if the event of kind, with labels and json decoded
+// data, is selected by the filter.
+func (f filter) match(kind string, labels pubsub.Labels, data map[string]any) bool {
+	if kind != f.Kind {
+		return false
+	}
+	for _, label := range f.Labels {
+		if v, ok := labels[label[0]]; !ok || v != label[1] {
+			return false
+		}
+	}
+	for _, df := range f.Data {
+		if v, ok := df.lookup(data); !ok || v != df.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// key returns a string identifying the event as seen by the filter, so
+// two events with the same key are considered the same notification.
+//
+// The "from" label is ignored, so the same event received from the local
+// node and from a peer node has the same key.
+func (f filter) key(labels pubsub.Labels, data map[string]any) string {
+	l := make([]string, 0, len(labels)+len(f.Data))
+	for k, v := range labels {
+		if k == "from" {
+			continue
+		}
+		l = append(l, k+"="+v)
+	}
+	sort.Strings(l)
+	for _, df := range f.Data {
+		v, _ := df.lookup(data)
+		l = append(l, "."+strings.Join(df.Path, ".")+"="+v)
+	}
+	return f.Kind + "," + strings.Join(l, ",")
+}
+
+// lookup returns the string representation of the data field value
+// addressed by the filter path.
+func (df dataFilter) lookup(data map[string]any) (string, bool) {
+	var i any = data
+	for _, k := range df.Path {
+		m, ok := i.(map[string]any)
+		if !ok {
+			return "", false
+		}
+		if i, ok = m[k]; !ok {
+			return "", false
+		}
+	}
+	switch v := i.(type) {
+	case map[string]any, []any:
+		return "", false
+	case nil:
+		return "", true
+	default:
+		return fmt.Sprint(v), true
+	}
+}
@@ -0,0 +1,349 @@
+// Package notifier sends notifications on daemon events.
+//
+// The notifiers are defined by the notify#N sections of the cluster
+// config. Each notifier subscribes to the daemon events selected by its
+// events filters, optionally restricted to the objects matching its
+// selector, and sends a notification per event:
+//
+//	webhook: a http POST request of the rendered notification to the url
+//	command: the execution of a local command fed the rendered
+//	         notification on stdin
+//
+// A failed delivery is retried with an exponential backoff delay. A
+// notification identical to a notification already sent during the dedup
+// window is not sent again.
+//
+// The notifiers delivery status are published with the
+// DaemonNotifierUpdated messages, and displayed by om daemon status.
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/core/event"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/objectselector"
+	"github.com/opensvc/om3/daemon/daemonsubsystem"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/plog"
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+type (
+	T struct {
+		ctx    context.Context
+		cancel context.CancelFunc
+		bus    *pubsub.Bus
+		log    *plog.Logger
+
+		sub   *pubsub.Subscription
+		subQS pubsub.QueueSizer
+
+		wg        sync.WaitGroup
+		localhost string
+
+		senders       []*sender
+		sendersCancel context.CancelFunc
+		sendersWg     sync.WaitGroup
+
+		status   daemonsubsystem.Notifier
+		updatedC chan struct{}
+	}
+)
+
+var (
+	// pruneInterval is the interval between the dedup caches pruning.
+	pruneInterval = time.Minute
+
+	// publishInterval is the minimum interval between the status
+	// publications.
+	publishInterval = time.Second
+)
+
+func New(subQS pubsub.QueueSizer) *T {
+	return &T{
+		localhost: hostname.Hostname(),
+		log: plog.NewDefaultLogger().
+			Attr("pkg", "daemon/notifier").
+			WithPrefix("daemon: notifier: "),
+		subQS:    subQS,
+		updatedC: make(chan struct{}, 1),
+	}
+}
+
+// Start launches the notifier worker goroutine
+func (t *T) Start(parent context.Context) error {
+	t.log.Infof("starting")
+	t.ctx, t.cancel = context.WithCancel(parent)
+	t.bus = pubsub.BusFromContext(t.ctx)
+	now := time.Now()
+	t.status = daemonsubsystem.Notifier{
+		Status: daemonsubsystem.Status{
+			ID:        "notifier",
+			CreatedAt: now,
+		},
+		Notifiers: make([]daemonsubsystem.NotifierStatus, 0),
+	}
+	t.loadConfig()
+
+	t.wg.Add(1)
+	go func() {
+		defer func() {
+			t.stopSenders()
+			if err := t.sub.Stop(); err != nil && !errors.Is(err, context.Canceled) {
+				t.log.Warnf("subscription stop: %s", err)
+			}
+			t.wg.Done()
+			t.log.Infof("stopped")
+		}()
+		t.log.Infof("started")
+		t.worker()
+	}()
+	return nil
+}
+
+func (t *T) Stop() error {
+	t.cancel()
+	t.wg.Wait()
+	return nil
+}
+
+// loadConfig replaces the senders and the subscription with the ones
+// defined by the current cluster config.
+func (t *T) loadConfig() {
+	var configs []notifierConfig
+	if n, err := object.NewNode(object.WithVolatile(true)); err != nil {
+		t.log.Errorf("load config: %s", err)
+	} else {
+		configs = t.getNotifierConfigs(n.MergedConfig())
+	}
+
+	t.stopSenders()
+	ctx, cancel := context.WithCancel(t.ctx)
+	t.sendersCancel = cancel
+	t.senders = make([]*sender, 0, len(configs))
+	for _, c := range configs {
+		s := newSender(c, t.log, t.updatedC)
+		t.senders = append(t.senders, s)
+		t.sendersWg.Add(1)
+		go func(s *sender) {
+			defer t.sendersWg.Done()
+			s.run(ctx)
+		}(s)
+	}
+	t.log.Infof("loaded %d notifiers", len(t.senders))
+
+	// create the new subscription before stopping the previous one, so no
+	// config update is missed.
+	sub := t.bus.Sub("daemon.notifier", t.subQS)
+	labelLocalhost := pubsub.Label{"node", t.localhost}
+	// Reminder: NodeConfigUpdated is fired on ClusterConfigUpdated
+	sub.AddFilter(&msgbus.NodeConfigUpdated{}, labelLocalhost)
+	filterM := make(map[string]any)
+	for _, s := range t.senders {
+		for _, f := range s.config.Filters {
+			filterKey := pubsub.FilterFmt(f.Kind, f.Labels...)
+			if _, ok := filterM[filterKey]; ok {
+				continue
+			}
+			filterM[filterKey] = nil
+			kind, _ := msgbus.KindToT(f.Kind)
+			sub.AddFilter(kind, f.Labels...)
+		}
+	}
+	sub.Start()
+	if t.sub != nil {
+		if err := t.sub.Stop(); err != nil && !errors.Is(err, context.Canceled) {
+			t.log.Warnf("subscription stop: %s", err)
+		}
+	}
+	t.sub = sub
+
+	if len(t.senders) > 0 {
+		t.status.State = "running"
+	} else {
+		t.status.State = "disabled"
+	}
+	t.status.ConfiguredAt = time.Now()
+	t.publish()
+}
+
+// stopSenders stops the sender goroutines, dropping their pending
+// notifications.
+func (t *T) stopSenders() {
+	if t.sendersCancel == nil {
+		return
+	}
+	t.sendersCancel()
+	t.sendersWg.Wait()
+	t.sendersCancel = nil
+	for _, s := range t.senders {
+		if pending := len(s.queue); pending > 0 {
+			t.log.Infof("%s: dropped %d pending notifications", s.config.Name, pending)
+		}
+	}
+}
+
+func (t *T) worker() {
+	defer t.log.Debugf("done")
+	pruneTicker := time.NewTicker(pruneInterval)
+	defer pruneTicker.Stop()
+	publishTicker := time.NewTicker(publishInterval)
+	defer publishTicker.Stop()
+	var updated bool
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-pruneTicker.C:
+			for _, s := range t.senders {
+				s.pruneDedup()
+			}
+		case <-t.updatedC:
+			updated = true
+		case <-publishTicker.C:
+			if updated {
+				updated = false
+				t.publish()
+			}
+		case i := <-t.sub.C:
+			switch c := i.(type) {
+			case *msgbus.NodeConfigUpdated:
+				t.onNodeConfigUpdated(c)
+			default:
+				t.onEvent(i)
+			}
+		}
+	}
+}
+
+func (t *T) onNodeConfigUpdated(c *msgbus.NodeConfigUpdated) {
+	t.log.Infof("reload config")
+	t.loadConfig()
+}
+
+// onEvent queues a notification of the event i for each notifier
+// selecting it.
+func (t *T) onEvent(i any) {
+	msg, ok := i.(pubsub.Messager)
+	if !ok {
+		return
+	}
+	kinder, ok := i.(event.Kinder)
+	if !ok {
+		return
+	}
+	kind := kinder.Kind()
+	labels := msg.GetLabels()
+
+	var (
+		data     map[string]any
+		isLeader *bool
+		now      = time.Now()
+	)
+	for _, s := range t.senders {
+		f, ok := s.match(kind, labels, func() map[string]any {
+			if data == nil {
+				data = make(map[string]any)
+				if b, err := json.Marshal(i); err != nil {
+					t.log.Debugf("%s: marshal: %s", kind, err)
+				} else if err := json.Unmarshal(b, &data); err != nil {
+					t.log.Debugf("%s: unmarshal: %s", kind, err)
+				}
+			}
+			return data
+		})
+		if !ok {
+			continue
+		}
+		if s.config.LeaderOnly {
+			if isLeader == nil {
+				v := t.isLeader()
+				isLeader = &v
+			}
+			if !*isLeader {
+				continue
+			}
+		}
+		n := Notification{
+			ID:     s.config.Name,
+			Kind:   kind,
+			Node:   labels["node"],
+			Path:   labels["path"],
+			Labels: labels,
+			At:     now,
+			Data:   data,
+		}
+		if n.Node == "" {
+			n.Node, _ = data["node"].(string)
+		}
+		if n.Path == "" {
+			n.Path, _ = data["path"].(string)
+		}
+		if !t.selected(s.config.Selector, n.Path) {
+			continue
+		}
+		s.enqueue(n, f.key(labels, data))
+	}
+}
+
+// match returns the first filter of the sender config selecting the
+// event. The data getter is called only if a filter needs the event data.
+func (s *sender) match(kind string, labels pubsub.Labels, getData func() map[string]any) (filter, bool) {
+	for _, f := range s.config.Filters {
+		if f.Kind != kind {
+			continue
+		}
+		if f.match(kind, labels, getData()) {
+			return f, true
+		}
+	}
+	return filter{}, false
+}
+
+// selected returns true if the object path p matches the selector. The
+// events not related to an object are always selected.
+func (t *T) selected(selector, p string) bool {
+	if selector == "" || p == "" {
+		return true
+	}
+	path, err := naming.ParsePath(p)
+	if err != nil {
+		return false
+	}
+	paths, err := objectselector.New(
+		selector,
+		objectselector.WithPaths(naming.Paths{path}),
+		objectselector.WithLocal(true),
+		objectselector.WithConfigFilterDisabled(),
+	).Expand()
+	if err != nil {
+		t.log.Debugf("selector %s: %s", selector, err)
+		return false
+	}
+	return len(paths) > 0
+}
+
+func (t *T) isLeader() bool {
+	if nodeStatus := node.StatusData.Get(t.localhost); nodeStatus != nil {
+		return nodeStatus.IsLeader
+	}
+	return false
+}
+
+func (t *T) publish() {
+	t.status.Notifiers = make([]daemonsubsystem.NotifierStatus, len(t.senders))
+	for i, s := range t.senders {
+		t.status.Notifiers[i] = s.getStatus()
+	}
+	t.status.UpdatedAt = time.Now()
+	daemonsubsystem.DataNotifier.Set(t.localhost, t.status.DeepCopy())
+	t.bus.Pub(&msgbus.DaemonNotifierUpdated{Node: t.localhost, Value: *t.status.DeepCopy()}, pubsub.Label{"node", t.localhost})
+}
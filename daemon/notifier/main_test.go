@@ -0,0 +1,198 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/util/plog"
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+func TestParseFilter(t *testing.T) {
+	cases := map[string]struct {
+		s         string
+		expected  filter
+		expectErr bool
+	}{
+		"kind only": {
+			s:        "NodeSplitAction",
+			expected: filter{Kind: "NodeSplitAction"},
+		},
+		"labels and data": {
+			s: "ObjectStatusUpdated,path=foo,.object_status.avail=down",
+			expected: filter{
+				Kind:   "ObjectStatusUpdated",
+				Labels: []pubsub.Label{{"path", "foo"}},
+				Data:   []dataFilter{{Path: []string{"object_status", "avail"}, Value: "down"}},
+			},
+		},
+		"empty kind": {
+			s:         ",path=foo",
+			expectErr: true,
+		},
+		"unknown kind": {
+			s:         "FooBar",
+			expectErr: true,
+		},
+		"invalid element": {
+			s:         "ObjectStatusUpdated,path",
+			expectErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			f, err := parseFilter(tc.s)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, f)
+		})
+	}
+}
+
+func TestFilterMatch(t *testing.T) {
+	f, err := parseFilter("ObjectStatusUpdated,path=foo,.object_status.avail=down")
+	require.NoError(t, err)
+
+	data := func(avail string) map[string]any {
+		return map[string]any{"object_status": map[string]any{"avail": avail}}
+	}
+	labels := pubsub.Labels{"path": "foo", "node": "n1"}
+
+	assert.True(t, f.match("ObjectStatusUpdated", labels, data("down")))
+	assert.False(t, f.match("ObjectStatusUpdated", labels, data("up")), "data mismatch")
+	assert.False(t, f.match("ObjectStatusUpdated", pubsub.Labels{"path": "bar"}, data("down")), "label mismatch")
+	assert.False(t, f.match("ObjectStatusDeleted", labels, data("down")), "kind mismatch")
+	assert.False(t, f.match("ObjectStatusUpdated", labels, map[string]any{}), "missing data")
+
+	t.Run("key", func(t *testing.T) {
+		k := f.key(labels, data("down"))
+		assert.Equal(t, k, f.key(pubsub.Labels{"node": "n1", "path": "foo", "from": "peer"}, data("down")),
+			"the from label is ignored")
+		assert.NotEqual(t, k, f.key(pubsub.Labels{"node": "n2", "path": "foo"}, data("down")))
+		assert.NotEqual(t, k, f.key(labels, data("up")))
+	})
+}
+
+func TestSenderDedup(t *testing.T) {
+	s := newSender(notifierConfig{Name: "notify#1", DedupWindow: time.Minute}, plog.NewDefaultLogger(), make(chan struct{}, 1))
+	n := Notification{ID: "notify#1", Kind: "NodeSplitAction"}
+
+	s.enqueue(n, "a")
+	s.enqueue(n, "a")
+	s.enqueue(n, "b")
+	status := s.getStatus()
+	assert.Equal(t, 2, status.Pending)
+	assert.Equal(t, uint64(1), status.Deduplicated)
+
+	s.dedup["a"] = time.Now().Add(-time.Second)
+	s.pruneDedup()
+	assert.NotContains(t, s.dedup, "a")
+	assert.Contains(t, s.dedup, "b")
+
+	t.Run("full queue", func(t *testing.T) {
+		s := newSender(notifierConfig{Name: "notify#1"}, plog.NewDefaultLogger(), make(chan struct{}, 1))
+		for i := 0; i < queueSize+1; i++ {
+			s.enqueue(n, "a")
+		}
+		status := s.getStatus()
+		assert.Equal(t, queueSize, status.Pending)
+		assert.Equal(t, uint64(1), status.Dropped)
+		assert.Equal(t, uint64(0), status.Deduplicated, "dedup window 0 disables the deduplication")
+	})
+}
+
+func TestSenderRender(t *testing.T) {
+	n := Notification{
+		ID:   "notify#1",
+		Kind: "ObjectStatusUpdated",
+		Node: "n1",
+		Path: "foo",
+		Data: map[string]any{"object_status": map[string]any{"avail": "down"}},
+	}
+
+	s := newSender(notifierConfig{}, plog.NewDefaultLogger(), nil)
+	b, err := s.render(n)
+	require.NoError(t, err)
+	var decoded Notification
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, n.Kind, decoded.Kind)
+	assert.Equal(t, n.Data, decoded.Data)
+
+	s.config.Template = template.Must(template.New("").Parse(`{{.Path}} is {{.Data.object_status.avail}} on {{.Node}}`))
+	b, err = s.render(n)
+	require.NoError(t, err)
+	assert.Equal(t, "foo is down on n1", string(b))
+}
+
+func TestSenderWebhookRetry(t *testing.T) {
+	var calls atomic.Int32
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		assert.Equal(t, "bar", r.Header.Get("X-Foo"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	config := notifierConfig{
+		Name:        "notify#1",
+		Type:        "webhook",
+		URL:         server.URL,
+		Headers:     http.Header{"X-Foo": []string{"bar"}},
+		ContentType: "application/json",
+		Timeout:     time.Second,
+		Retries:     2,
+		RetryDelay:  time.Millisecond,
+	}
+	n := Notification{ID: "notify#1", Kind: "NodeSplitAction"}
+
+	s := newSender(config, plog.NewDefaultLogger(), nil)
+	require.NoError(t, s.deliver(context.Background(), n))
+	assert.Equal(t, int32(3), calls.Load())
+	assert.Contains(t, string(body), `"kind":"NodeSplitAction"`)
+
+	calls.Store(0)
+	s.config.Retries = 1
+	require.Error(t, s.deliver(context.Background(), n))
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestSenderCommand(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "out")
+	config := notifierConfig{
+		Name:    "notify#1",
+		Type:    "command",
+		Command: []string{"sh", "-c", `cat >` + out + `; echo "$OSVC_NOTIFY_KIND" >>` + out},
+		Timeout: 5 * time.Second,
+	}
+	s := newSender(config, plog.NewDefaultLogger(), nil)
+	s.config.Template = template.Must(template.New("").Parse(`{{.Path}}`))
+	require.NoError(t, s.deliver(context.Background(), Notification{Kind: "ObjectStatusUpdated", Path: "foo"}))
+	b, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, "fooObjectStatusUpdated\n", string(b))
+
+	s.config.Command = []string{"sh", "-c", "echo oops >&2; exit 1"}
+	err = s.deliver(context.Background(), Notification{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "oops")
+}
@@ -0,0 +1,269 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/daemon/daemonsubsystem"
+	"github.com/opensvc/om3/util/plog"
+)
+
+type (
+	// Notification is the data sent by a notifier. It is the template data,
+	// and the json formatted body when no template is configured.
+	Notification struct {
+		// ID is the name of the notify#N section sending the notification
+		ID     string            `json:"id"`
+		Kind   string            `json:"kind"`
+		Node   string            `json:"node,omitempty"`
+		Path   string            `json:"path,omitempty"`
+		Labels map[string]string `json:"labels"`
+		At     time.Time         `json:"at"`
+		Data   map[string]any    `json:"data"`
+	}
+
+	// sender delivers the notifications of a notify#N section.
+	sender struct {
+		config notifierConfig
+		log    *plog.Logger
+		queue  chan Notification
+		client *http.Client
+
+		// dedup holds the notification keys sent during the dedup window,
+		// with their expire time. It is only accessed by the notifier
+		// worker.
+		dedup map[string]time.Time
+
+		// updatedC is signaled when the status changes
+		updatedC chan<- struct{}
+
+		mu     sync.RWMutex
+		status daemonsubsystem.NotifierStatus
+	}
+)
+
+var (
+	// queueSize is the maximum number of notifications waiting for
+	// delivery per notifier. The new notifications are dropped when the
+	// queue is full.
+	queueSize = 100
+)
+
+func newSender(config notifierConfig, log *plog.Logger, updatedC chan<- struct{}) *sender {
+	now := time.Now()
+	s := &sender{
+		config:   config,
+		log:      log.WithPrefix(log.Prefix() + config.Name + ": "),
+		queue:    make(chan Notification, queueSize),
+		dedup:    make(map[string]time.Time),
+		updatedC: updatedC,
+		status: daemonsubsystem.NotifierStatus{
+			Status: daemonsubsystem.Status{
+				ID:           config.Name,
+				State:        "idle",
+				ConfiguredAt: now,
+				CreatedAt:    now,
+				UpdatedAt:    now,
+			},
+			Type: config.Type,
+		},
+	}
+	if config.Type == "webhook" {
+		s.client = &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: config.Insecure},
+			},
+		}
+	}
+	return s
+}
+
+// run delivers the queued notifications until ctx is done.
+func (s *sender) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case n := <-s.queue:
+			err := s.deliver(ctx, n)
+			if ctx.Err() != nil {
+				return
+			}
+			s.update(func(status *daemonsubsystem.NotifierStatus) {
+				now := time.Now()
+				if err != nil {
+					status.State = "failed"
+					status.Failed++
+					status.LastError = err.Error()
+					status.LastErrorAt = now
+				} else {
+					status.State = "ok"
+					status.Sent++
+					status.LastSentAt = now
+				}
+			})
+			if err != nil {
+				s.log.Warnf("%s notification failed: %s", n.Kind, err)
+			} else {
+				s.log.Debugf("%s notification sent", n.Kind)
+			}
+		}
+	}
+}
+
+// enqueue queues n for delivery, unless n was already sent during the
+// dedup window, or the queue is full.
+func (s *sender) enqueue(n Notification, dedupKey string) {
+	now := time.Now()
+	if s.config.DedupWindow > 0 {
+		if expireAt, ok := s.dedup[dedupKey]; ok && now.Before(expireAt) {
+			s.update(func(status *daemonsubsystem.NotifierStatus) {
+				status.Deduplicated++
+			})
+			return
+		}
+	}
+	select {
+	case s.queue <- n:
+		if s.config.DedupWindow > 0 {
+			s.dedup[dedupKey] = now.Add(s.config.DedupWindow)
+		}
+		s.update(func(status *daemonsubsystem.NotifierStatus) {})
+	default:
+		s.log.Warnf("%s notification dropped: queue is full", n.Kind)
+		s.update(func(status *daemonsubsystem.NotifierStatus) {
+			status.Dropped++
+		})
+	}
+}
+
+// pruneDedup removes the expired keys from the dedup cache.
+func (s *sender) pruneDedup() {
+	now := time.Now()
+	for k, expireAt := range s.dedup {
+		if now.After(expireAt) {
+			delete(s.dedup, k)
+		}
+	}
+}
+
+func (s *sender) update(f func(*daemonsubsystem.NotifierStatus)) {
+	s.mu.Lock()
+	f(&s.status)
+	s.status.UpdatedAt = time.Now()
+	s.mu.Unlock()
+	select {
+	case s.updatedC <- struct{}{}:
+	default:
+	}
+}
+
+func (s *sender) getStatus() daemonsubsystem.NotifierStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	status := s.status
+	status.Pending = len(s.queue)
+	return status
+}
+
+// deliver sends n, retrying on failure with an exponential backoff delay.
+func (s *sender) deliver(ctx context.Context, n Notification) error {
+	body, err := s.render(n)
+	if err != nil {
+		return err
+	}
+	delay := s.config.RetryDelay
+	for i := 0; ; i++ {
+		err = s.send(ctx, n, body)
+		if err == nil || i >= s.config.Retries {
+			return err
+		}
+		s.log.Debugf("%s notification attempt %d failed: %s, retry in %s", n.Kind, i+1, err, delay)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// render returns the notification body: the json formatted notification,
+// or the notifier template executed with the notification as data.
+func (s *sender) render(n Notification) ([]byte, error) {
+	if s.config.Template == nil {
+		return json.Marshal(n)
+	}
+	var buf bytes.Buffer
+	if err := s.config.Template.Execute(&buf, n); err != nil {
+		return nil, fmt.Errorf("template: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (s *sender) send(ctx context.Context, n Notification, body []byte) error {
+	if s.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.config.Timeout)
+		defer cancel()
+	}
+	switch s.config.Type {
+	case "webhook":
+		return s.sendWebhook(ctx, body)
+	case "command":
+		return s.sendCommand(ctx, n, body)
+	default:
+		return fmt.Errorf("unsupported type %s", s.config.Type)
+	}
+}
+
+func (s *sender) sendWebhook(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, l := range s.config.Headers {
+		req.Header[k] = l
+	}
+	if s.config.ContentType != "" {
+		req.Header.Set("Content-Type", s.config.ContentType)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (s *sender) sendCommand(ctx context.Context, n Notification, body []byte) error {
+	cmd := exec.CommandContext(ctx, s.config.Command[0], s.config.Command[1:]...)
+	cmd.Stdin = bytes.NewReader(body)
+	cmd.Env = append(os.Environ(),
+		"OSVC_NOTIFY_ID="+n.ID,
+		"OSVC_NOTIFY_KIND="+n.Kind,
+		"OSVC_NOTIFY_NODE="+n.Node,
+		"OSVC_NOTIFY_PATH="+n.Path,
+	)
+	if b, err := cmd.CombinedOutput(); err != nil {
+		if out := strings.TrimSpace(string(b)); out != "" {
+			return fmt.Errorf("%w: %s", err, out)
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+/*
+Package qdisk implements a quorum device lease on a shared block device.
+
+The device is sliced with the hb disk layout. Each node stores its lease
+claim in its data slot. A claim is valid while it is renewed more recently
+than the lease duration ago. The lease holder is the node with the oldest
+valid claim, the node name breaking ties. So, when nodes of a split
+cluster claim a free lease concurrently, all of them elect the same holder
+after the claims settle.
+
+The claims validity is evaluated from the writer and reader clocks, so the
+node clocks must be synchronized.
+
+Renew keeps the lease held between the arbitrator checks, so the holder
+of a healthy cluster is stable and still holds the lease at split time.
+*/
+package qdisk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/daemon/hb/hbdisk"
+	"github.com/opensvc/om3/util/plog"
+)
+
+type (
+	T struct {
+		// mu serializes the device accesses of Acquire, Status and Renew.
+		mu sync.Mutex
+
+		nodename string
+		nodes    []string
+		lease    time.Duration
+		disk     *hbdisk.Disk
+		log      *plog.Logger
+	}
+
+	// Lease describes the holder of the qdisk lease.
+	Lease struct {
+		// Holder is the name of the node holding the lease. It is empty
+		// if the lease is free.
+		Holder string
+
+		// TakenAt is the time the holder took the lease.
+		TakenAt time.Time
+
+		// RenewedAt is the time the holder last renewed the lease.
+		RenewedAt time.Time
+	}
+
+	claim struct {
+		Node    string    `json:"node"`
+		TakenAt time.Time `json:"taken_at"`
+	}
+)
+
+var (
+	// ErrHeld is returned by Acquire when the lease is held by another node.
+	ErrHeld = errors.New("lease held by peer")
+
+	// SettleDelay is the duration to wait after a lease claim, so the
+	// concurrent claims of other nodes are written before the holder is
+	// elected.
+	SettleDelay = 500 * time.Millisecond
+)
+
+// New returns a qdisk lease on the device <path>, shared by <nodes>,
+// claimed as <nodename>.
+func New(path, nodename string, nodes []string, lease time.Duration, log *plog.Logger) *T {
+	return &T{
+		nodename: nodename,
+		nodes:    nodes,
+		lease:    lease,
+		disk:     hbdisk.NewDisk(path, log),
+		log:      log,
+	}
+}
+
+// Close closes the device.
+func (t *T) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.disk.Close()
+}
+
+// RenewInterval returns the interval between two lease renewals, a third
+// of the lease duration, so a renewal can fail once without losing the
+// lease.
+func (t *T) RenewInterval() time.Duration {
+	return t.lease / 3
+}
+
+// Renew acquires the lease every RenewInterval until <ctx> is done. The
+// lease is renewed if held by the local node, or taken if free.
+func (t *T) Renew(ctx context.Context) {
+	ticker := time.NewTicker(t.RenewInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if _, err := t.Acquire(ctx); err != nil && !errors.Is(err, ErrHeld) && ctx.Err() == nil {
+			t.log.Warnf("renew lease: %s", err)
+		}
+	}
+}
+
+// Status returns the current lease holder, without claiming the lease.
+func (t *T) Status(ctx context.Context) (Lease, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err := t.open(); err != nil {
+		return Lease{}, err
+	}
+	return t.holder(time.Now())
+}
+
+// Acquire renews the lease if held by the local node, or takes it if free.
+// It returns ErrHeld if the lease is held by another node.
+func (t *T) Acquire(ctx context.Context) (Lease, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err := t.open(); err != nil {
+		return Lease{}, err
+	}
+	lease, err := t.holder(time.Now())
+	if err != nil {
+		return lease, err
+	}
+	switch lease.Holder {
+	case t.nodename:
+		if err := t.write(lease.TakenAt); err != nil {
+			return lease, err
+		}
+		lease.RenewedAt = time.Now()
+		return lease, nil
+	case "":
+	default:
+		return lease, fmt.Errorf("%w %s", ErrHeld, lease.Holder)
+	}
+	if err := t.write(time.Now()); err != nil {
+		return lease, err
+	}
+	select {
+	case <-ctx.Done():
+		return lease, ctx.Err()
+	case <-time.After(SettleDelay):
+	}
+	lease, err = t.holder(time.Now())
+	if err != nil {
+		return lease, err
+	}
+	if lease.Holder != t.nodename {
+		return lease, fmt.Errorf("%w %s", ErrHeld, lease.Holder)
+	}
+	t.log.Infof("lease taken")
+	return lease, nil
+}
+
+func (t *T) open() error {
+	if t.disk.IsOpen() {
+		return nil
+	}
+	return t.disk.Open(t.nodes)
+}
+
+func (t *T) write(takenAt time.Time) error {
+	b, err := json.Marshal(claim{Node: t.nodename, TakenAt: takenAt})
+	if err != nil {
+		return err
+	}
+	if err := t.disk.Write(t.nodename, b); err != nil {
+		// reopen the device on next call
+		_ = t.disk.Close()
+		return err
+	}
+	return nil
+}
+
+// holder reads the node claims and returns the lease elected from the
+// claims valid at <now>.
+func (t *T) holder(now time.Time) (Lease, error) {
+	var lease Lease
+	for _, nodename := range t.nodes {
+		b, renewedAt, err := t.disk.Read(nodename)
+		var syntaxError *json.SyntaxError
+		if errors.As(err, &syntaxError) {
+			// never written slot
+			continue
+		} else if err != nil {
+			// reopen the device on next call
+			_ = t.disk.Close()
+			return lease, fmt.Errorf("read node %s claim: %w", nodename, err)
+		}
+		if now.Sub(renewedAt) > t.lease {
+			continue
+		}
+		var c claim
+		if err := json.Unmarshal(b, &c); err != nil {
+			t.log.Debugf("decode node %s claim: %s", nodename, err)
+			continue
+		}
+		if c.Node != nodename {
+			t.log.Warnf("node %s slot contains a claim of node %s", nodename, c.Node)
+			continue
+		}
+		if lease.Holder == "" || c.TakenAt.Before(lease.TakenAt) || (c.TakenAt.Equal(lease.TakenAt) && c.Node < lease.Holder) {
+			lease = Lease{
+				Holder:    c.Node,
+				TakenAt:   c.TakenAt,
+				RenewedAt: renewedAt,
+			}
+		}
+	}
+	return lease, nil
+}
@@ -0,0 +1,140 @@
+package qdisk
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/util/loop"
+	"github.com/opensvc/om3/util/plog"
+)
+
+// newLoopDevice returns the path of a loop device backed by a blank file,
+// or skips the test if loop devices can not be set up.
+func newLoopDevice(t *testing.T) string {
+	t.Helper()
+	if os.Getuid() != 0 {
+		t.Skip("need root to setup a loop device")
+	}
+	if _, err := exec.LookPath("losetup"); err != nil {
+		t.Skip("need losetup to setup a loop device")
+	}
+	filePath := filepath.Join(t.TempDir(), "qdisk")
+	require.NoError(t, os.WriteFile(filePath, nil, 0600))
+	require.NoError(t, os.Truncate(filePath, 16*1024*1024))
+	l := loop.New()
+	if err := l.Add(filePath); err != nil {
+		t.Skipf("setup loop device: %s", err)
+	}
+	info, err := l.FileGet(filePath)
+	require.NoError(t, err)
+	require.NotNil(t, info)
+	t.Cleanup(func() { _ = l.Delete(info.Name) })
+	return info.Name
+}
+
+func newTestQdisk(t *testing.T, dev, nodename string, lease time.Duration) *T {
+	q := New(dev, nodename, []string{"node1", "node2"}, lease, plog.NewDefaultLogger())
+	t.Cleanup(func() { _ = q.Close() })
+	return q
+}
+
+func TestAcquire(t *testing.T) {
+	SettleDelay = 100 * time.Millisecond
+	ctx := context.Background()
+
+	t.Run("the first claim takes the lease", func(t *testing.T) {
+		dev := newLoopDevice(t)
+		q1 := newTestQdisk(t, dev, "node1", 10*time.Second)
+		q2 := newTestQdisk(t, dev, "node2", 10*time.Second)
+
+		lease, err := q1.Status(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "", lease.Holder)
+
+		lease, err = q1.Acquire(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "node1", lease.Holder)
+		takenAt := lease.TakenAt
+
+		lease, err = q2.Acquire(ctx)
+		require.ErrorIs(t, err, ErrHeld)
+		require.Equal(t, "node1", lease.Holder)
+
+		lease, err = q1.Acquire(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "node1", lease.Holder)
+		require.True(t, takenAt.Equal(lease.TakenAt), "a renew must not change the take time")
+
+		lease, err = q2.Status(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "node1", lease.Holder)
+		require.False(t, lease.RenewedAt.Before(takenAt))
+	})
+
+	t.Run("an expired lease can be taken", func(t *testing.T) {
+		dev := newLoopDevice(t)
+		q1 := newTestQdisk(t, dev, "node1", time.Second)
+		q2 := newTestQdisk(t, dev, "node2", time.Second)
+
+		_, err := q1.Acquire(ctx)
+		require.NoError(t, err)
+		time.Sleep(1500 * time.Millisecond)
+
+		lease, err := q2.Acquire(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "node2", lease.Holder)
+
+		_, err = q1.Acquire(ctx)
+		require.ErrorIs(t, err, ErrHeld)
+	})
+
+	t.Run("concurrent claims elect a single holder", func(t *testing.T) {
+		dev := newLoopDevice(t)
+		q1 := newTestQdisk(t, dev, "node1", 10*time.Second)
+		q2 := newTestQdisk(t, dev, "node2", 10*time.Second)
+
+		var wg sync.WaitGroup
+		errs := make([]error, 2)
+		leases := make([]Lease, 2)
+		for i, q := range []*T{q1, q2} {
+			wg.Add(1)
+			go func(i int, q *T) {
+				defer wg.Done()
+				leases[i], errs[i] = q.Acquire(ctx)
+			}(i, q)
+		}
+		wg.Wait()
+		require.Equal(t, leases[0].Holder, leases[1].Holder)
+		require.NotEmpty(t, leases[0].Holder)
+		if leases[0].Holder == "node1" {
+			require.NoError(t, errs[0])
+			require.ErrorIs(t, errs[1], ErrHeld)
+		} else {
+			require.ErrorIs(t, errs[0], ErrHeld)
+			require.NoError(t, errs[1])
+		}
+	})
+}
+
+func TestRenew(t *testing.T) {
+	SettleDelay = 100 * time.Millisecond
+	dev := newLoopDevice(t)
+	q1 := newTestQdisk(t, dev, "node1", time.Second)
+	q2 := newTestQdisk(t, dev, "node2", time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go q1.Renew(ctx)
+
+	time.Sleep(2500 * time.Millisecond)
+	lease, err := q2.Acquire(context.Background())
+	require.ErrorIs(t, err, ErrHeld, "the renewed lease must not expire")
+	require.Equal(t, "node1", lease.Holder)
+}
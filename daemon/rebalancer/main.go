@@ -0,0 +1,406 @@
+// Package rebalancer applies the cluster rebalance plans.
+//
+// The rebalancer receives the plans from the ClusterRebalanceRequested
+// messages. It runs the plan waves sequentially, with at most
+// plan.MaxParallel concurrent moves per wave, and publishes its progress
+// with the ClusterRebalanceStarted, ClusterRebalanceMoveStarted,
+// ClusterRebalanceMoveEnded and ClusterRebalanceEnded messages.
+//
+// A move is a giveback orchestration, posted to the local instance monitor
+// if the object has a local instance, or to a peer node hosting an
+// instance of the object. The moves are deferred while the local node
+// maintenance window is open.
+package rebalancer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/cluster"
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/placement"
+	"github.com/opensvc/om3/core/rebalance"
+	"github.com/opensvc/om3/daemon/daemonenv"
+	"github.com/opensvc/om3/daemon/daemonsubsystem"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/plog"
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+type (
+	T struct {
+		ctx    context.Context
+		cancel context.CancelFunc
+		bus    *pubsub.Bus
+		log    *plog.Logger
+
+		sub   *pubsub.Subscription
+		subQS pubsub.QueueSizer
+
+		wg        sync.WaitGroup
+		localhost string
+
+		// running is the id of the plan being applied, uuid.Nil when idle.
+		running uuid.UUID
+		doneC   chan uuid.UUID
+	}
+)
+
+var (
+	// moveTimeout is the max duration of a move orchestration.
+	moveTimeout = 10 * time.Minute
+
+	// moveStartTimeout is the max duration to wait for a move
+	// orchestration to be visible in the instance monitors data.
+	moveStartTimeout = 30 * time.Second
+)
+
+func New(subQS pubsub.QueueSizer) *T {
+	return &T{
+		localhost: hostname.Hostname(),
+		log: plog.NewDefaultLogger().
+			Attr("pkg", "daemon/rebalancer").
+			WithPrefix("daemon: rebalancer: "),
+		subQS: subQS,
+		doneC: make(chan uuid.UUID),
+	}
+}
+
+// Start launches the rebalancer worker goroutine
+func (t *T) Start(parent context.Context) error {
+	t.log.Infof("starting")
+	t.ctx, t.cancel = context.WithCancel(parent)
+	t.bus = pubsub.BusFromContext(t.ctx)
+	t.startSubscriptions()
+
+	t.wg.Add(1)
+	go func() {
+		defer func() {
+			if err := t.sub.Stop(); err != nil && !errors.Is(err, context.Canceled) {
+				t.log.Warnf("subscription stop: %s", err)
+			}
+			t.wg.Done()
+			t.log.Infof("stopped")
+		}()
+		t.log.Infof("started")
+		t.worker()
+	}()
+	return nil
+}
+
+func (t *T) Stop() error {
+	t.cancel()
+	t.wg.Wait()
+	return nil
+}
+
+func (t *T) startSubscriptions() {
+	sub := t.bus.Sub("daemon.rebalancer", t.subQS)
+	sub.AddFilter(&msgbus.ClusterRebalanceRequested{}, pubsub.Label{"node", t.localhost})
+	sub.Start()
+	t.sub = sub
+}
+
+func (t *T) worker() {
+	defer t.log.Debugf("done")
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case id := <-t.doneC:
+			if id == t.running {
+				t.running = uuid.Nil
+			}
+		case i := <-t.sub.C:
+			switch c := i.(type) {
+			case *msgbus.ClusterRebalanceRequested:
+				t.onClusterRebalanceRequested(c)
+			}
+		}
+	}
+}
+
+func (t *T) onClusterRebalanceRequested(c *msgbus.ClusterRebalanceRequested) {
+	var err error
+	if t.running != uuid.Nil {
+		err = fmt.Errorf("rebalance %s is already running", t.running)
+	}
+	if c.Err != nil {
+		c.Err.Send(err)
+		c.Err.Close()
+	}
+	if err != nil {
+		return
+	}
+	t.running = c.Plan.ID
+	t.wg.Add(1)
+	go func(plan rebalance.Plan) {
+		defer t.wg.Done()
+		t.apply(plan)
+		select {
+		case <-t.ctx.Done():
+		case t.doneC <- plan.ID:
+		}
+	}(c.Plan)
+}
+
+// apply runs the plan waves sequentially. A wave failure does not abort
+// the next waves, because the failed moves children may not be part of
+// the next waves.
+func (t *T) apply(plan rebalance.Plan) {
+	labels := []pubsub.Label{{"node", t.localhost}}
+	t.log.Infof("rebalance %s: start %d moves, max parallel %d", plan.ID, len(plan.Moves), plan.MaxParallel)
+	t.bus.Pub(&msgbus.ClusterRebalanceStarted{Node: t.localhost, Plan: plan}, labels...)
+
+	var (
+		mu           sync.Mutex
+		done, failed int
+	)
+	for _, wave := range plan.Waves() {
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, plan.MaxParallel)
+		for _, move := range wave {
+			if err := t.waitMaintenanceWindow(plan.ID); err != nil {
+				return
+			}
+			select {
+			case <-t.ctx.Done():
+				return
+			case sem <- struct{}{}:
+			}
+			wg.Add(1)
+			go func(move rebalance.Move) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				err := t.runMove(plan.ID, move)
+				mu.Lock()
+				if err != nil {
+					failed++
+				} else {
+					done++
+				}
+				mu.Unlock()
+			}(move)
+		}
+		wg.Wait()
+	}
+	t.log.Infof("rebalance %s: end with %d moves done, %d moves failed", plan.ID, done, failed)
+	t.bus.Pub(&msgbus.ClusterRebalanceEnded{Node: t.localhost, ID: plan.ID, Done: done, Failed: failed}, labels...)
+}
+
+// waitMaintenanceWindow waits for the end of the maintenance window open on
+// the local node. The deferral is announced by a MaintenanceWindowBlocked
+// event, once per window.
+func (t *T) waitMaintenanceWindow(id uuid.UUID) error {
+	var announced time.Time
+	for {
+		nodeMonitor := node.MonitorData.Get(t.localhost)
+		if nodeMonitor == nil {
+			return nil
+		}
+		w := nodeMonitor.MaintenanceWindow
+		now := time.Now()
+		if w == nil || !w.IsOpen(now) {
+			return nil
+		}
+		if !w.End.Equal(announced) {
+			announced = w.End
+			t.log.Infof("rebalance %s: deferred by %s %s until %s", id, w.Key, w.Schedule, w.End.Format(time.RFC3339))
+			t.bus.Pub(&msgbus.MaintenanceWindowBlocked{Node: t.localhost, Action: "rebalance", Window: *w}, pubsub.Label{"node", t.localhost})
+		}
+		timer := time.NewTimer(w.End.Sub(now))
+		select {
+		case <-t.ctx.Done():
+			timer.Stop()
+			return t.ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *T) runMove(id uuid.UUID, move rebalance.Move) error {
+	labels := []pubsub.Label{{"node", t.localhost}, {"path", move.Path.String()}}
+	t.log.Infof("rebalance %s: move %s from %s to %s", id, move.Path, move.From, move.To)
+	t.bus.Pub(&msgbus.ClusterRebalanceMoveStarted{Node: t.localhost, ID: id, Move: move}, labels...)
+
+	err := t.move(move)
+	msg := &msgbus.ClusterRebalanceMoveEnded{Node: t.localhost, ID: id, Move: move}
+	if err != nil {
+		msg.ErrS = err.Error()
+		t.log.Warnf("rebalance %s: move %s: %s", id, move.Path, err)
+	}
+	t.bus.Pub(msg, labels...)
+	return err
+}
+
+// move posts the giveback orchestration of the move object, waits for the
+// orchestration end and verifies the object placement is now optimal.
+//
+// The object events are subscribed before the giveback is posted, so the
+// orchestration progress events are not missed.
+func (t *T) move(move rebalance.Move) error {
+	sub := t.bus.Sub("daemon.rebalancer.move "+move.Path.String(), t.subQS)
+	labelPath := pubsub.Label{"path", move.Path.String()}
+	sub.AddFilter(&msgbus.InstanceMonitorUpdated{}, labelPath)
+	sub.AddFilter(&msgbus.ObjectOrchestrationEnd{}, labelPath)
+	sub.AddFilter(&msgbus.ObjectOrchestrationRefused{}, labelPath)
+	sub.Start()
+	defer func() {
+		if err := sub.Stop(); err != nil && !errors.Is(err, context.Canceled) {
+			t.log.Warnf("move %s subscription stop: %s", move.Path, err)
+		}
+	}()
+	orchestrationID, err := t.giveback(move)
+	if err != nil {
+		return err
+	}
+	if err := t.waitOrchestration(sub, move, orchestrationID); err != nil {
+		return err
+	}
+	if objStatus := object.StatusData.Get(move.Path); objStatus != nil && objStatus.PlacementState == placement.NonOptimal {
+		return fmt.Errorf("placement is still non-optimal")
+	}
+	return nil
+}
+
+func (t *T) giveback(move rebalance.Move) (uuid.UUID, error) {
+	if instance.MonitorData.Get(move.Path, t.localhost) != nil {
+		ctx, cancel := context.WithTimeout(t.ctx, 500*time.Millisecond)
+		defer cancel()
+		globalExpect := instance.MonitorGlobalExpectPlaced
+		value := instance.MonitorUpdate{
+			GlobalExpect:             &globalExpect,
+			CandidateOrchestrationID: uuid.New(),
+		}
+		msg, setImonErr := msgbus.NewSetInstanceMonitorWithErr(ctx, move.Path, t.localhost, value)
+		t.bus.Pub(msg, pubsub.Label{"path", move.Path.String()}, pubsub.Label{"origin", "rebalancer"})
+		if err := setImonErr.Receive(); err != nil {
+			return uuid.Nil, fmt.Errorf("set instance monitor: %w", err)
+		}
+		return value.CandidateOrchestrationID, nil
+	}
+	var errs error
+	for nodename := range instance.MonitorData.GetByPath(move.Path) {
+		id, err := t.givebackOnPeer(move, nodename)
+		if err == nil {
+			return id, nil
+		}
+		errs = errors.Join(errs, fmt.Errorf("%s: %w", nodename, err))
+	}
+	if errs == nil {
+		errs = fmt.Errorf("no instance monitor")
+	}
+	return uuid.Nil, errs
+}
+
+func (t *T) givebackOnPeer(move rebalance.Move, nodename string) (uuid.UUID, error) {
+	c, err := newDaemonClient(nodename)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	ctx, cancel := context.WithTimeout(t.ctx, 5*time.Second)
+	defer cancel()
+	p := move.Path
+	resp, err := c.PostObjectActionGivebackWithResponse(ctx, p.Namespace, p.Kind, p.Name)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return resp.JSON200.OrchestrationID, nil
+	case http.StatusConflict:
+		return uuid.Nil, fmt.Errorf("%s", resp.JSON409)
+	default:
+		return uuid.Nil, fmt.Errorf("unexpected status code %s", resp.Status())
+	}
+}
+
+// waitOrchestration waits for the end of the orchestration, decided on the
+// events of the <sub> subscription: the ObjectOrchestrationEnd event of the
+// local instance monitor, or the orchestration id set on, then unset from,
+// the instance monitors.
+func (t *T) waitOrchestration(sub *pubsub.Subscription, move rebalance.Move, orchestrationID uuid.UUID) error {
+	id := orchestrationID.String()
+
+	// nodes is the set of nodes where the instance monitor has the
+	// orchestration id set.
+	nodes := make(map[string]struct{})
+	for nodename, instMonitor := range instance.MonitorData.GetByPath(move.Path) {
+		if instMonitor != nil && instMonitor.OrchestrationID == orchestrationID {
+			nodes[nodename] = struct{}{}
+		}
+	}
+	started := len(nodes) > 0
+
+	timeout := time.NewTimer(moveTimeout)
+	defer timeout.Stop()
+	startTimeout := time.NewTimer(moveStartTimeout)
+	defer startTimeout.Stop()
+	for {
+		select {
+		case <-t.ctx.Done():
+			return t.ctx.Err()
+		case <-startTimeout.C:
+			if !started {
+				return fmt.Errorf("orchestration %s not started after %s", orchestrationID, moveStartTimeout)
+			}
+		case <-timeout.C:
+			return fmt.Errorf("orchestration %s not ended after %s", orchestrationID, moveTimeout)
+		case i := <-sub.C:
+			switch c := i.(type) {
+			case *msgbus.ObjectOrchestrationEnd:
+				if c.ID == id {
+					return nil
+				}
+			case *msgbus.ObjectOrchestrationRefused:
+				if c.ID == id {
+					return fmt.Errorf("orchestration %s refused: %s", orchestrationID, c.Reason)
+				}
+			case *msgbus.InstanceMonitorUpdated:
+				if c.Value.OrchestrationID == orchestrationID {
+					started = true
+					nodes[c.Node] = struct{}{}
+					continue
+				}
+				if _, ok := nodes[c.Node]; !ok {
+					continue
+				}
+				delete(nodes, c.Node)
+				if len(nodes) == 0 {
+					return nil
+				}
+			}
+		}
+	}
+}
+
+func newDaemonClient(nodename string) (*client.T, error) {
+	addr := nodename
+	port := fmt.Sprintf("%d", daemonenv.HTTPPort)
+	if lsnr := daemonsubsystem.DataListener.Get(nodename); lsnr != nil {
+		if lsnr.Port != "" {
+			port = lsnr.Port
+		}
+		if lsnr.Addr != "::" && lsnr.Addr != "" {
+			addr = lsnr.Addr
+		}
+	}
+	return client.New(
+		client.WithURL(daemonenv.HTTPNodeAndPortURL(addr, port)),
+		client.WithUsername(hostname.Hostname()),
+		client.WithPassword(cluster.ConfigData.Get().Secret()),
+		client.WithCertificate(daemonenv.CertChainFile()),
+	)
+}
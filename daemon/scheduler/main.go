@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/opensvc/om3/core/collector"
+	"github.com/opensvc/om3/core/instance"
 	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/core/node"
 	"github.com/opensvc/om3/core/object"
@@ -42,6 +43,9 @@ type (
 		status daemonsubsystem.Scheduler
 
 		maxRunning int
+
+		// nodeMaintenanceWindow is the node maintenance_window keyword value.
+		nodeMaintenanceWindow string
 	}
 
 	Jobs map[string]Job
@@ -58,6 +62,12 @@ type (
 	}
 )
 
+const (
+	// maxMaintenanceWindowDefer is the maximum number of consecutive
+	// maintenance windows a job next run is deferred after.
+	maxMaintenanceWindowDefer = 10
+)
+
 var (
 	incompatibleNodeMonitorStatus = map[node.MonitorState]any{
 		node.MonitorStateInit:        nil,
@@ -157,6 +167,24 @@ func (t *T) createJob(e schedule.Entry) {
 		t.jobs.Del(e)
 		return
 	}
+	if deferred, w, ok, err := t.deferByMaintenanceWindow(e, next); err != nil {
+		logger.Warnf("%s: maintenance window: %s", e.Path, err)
+	} else if ok {
+		if deferred.IsZero() {
+			logger.Infof("%s: %s deferred by %s %s with no next run", e.Path, e.Key, w.Key, w.Schedule)
+			t.jobs.Del(e)
+		} else {
+			logger.Infof("%s: %s at %s deferred by %s %s to %s", e.Path, e.Key, next, w.Key, w.Schedule, deferred)
+		}
+		t.pubsub.Pub(&msgbus.MaintenanceWindowBlocked{Node: t.localhost, Path: e.Path, Action: e.Action, Window: w},
+			pubsub.Label{"node", t.localhost},
+			pubsub.Label{"path", e.Path.String()},
+		)
+		if deferred.IsZero() {
+			return
+		}
+		next = deferred
+	}
 	e.NextRunAt = next
 	delay := next.Sub(now)
 	var obj string
@@ -351,6 +379,16 @@ func (t *T) onNodeConfigUpdated(c *msgbus.NodeConfigUpdated) {
 	} else {
 		t.log.Warnf("on NodeConfigUpdated ignore MaxParallel value 0")
 	}
+	if t.nodeMaintenanceWindow != c.Value.MaintenanceWindow {
+		t.nodeMaintenanceWindow = c.Value.MaintenanceWindow
+		if t.enabled {
+			// the node maintenance window also applies to the object jobs
+			t.log.Infof("node maintenance window changed: update all schedules")
+			t.jobs.Purge()
+			t.scheduleAll()
+		}
+		return
+	}
 	switch {
 	case t.enabled:
 		t.log.Infof("node: update schedules")
@@ -414,7 +452,7 @@ func (t *T) scheduleNode() {
 	table := o.PrintSchedule()
 	defer schedule.TableData.Set(naming.Path{}, &table)
 
-	for _, e := range table {
+	for _, e := range table.Jobs() {
 		t.createJob(e)
 	}
 	table = table.Merge(t.jobs.Table(naming.Path{}))
@@ -443,7 +481,7 @@ func (t *T) scheduleObject(p naming.Path) {
 		return
 	}
 
-	for _, e := range table {
+	for _, e := range table.Jobs() {
 		t.createJob(e)
 	}
 	table = table.Merge(t.jobs.Table(p))
@@ -459,3 +497,48 @@ func (t *T) publishUpdate() {
 	daemonsubsystem.DataScheduler.Set(localhost, t.status.DeepCopy())
 	t.pubsub.Pub(&msgbus.DaemonSchedulerUpdated{Node: localhost, Value: *t.status.DeepCopy()}, pubsub.Label{"node", localhost})
 }
+
+// maintenanceWindows returns the maintenance_window keyword values
+// applying to the jobs of the object p, or of the node if p is zero.
+func (t *T) maintenanceWindows(p naming.Path) map[string]string {
+	m := map[string]string{
+		"node.maintenance_window": t.nodeMaintenanceWindow,
+	}
+	if !p.IsZero() {
+		if cfg := instance.ConfigData.Get(p, t.localhost); cfg != nil {
+			m["maintenance_window"] = cfg.MaintenanceWindow
+		}
+	}
+	return m
+}
+
+// deferByMaintenanceWindow returns the first next run time of the entry
+// e, at or after next, that is not in a maintenance window. The first
+// window deferring the job is returned with ok set to true.
+func (t *T) deferByMaintenanceWindow(e schedule.Entry, next time.Time) (time.Time, schedule.Window, bool, error) {
+	var (
+		found schedule.Window
+		ok    bool
+	)
+	windows := t.maintenanceWindows(e.Path)
+	for i := 0; i < maxMaintenanceWindowDefer; i++ {
+		w, err := schedule.NextWindowOf(next, windows)
+		if err != nil {
+			return next, found, ok, err
+		}
+		if !w.IsOpen(next) {
+			return next, found, ok, nil
+		}
+		if !ok {
+			found, ok = w, true
+		}
+		next, _, err = e.GetNextAfter(w.End)
+		if err != nil {
+			return next, found, ok, err
+		}
+		if next.IsZero() {
+			return next, found, ok, nil
+		}
+	}
+	return next, found, ok, nil
+}
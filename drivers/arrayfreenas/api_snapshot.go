@@ -0,0 +1,46 @@
+package arrayfreenas
+
+// CreateSnapshotParams defines model for CreateSnapshotParams.
+type CreateSnapshotParams struct {
+	Dataset   string `json:"dataset"`
+	Name      string `json:"name"`
+	Recursive bool   `json:"recursive"`
+}
+
+// CloneSnapshotParams defines model for CloneSnapshotParams.
+type CloneSnapshotParams struct {
+	Snapshot   string `json:"snapshot"`
+	DatasetDst string `json:"dataset_dst"`
+}
+
+// RollbackSnapshotParams defines model for RollbackSnapshotParams.
+type RollbackSnapshotParams struct {
+	Id      string                        `json:"id"`
+	Options RollbackSnapshotParamsOptions `json:"options"`
+}
+
+// RollbackSnapshotParamsOptions defines model for RollbackSnapshotParams_options.
+type RollbackSnapshotParamsOptions struct {
+	Recursive bool `json:"recursive"`
+	Force     bool `json:"force"`
+}
+
+// Snapshot defines model for Snapshot.
+type Snapshot struct {
+	Id           string                    `json:"id"`
+	Name         string                    `json:"name"`
+	Dataset      string                    `json:"dataset"`
+	SnapshotName string                    `json:"snapshot_name"`
+	Properties   map[string]CompositeValue `json:"properties,omitempty"`
+}
+
+type Snapshots []Snapshot
+
+func (t Snapshots) GetBySnapshotName(name string) (*Snapshot, bool) {
+	for _, e := range t {
+		if e.SnapshotName == name {
+			return &e, true
+		}
+	}
+	return nil, false
+}
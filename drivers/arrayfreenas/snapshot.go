@@ -0,0 +1,133 @@
+package arrayfreenas
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Created returns the snapshot creation time.
+func (t Snapshot) Created() time.Time {
+	if v, ok := t.Properties["creation"]; ok {
+		if i, err := strconv.ParseInt(v.Rawvalue, 10, 64); err == nil {
+			return time.Unix(i, 0)
+		}
+	}
+	return time.Time{}
+}
+
+// Used returns the space consumed by the snapshot, in bytes.
+func (t Snapshot) Used() int64 {
+	if v, ok := t.Properties["used"]; ok {
+		if i, err := strconv.ParseInt(v.Rawvalue, 10, 64); err == nil {
+			return i
+		}
+	}
+	return 0
+}
+
+// GetSnapshots returns the snapshots of the dataset.
+func (t Array) GetSnapshots(dataset string) (Snapshots, error) {
+	params := map[string]string{
+		"dataset": dataset,
+	}
+	req, err := t.newRequest(http.MethodGet, "/zfs/snapshot", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	items := make(Snapshots, 0)
+	_, err = t.Do(req, &items)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (t Array) CreateSnapshot(params CreateSnapshotParams) (*Snapshot, error) {
+	req, err := t.newRequest(http.MethodPost, "/zfs/snapshot", nil, params)
+	if err != nil {
+		return nil, err
+	}
+	var data Snapshot
+	_, err = t.Do(req, &data)
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+func (t Array) DeleteSnapshot(id string) error {
+	path := fmt.Sprintf("/zfs/snapshot/id/%s", url.PathEscape(id))
+	req, err := t.newRequest(http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return err
+	}
+	var data any
+	_, err = t.Do(req, &data)
+	return err
+}
+
+func (t Array) RollbackSnapshot(params RollbackSnapshotParams) error {
+	req, err := t.newRequest(http.MethodPost, "/zfs/snapshot/rollback", nil, params)
+	if err != nil {
+		return err
+	}
+	var data any
+	_, err = t.Do(req, &data)
+	return err
+}
+
+func (t Array) CloneSnapshot(params CloneSnapshotParams) error {
+	req, err := t.newRequest(http.MethodPost, "/zfs/snapshot/clone", nil, params)
+	if err != nil {
+		return err
+	}
+	var data any
+	_, err = t.Do(req, &data)
+	return err
+}
+
+// AddClone creates the zvol <name> as a clone of the zvol snapshot
+// <snapshot>, and exports it like AddDisk does for a new zvol.
+func (t Array) AddClone(snapshot string, opt AddDiskOptions) (*Disk, error) {
+	disk := Disk{
+		ISCSI: &DiskISCSI{},
+	}
+	if err := t.CloneSnapshot(CloneSnapshotParams{Snapshot: snapshot, DatasetDst: opt.Name}); err != nil {
+		return nil, err
+	}
+	if data, err := t.GetDataset(opt.Name); err != nil {
+		return nil, err
+	} else if data == nil {
+		return nil, fmt.Errorf("clone dataset %s not found", opt.Name)
+	} else {
+		disk.Dataset = data
+	}
+
+	// Extent
+	extent, err := t.AddISCSIExtent(AddISCSIExtentOptions{
+		Name:        opt.Name,
+		Disk:        "zvol/" + opt.Name,
+		Blocksize:   opt.Blocksize,
+		InsecureTPC: opt.InsecureTPC,
+	})
+	if err != nil {
+		return nil, err
+	}
+	disk.ISCSI.Extent = extent
+
+	// targetExtent
+	targetExtent, err := t.MapDisk(MapDiskOptions{
+		Name:    opt.Name,
+		Mapping: opt.Mapping,
+		LunId:   opt.LunId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	disk.ISCSI.TargetExtents = targetExtent
+
+	return &disk, nil
+}
@@ -0,0 +1,166 @@
+package arraypure
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type (
+	OptSnapshot struct {
+		Volume OptVolume
+		Suffix string
+	}
+
+	OptCopyVolume struct {
+		// Source is the name of the volume or volume snapshot to copy.
+		Source string
+
+		// Name is the name of the volume to create or overwrite.
+		Name string
+
+		// Overwrite allows replacing the data of an existing volume.
+		Overwrite bool
+	}
+
+	pureVolumeSnapshot struct {
+		ID          string                `json:"id,omitempty"`
+		Name        string                `json:"name,omitempty"`
+		Created     int64                 `json:"created,omitempty"`
+		Destroyed   bool                  `json:"destroyed,omitempty"`
+		Provisioned int64                 `json:"provisioned,omitempty"`
+		Serial      string                `json:"serial,omitempty"`
+		Source      pureSourceIdentifiers `json:"source,omitempty"`
+		Space       map[string]any        `json:"space,omitempty"`
+		Suffix      string                `json:"suffix,omitempty"`
+	}
+
+	pureResponseVolumeSnapshots struct {
+		TotalItems        int                  `json:"total_item_count,omitempty"`
+		ContinuationToken any                  `json:"continuation_token,omitempty"`
+		Items             []pureVolumeSnapshot `json:"items,omitempty"`
+	}
+)
+
+// Used returns the space consumed by the snapshot, in bytes.
+func (t pureVolumeSnapshot) Used() int64 {
+	if v, ok := t.Space["snapshots"].(float64); ok {
+		return int64(v)
+	}
+	return 0
+}
+
+// GetVolumeSnapshots returns the snapshots of the volume.
+func (t *Array) GetVolumeSnapshots(opt OptVolume) ([]pureVolumeSnapshot, error) {
+	volume, err := t.getVolume(opt)
+	if err != nil {
+		return nil, err
+	}
+	params := getParams(fmt.Sprintf("source.name='%s' and destroyed='false'", volume.Name))
+	l, err := t.doGet("GET", "/volume-snapshots", params, nil)
+	if err != nil {
+		return nil, err
+	}
+	snaps := make([]pureVolumeSnapshot, len(l))
+	for i, item := range l {
+		var snap pureVolumeSnapshot
+		b, _ := json.Marshal(item)
+		json.Unmarshal(b, &snap)
+		snaps[i] = snap
+	}
+	return snaps, nil
+}
+
+// AddVolumeSnapshot creates the snapshot <volume name>.<suffix> of the
+// volume.
+func (t *Array) AddVolumeSnapshot(opt OptSnapshot) (pureVolumeSnapshot, error) {
+	if opt.Suffix == "" {
+		return pureVolumeSnapshot{}, fmt.Errorf("suffix is required")
+	}
+	volume, err := t.getVolume(opt.Volume)
+	if err != nil {
+		return pureVolumeSnapshot{}, err
+	}
+	params := map[string]string{
+		"source_names": volume.Name,
+	}
+	data := map[string]string{
+		"suffix": opt.Suffix,
+	}
+	req, err := t.newRequest(http.MethodPost, "/volume-snapshots", params, data)
+	if err != nil {
+		return pureVolumeSnapshot{}, err
+	}
+	var responseData pureResponseVolumeSnapshots
+	if _, err := t.Do(req, &responseData, true); err != nil {
+		return pureVolumeSnapshot{}, err
+	}
+	if len(responseData.Items) == 0 {
+		return pureVolumeSnapshot{}, fmt.Errorf("no volume snapshot item in response")
+	}
+	return responseData.Items[0], nil
+}
+
+// DelVolumeSnapshot destroys and eradicates the snapshot
+// <volume name>.<suffix> of the volume.
+func (t *Array) DelVolumeSnapshot(opt OptSnapshot) error {
+	if opt.Suffix == "" {
+		return fmt.Errorf("suffix is required")
+	}
+	volume, err := t.getVolume(opt.Volume)
+	if err != nil {
+		return err
+	}
+	params := map[string]string{
+		"names": volume.Name + "." + opt.Suffix,
+	}
+	data := map[string]any{
+		"destroyed": true,
+	}
+	req, err := t.newRequest(http.MethodPatch, "/volume-snapshots", params, data)
+	if err != nil {
+		return err
+	}
+	var responseData pureResponseVolumeSnapshots
+	if _, err := t.Do(req, &responseData, true); err != nil {
+		return err
+	}
+	req, err = t.newRequest(http.MethodDelete, "/volume-snapshots", params, nil)
+	if err != nil {
+		return err
+	}
+	_, err = t.Do(req, &responseData, true)
+	return err
+}
+
+// CopyVolume creates or overwrites a volume with the data of another
+// volume or volume snapshot.
+func (t *Array) CopyVolume(opt OptCopyVolume) (pureVolume, error) {
+	if opt.Name == "" {
+		return pureVolume{}, fmt.Errorf("name is required")
+	}
+	if opt.Source == "" {
+		return pureVolume{}, fmt.Errorf("source is required")
+	}
+	params := map[string]string{
+		"names":     opt.Name,
+		"overwrite": fmt.Sprint(opt.Overwrite),
+	}
+	data := map[string]any{
+		"source": map[string]string{
+			"name": opt.Source,
+		},
+	}
+	req, err := t.newRequest(http.MethodPost, "/volumes", params, data)
+	if err != nil {
+		return pureVolume{}, err
+	}
+	var responseData pureResponseVolumes
+	if _, err := t.Do(req, &responseData, true); err != nil {
+		return pureVolume{}, err
+	}
+	if len(responseData.Items) == 0 {
+		return pureVolume{}, fmt.Errorf("no volume item in response")
+	}
+	return responseData.Items[0], nil
+}
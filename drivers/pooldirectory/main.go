@@ -40,7 +40,7 @@ func (t T) Head() string {
 }
 
 func (t T) Capabilities() []string {
-	return []string{"rox", "rwx", "roo", "rwo", "blk"}
+	return []string{"rox", "rwx", "roo", "rwo", "snap", "blk"}
 }
 
 func (t T) Usage() (pool.Usage, error) {
@@ -0,0 +1,173 @@
+package pooldirectory
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/core/pool"
+	"github.com/opensvc/om3/util/command"
+	"github.com/opensvc/om3/util/plog"
+)
+
+// The snapshots are reflink copies of the volume directory or loop file,
+// so the pool path must be hosted by a filesystem supporting reflinks,
+// like xfs or btrfs.
+
+const snapshotsDir = ".snapshots"
+
+// volumeData returns the path of the volume directory, or of the volume
+// loop file for block volumes.
+func (t *T) volumeData(vol pool.Volumer) (string, error) {
+	name := pool.DiskName(t, vol)
+	for _, p := range []string{filepath.Join(t.path(), name), t.loopFile(name)} {
+		if _, err := os.Stat(p); err == nil {
+			return p, nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+	}
+	return "", fmt.Errorf("volume %s data not found in %s", name, t.path())
+}
+
+func (t *T) snapshotDir(vol pool.Volumer) string {
+	return filepath.Join(t.path(), snapshotsDir, pool.DiskName(t, vol))
+}
+
+func (t *T) snapshotData(vol pool.Volumer, name string) (string, error) {
+	p := filepath.Join(t.snapshotDir(vol), name)
+	if _, err := os.Stat(p); errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("%s: %w", name, pool.ErrSnapshotNotFound)
+	} else if err != nil {
+		return "", err
+	}
+	return p, nil
+}
+
+func (t *T) CreateSnapshot(vol pool.Volumer, name string) error {
+	src, err := t.volumeData(vol)
+	if err != nil {
+		return err
+	}
+	dst := filepath.Join(t.snapshotDir(vol), name)
+	if _, err := os.Stat(dst); err == nil {
+		return fmt.Errorf("%s: %w", name, pool.ErrSnapshotExists)
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0700); err != nil {
+		return err
+	}
+	if err := reflinkCopy(pool.VolumeLogger(vol), src, dst); err != nil {
+		return err
+	}
+	// the copy preserves the volume data mtime, reset it to record the
+	// snapshot creation time.
+	now := time.Now()
+	return os.Chtimes(dst, now, now)
+}
+
+func (t *T) DeleteSnapshot(vol pool.Volumer, name string) error {
+	p, err := t.snapshotData(vol, name)
+	if err != nil {
+		return err
+	}
+	pool.VolumeLogger(vol).Infof("remove snapshot %s", p)
+	return os.RemoveAll(p)
+}
+
+// RollbackSnapshot replaces the volume data with a reflink copy of the
+// snapshot. The previous data is removed only when the copy succeeds.
+func (t *T) RollbackSnapshot(vol pool.Volumer, name string) error {
+	snap, err := t.snapshotData(vol, name)
+	if err != nil {
+		return err
+	}
+	data, err := t.volumeData(vol)
+	if err != nil {
+		return err
+	}
+	log := pool.VolumeLogger(vol)
+	tmp := filepath.Join(filepath.Dir(data), "."+filepath.Base(data)+".rollback")
+	if err := os.RemoveAll(tmp); err != nil {
+		return err
+	}
+	if err := reflinkCopy(log, snap, tmp); err != nil {
+		_ = os.RemoveAll(tmp)
+		return err
+	}
+	old := tmp + ".old"
+	if err := os.Rename(data, old); err != nil {
+		_ = os.RemoveAll(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, data); err != nil {
+		_ = os.Rename(old, data)
+		return err
+	}
+	log.Infof("rolled back %s to snapshot %s", data, name)
+	return os.RemoveAll(old)
+}
+
+func (t *T) Snapshots(vol pool.Volumer) (pool.Snapshots, error) {
+	entries, err := os.ReadDir(t.snapshotDir(vol))
+	if errors.Is(err, os.ErrNotExist) {
+		return pool.Snapshots{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	l := make(pool.Snapshots, 0, len(entries))
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		l = append(l, pool.Snapshot{
+			Name:    entry.Name(),
+			Created: info.ModTime(),
+		})
+	}
+	sort.Sort(l)
+	return l, nil
+}
+
+// CloneSnapshot creates the clone volume data as a reflink copy of the
+// snapshot.
+func (t *T) CloneSnapshot(vol pool.Volumer, name string, clone pool.Volumer) error {
+	snap, err := t.snapshotData(vol, name)
+	if err != nil {
+		return err
+	}
+	data, err := t.volumeData(vol)
+	if err != nil {
+		return err
+	}
+	cloneName := pool.DiskName(t, clone)
+	dst := filepath.Join(t.path(), cloneName)
+	if strings.HasSuffix(data, ".img") {
+		dst = t.loopFile(cloneName)
+	}
+	if _, err := os.Stat(dst); err == nil {
+		return fmt.Errorf("clone data %s already exists", dst)
+	}
+	return reflinkCopy(pool.VolumeLogger(clone), snap, dst)
+}
+
+func reflinkCopy(log *plog.Logger, src, dst string) error {
+	cmd := command.New(
+		command.WithName("cp"),
+		command.WithVarArgs("-a", "--reflink=always", src, dst),
+		command.WithLogger(log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
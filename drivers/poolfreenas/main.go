@@ -72,7 +72,7 @@ func (t T) arrayName() string {
 }
 
 func (t T) Capabilities() []string {
-	return []string{"rox", "rwx", "roo", "rwo", "blk", "iscsi", "shared"}
+	return []string{"rox", "rwx", "roo", "rwo", "blk", "iscsi", "shared", "snap"}
 }
 
 func (t T) Usage() (pool.Usage, error) {
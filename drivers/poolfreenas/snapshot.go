@@ -0,0 +1,130 @@
+//go:build linux || solaris
+
+package poolfreenas
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/opensvc/om3/core/keyop"
+	"github.com/opensvc/om3/core/pool"
+	"github.com/opensvc/om3/drivers/arrayfreenas"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/key"
+	"github.com/opensvc/om3/util/san"
+)
+
+// The volume snapshots are zfs snapshots of the array zvol allocated by the
+// volume disk#0 resource.
+
+func (t *T) zvolName(vol pool.Volumer) string {
+	return t.diskgroup() + "/" + pool.VolumeDiskName(t, vol)
+}
+
+func (t *T) CreateSnapshot(vol pool.Volumer, name string) error {
+	zvol := t.zvolName(vol)
+	a := t.array()
+	snaps, err := a.GetSnapshots(zvol)
+	if err != nil {
+		return err
+	}
+	if _, ok := snaps.GetBySnapshotName(name); ok {
+		return fmt.Errorf("%s@%s: %w", zvol, name, pool.ErrSnapshotExists)
+	}
+	pool.VolumeLogger(vol).Infof("create snapshot %s@%s", zvol, name)
+	_, err = a.CreateSnapshot(arrayfreenas.CreateSnapshotParams{Dataset: zvol, Name: name})
+	return err
+}
+
+func (t *T) DeleteSnapshot(vol pool.Volumer, name string) error {
+	snap, err := t.snapshot(vol, name)
+	if err != nil {
+		return err
+	}
+	pool.VolumeLogger(vol).Infof("delete snapshot %s", snap.Name)
+	return t.array().DeleteSnapshot(snap.Id)
+}
+
+func (t *T) RollbackSnapshot(vol pool.Volumer, name string) error {
+	snap, err := t.snapshot(vol, name)
+	if err != nil {
+		return err
+	}
+	pool.VolumeLogger(vol).Infof("rollback %s", snap.Name)
+	return t.array().RollbackSnapshot(arrayfreenas.RollbackSnapshotParams{
+		Id: snap.Id,
+		Options: arrayfreenas.RollbackSnapshotParamsOptions{
+			Recursive: true,
+			Force:     true,
+		},
+	})
+}
+
+func (t *T) Snapshots(vol pool.Volumer) (pool.Snapshots, error) {
+	items, err := t.array().GetSnapshots(t.zvolName(vol))
+	if err != nil {
+		return nil, err
+	}
+	l := make(pool.Snapshots, len(items))
+	for i, item := range items {
+		l[i] = pool.Snapshot{
+			Name:    item.SnapshotName,
+			Created: item.Created(),
+			Used:    item.Used(),
+		}
+	}
+	return l, nil
+}
+
+// CloneSnapshot creates the clone zvol as a zfs clone of the snapshot,
+// exports it to the clone nodes, and sets the clone disk#0.disk_id keyword
+// so the clone provisioning does not create another zvol.
+func (t *T) CloneSnapshot(vol pool.Volumer, name string, clone pool.Volumer) error {
+	snap, err := t.snapshot(vol, name)
+	if err != nil {
+		return err
+	}
+	nodenames := clone.Config().GetStrings(key.New("DEFAULT", "nodes"))
+	if len(nodenames) == 0 {
+		nodenames = []string{hostname.Hostname()}
+	}
+	paths, err := pool.GetPaths(t, nodenames, san.ISCSI)
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		return fmt.Errorf("no mapping for nodes %s. cowardly refuse to create a disk that can not be mapped", strings.Join(nodenames, " "))
+	}
+	a := t.array()
+	cloneName := t.zvolName(clone)
+	pool.VolumeLogger(clone).Infof("clone %s to %s", snap.Name, cloneName)
+	disk, err := a.AddClone(snap.Name, arrayfreenas.AddDiskOptions{
+		AddZvolOptions: arrayfreenas.AddZvolOptions{
+			Name:      cloneName,
+			Blocksize: fmt.Sprint(*t.blocksize()),
+		},
+		InsecureTPC: t.insecureTPC(),
+		Mapping:     paths.Mapping(),
+	})
+	if err != nil {
+		return err
+	}
+	return clone.Config().Set(keyop.T{
+		Key:   key.New("disk#0", "disk_id"),
+		Op:    keyop.Set,
+		Value: a.DiskId(*disk),
+	})
+}
+
+func (t *T) snapshot(vol pool.Volumer, name string) (*arrayfreenas.Snapshot, error) {
+	zvol := t.zvolName(vol)
+	snaps, err := t.array().GetSnapshots(zvol)
+	if err != nil {
+		return nil, err
+	}
+	snap, ok := snaps.GetBySnapshotName(name)
+	if !ok {
+		return nil, fmt.Errorf("%s@%s: %w", zvol, name, pool.ErrSnapshotNotFound)
+	}
+	return snap, nil
+}
@@ -70,7 +70,7 @@ func (t T) arrayName() string {
 }
 
 func (t T) Capabilities() []string {
-	return []string{"rox", "rwx", "roo", "rwo", "blk", "fc", "shared"}
+	return []string{"rox", "rwx", "roo", "rwo", "blk", "fc", "shared", "snap"}
 }
 
 func (t T) Usage() (pool.Usage, error) {
@@ -168,15 +168,8 @@ func (t *T) CreateDisk(name string, size int64, nodenames []string) ([]pool.Disk
 	}
 	a := t.array()
 	drvSize := sizeconv.ExactBSizeCompact(float64(size))
-	pod := t.pod()
-	vg := t.volumeGroup()
-	if pod != "" {
-		name = pod + "::" + name
-	} else if vg != "" {
-		name = vg + "/" + name
-	}
 	arrayDisk, err := a.AddDisk(arraypure.OptAddDisk{
-		Name:     name,
+		Name:     t.arrayVolumeName(name),
 		Size:     drvSize,
 		Mappings: paths.MappingList(),
 		LUN:      -1,
@@ -190,6 +183,17 @@ func (t *T) CreateDisk(name string, size int64, nodenames []string) ([]pool.Disk
 	return []pool.Disk{poolDisk}, nil
 }
 
+// arrayVolumeName returns the array volume name, prefixed by the pod or
+// volume group the pool allocates its volumes in.
+func (t *T) arrayVolumeName(name string) string {
+	if pod := t.pod(); pod != "" {
+		return pod + "::" + name
+	} else if vg := t.volumeGroup(); vg != "" {
+		return vg + "/" + name
+	}
+	return name
+}
+
 func (t *T) DiskName(vol pool.Volumer) string {
 	var s string
 	if labelPrefix := t.labelPrefix(); labelPrefix != "" {
@@ -0,0 +1,166 @@
+//go:build linux || solaris
+
+package poolpure
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/opensvc/om3/core/keyop"
+	"github.com/opensvc/om3/core/pool"
+	"github.com/opensvc/om3/drivers/arraypure"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/key"
+	"github.com/opensvc/om3/util/san"
+)
+
+// The volume snapshots are array volume snapshots named
+// <array volume name>.<snapshot name>.
+
+// volumeOpt returns the array volume selector of the volume, built from
+// the serial part of the disk#0.disk_id keyword value.
+func (t *T) volumeOpt(vol pool.Volumer) (arraypure.OptVolume, error) {
+	wwid := vol.Config().GetString(key.New("disk#0", "disk_id"))
+	if len(wwid) != 32 {
+		return arraypure.OptVolume{}, fmt.Errorf("%s: can not fetch serial from disk#0.disk_id: '%s'", vol.FQDN(), wwid)
+	}
+	return arraypure.OptVolume{Serial: wwid[8:]}, nil
+}
+
+func (t *T) CreateSnapshot(vol pool.Volumer, name string) error {
+	opt, err := t.volumeOpt(vol)
+	if err != nil {
+		return err
+	}
+	if snaps, err := t.Snapshots(vol); err != nil {
+		return err
+	} else if snaps.Has(name) {
+		return fmt.Errorf("%s: %w", name, pool.ErrSnapshotExists)
+	}
+	pool.VolumeLogger(vol).Infof("create snapshot %s of array volume %s", name, opt.Serial)
+	_, err = t.array().AddVolumeSnapshot(arraypure.OptSnapshot{Volume: opt, Suffix: name})
+	return err
+}
+
+func (t *T) DeleteSnapshot(vol pool.Volumer, name string) error {
+	opt, err := t.snapshotVolumeOpt(vol, name)
+	if err != nil {
+		return err
+	}
+	pool.VolumeLogger(vol).Infof("delete snapshot %s of array volume %s", name, opt.Serial)
+	return t.array().DelVolumeSnapshot(arraypure.OptSnapshot{Volume: opt, Suffix: name})
+}
+
+// RollbackSnapshot overwrites the array volume with the snapshot data.
+// The volume keeps its serial, so the disk_id is unchanged.
+func (t *T) RollbackSnapshot(vol pool.Volumer, name string) error {
+	opt, err := t.snapshotVolumeOpt(vol, name)
+	if err != nil {
+		return err
+	}
+	a := t.array()
+	volumes, err := a.GetVolumes(arraypure.OptGetItems{Filter: fmt.Sprintf("serial='%s'", opt.Serial)})
+	if err != nil {
+		return err
+	}
+	if len(volumes) != 1 {
+		return fmt.Errorf("%d array volumes found with serial %s", len(volumes), opt.Serial)
+	}
+	volumeName := volumes[0].Name
+	pool.VolumeLogger(vol).Infof("overwrite array volume %s with snapshot %s", volumeName, name)
+	_, err = a.CopyVolume(arraypure.OptCopyVolume{
+		Source:    volumeName + "." + name,
+		Name:      volumeName,
+		Overwrite: true,
+	})
+	return err
+}
+
+func (t *T) Snapshots(vol pool.Volumer) (pool.Snapshots, error) {
+	opt, err := t.volumeOpt(vol)
+	if err != nil {
+		return nil, err
+	}
+	items, err := t.array().GetVolumeSnapshots(opt)
+	if err != nil {
+		return nil, err
+	}
+	l := make(pool.Snapshots, len(items))
+	for i, item := range items {
+		l[i] = pool.Snapshot{
+			Name:    item.Suffix,
+			Created: time.UnixMilli(item.Created),
+			Used:    item.Used(),
+		}
+	}
+	return l, nil
+}
+
+// CloneSnapshot creates the clone array volume as a copy of the snapshot,
+// maps it to the clone nodes, and sets the clone disk#0.disk_id keyword
+// so the clone provisioning does not create another array volume.
+func (t *T) CloneSnapshot(vol pool.Volumer, name string, clone pool.Volumer) error {
+	opt, err := t.snapshotVolumeOpt(vol, name)
+	if err != nil {
+		return err
+	}
+	a := t.array()
+	volumes, err := a.GetVolumes(arraypure.OptGetItems{Filter: fmt.Sprintf("serial='%s'", opt.Serial)})
+	if err != nil {
+		return err
+	}
+	if len(volumes) != 1 {
+		return fmt.Errorf("%d array volumes found with serial %s", len(volumes), opt.Serial)
+	}
+	nodenames := clone.Config().GetStrings(key.New("DEFAULT", "nodes"))
+	if len(nodenames) == 0 {
+		nodenames = []string{hostname.Hostname()}
+	}
+	paths, err := pool.GetPaths(t, nodenames, san.FC)
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		return fmt.Errorf("no mapping for nodes %s. cowardly refuse to create a disk that can not be mapped", strings.Join(nodenames, " "))
+	}
+	cloneName := t.arrayVolumeName(pool.VolumeDiskName(t, clone))
+	log := pool.VolumeLogger(clone)
+	log.Infof("create array volume %s from snapshot %s.%s", cloneName, volumes[0].Name, name)
+	volume, err := a.CopyVolume(arraypure.OptCopyVolume{
+		Source: volumes[0].Name + "." + name,
+		Name:   cloneName,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := a.MapDisk(arraypure.OptMapDisk{
+		Volume: arraypure.OptVolume{ID: volume.ID},
+		Mapping: arraypure.OptMapping{
+			Mappings: paths.MappingList(),
+			LUN:      -1,
+		},
+	}); err != nil {
+		return err
+	}
+	return clone.Config().Set(keyop.T{
+		Key:   key.New("disk#0", "disk_id"),
+		Op:    keyop.Set,
+		Value: volume.WWN(),
+	})
+}
+
+func (t *T) snapshotVolumeOpt(vol pool.Volumer, name string) (arraypure.OptVolume, error) {
+	opt, err := t.volumeOpt(vol)
+	if err != nil {
+		return opt, err
+	}
+	snaps, err := t.Snapshots(vol)
+	if err != nil {
+		return opt, err
+	}
+	if !snaps.Has(name) {
+		return opt, fmt.Errorf("%s: %w", name, pool.ErrSnapshotNotFound)
+	}
+	return opt, nil
+}
@@ -0,0 +1,138 @@
+//go:build linux
+
+package poolvg
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/core/pool"
+	"github.com/opensvc/om3/util/command"
+	"github.com/opensvc/om3/util/lvm2"
+)
+
+// snapSeparator separates the volume logical volume name and the snapshot
+// name in the snapshot logical volume name.
+const snapSeparator = "_snap_"
+
+func (t T) snapSize() string {
+	return t.GetString("snap_size")
+}
+
+func (t *T) lv(vol pool.Volumer) *lvm2.LV {
+	return lvm2.NewLV(t.VGName(), pool.DiskName(t, vol), lvm2.WithLogger(pool.VolumeLogger(vol)))
+}
+
+func (t *T) snapLV(vol pool.Volumer, name string) *lvm2.LV {
+	lv := t.lv(vol)
+	return lvm2.NewLV(lv.VGName, lv.LVName+snapSeparator+name, lvm2.WithLogger(lv.Log()))
+}
+
+func (t *T) CreateSnapshot(vol pool.Volumer, name string) error {
+	lv := t.lv(vol)
+	info, err := lv.Show()
+	if err != nil {
+		return err
+	}
+	snap := t.snapLV(vol, name)
+	if v, err := snap.Exists(); err != nil {
+		return err
+	} else if v {
+		return fmt.Errorf("%s: %w", snap.FQN(), pool.ErrSnapshotExists)
+	}
+	return lv.CreateSnapshot(snap.LVName, t.snapSize(), info.IsThin())
+}
+
+func (t *T) DeleteSnapshot(vol pool.Volumer, name string) error {
+	snap, err := t.snapshot(vol, name)
+	if err != nil {
+		return err
+	}
+	return snap.Remove([]string{"-f"})
+}
+
+// RollbackSnapshot merges the snapshot into the volume logical volume.
+// The merge consumes the snapshot, so it is no longer listed after the
+// rollback.
+func (t *T) RollbackSnapshot(vol pool.Volumer, name string) error {
+	snap, err := t.snapshot(vol, name)
+	if err != nil {
+		return err
+	}
+	return snap.Merge()
+}
+
+func (t *T) Snapshots(vol pool.Volumer) (pool.Snapshots, error) {
+	lv := t.lv(vol)
+	infos, err := lv.Snapshots()
+	if err != nil {
+		return nil, err
+	}
+	prefix := lv.LVName + snapSeparator
+	l := make(pool.Snapshots, 0)
+	for _, info := range infos {
+		if !strings.HasPrefix(info.LVName, prefix) {
+			continue
+		}
+		s := pool.Snapshot{
+			Name: strings.TrimPrefix(info.LVName, prefix),
+			Used: info.Used(),
+		}
+		if tm, err := info.Time(); err == nil {
+			s.Created = tm
+		}
+		l = append(l, s)
+	}
+	sort.Sort(l)
+	return l, nil
+}
+
+// CloneSnapshot creates the clone logical volume. The clone of a thin
+// volume snapshot is a thin snapshot of the snapshot. The clone of a
+// non-thin volume snapshot is a full copy of the snapshot.
+func (t *T) CloneSnapshot(vol pool.Volumer, name string, clone pool.Volumer) error {
+	snap, err := t.snapshot(vol, name)
+	if err != nil {
+		return err
+	}
+	info, err := t.lv(vol).Show()
+	if err != nil {
+		return err
+	}
+	dst := t.lv(clone)
+	if info.IsThin() {
+		return snap.CreateSnapshot(dst.LVName, "", true)
+	}
+	size, err := info.Size()
+	if err != nil {
+		return err
+	}
+	if err := dst.Create(fmt.Sprintf("%dB", size), nil); err != nil {
+		return err
+	}
+	if err := snap.Activate(); err != nil {
+		return err
+	}
+	cmd := command.New(
+		command.WithName("dd"),
+		command.WithVarArgs("if="+snap.DevPath(), "of="+dst.DevPath(), "bs=4M", "conv=fsync"),
+		command.WithLogger(dst.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.InfoLevel),
+	)
+	return cmd.Run()
+}
+
+func (t *T) snapshot(vol pool.Volumer, name string) (*lvm2.LV, error) {
+	snap := t.snapLV(vol, name)
+	if v, err := snap.Exists(); err != nil {
+		return nil, err
+	} else if !v {
+		return nil, fmt.Errorf("%s: %w", snap.FQN(), pool.ErrSnapshotNotFound)
+	}
+	return snap, nil
+}
@@ -0,0 +1,93 @@
+//go:build linux || solaris
+
+package poolzpool
+
+import (
+	"fmt"
+
+	"github.com/opensvc/om3/core/pool"
+	"github.com/opensvc/om3/util/zfs"
+)
+
+func (t *T) dataset(vol pool.Volumer) *zfs.Filesystem {
+	return &zfs.Filesystem{
+		Name: t.poolName() + "/" + pool.DiskName(t, vol),
+		Log:  pool.VolumeLogger(vol),
+	}
+}
+
+func (t *T) CreateSnapshot(vol pool.Volumer, name string) error {
+	ds := t.dataset(vol)
+	snap := &zfs.Filesystem{Name: ds.Name + "@" + name, Log: ds.Log}
+	if v, err := snap.SnapshotExists(); err != nil {
+		return err
+	} else if v {
+		return fmt.Errorf("%s: %w", snap.Name, pool.ErrSnapshotExists)
+	}
+	return snap.Snapshot()
+}
+
+func (t *T) DeleteSnapshot(vol pool.Volumer, name string) error {
+	snap, err := t.snapshot(vol, name)
+	if err != nil {
+		return err
+	}
+	return snap.Destroy()
+}
+
+func (t *T) RollbackSnapshot(vol pool.Volumer, name string) error {
+	if _, err := t.snapshot(vol, name); err != nil {
+		return err
+	}
+	return t.dataset(vol).Rollback(name)
+}
+
+func (t *T) Snapshots(vol pool.Volumer) (pool.Snapshots, error) {
+	infos, err := t.dataset(vol).ListSnapshots()
+	if err != nil {
+		return nil, err
+	}
+	l := make(pool.Snapshots, len(infos))
+	for i, info := range infos {
+		l[i] = pool.Snapshot{
+			Name:    info.Name,
+			Created: info.Creation,
+			Used:    info.Used,
+		}
+	}
+	return l, nil
+}
+
+// CloneSnapshot creates the clone dataset as a zfs clone of the snapshot.
+// The clone depends on the snapshot, which can not be deleted until the
+// clone is deleted or promoted.
+func (t *T) CloneSnapshot(vol pool.Volumer, name string, clone pool.Volumer) error {
+	if _, err := t.snapshot(vol, name); err != nil {
+		return err
+	}
+	ds := t.dataset(vol)
+	dst := t.dataset(clone)
+	dsType, err := ds.GetProperty("type")
+	if err != nil {
+		return err
+	}
+	var props []string
+	if dsType == "filesystem" {
+		props = append(props,
+			"canmount=noauto",
+			"mountpoint="+pool.MountPointFromName(pool.DiskName(t, clone)),
+		)
+	}
+	return ds.Clone(name, dst.Name, props...)
+}
+
+func (t *T) snapshot(vol pool.Volumer, name string) (*zfs.Filesystem, error) {
+	ds := t.dataset(vol)
+	snap := &zfs.Filesystem{Name: ds.Name + "@" + name, Log: ds.Log}
+	if v, err := snap.SnapshotExists(); err != nil {
+		return nil, err
+	} else if !v {
+		return nil, fmt.Errorf("%s: %w", snap.Name, pool.ErrSnapshotNotFound)
+	}
+	return snap, nil
+}
@@ -26,7 +26,8 @@ type BaseT struct {
 	ObjectID     uuid.UUID      `json:"objectID"`
 }
 
-func (t T) getEnv() (env []string, err error) {
+// GetEnv returns the environment variables to set for the app commands.
+func (t T) GetEnv() (env []string, err error) {
 	var tempEnv []string
 	env = []string{
 		"OPENSVC_RID=" + t.RID(),
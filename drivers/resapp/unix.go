@@ -235,7 +235,7 @@ func (t T) GetFuncOpts(s string, action string) ([]funcopt.O, error) {
 	if err != nil || cmdArgs == nil {
 		return nil, err
 	}
-	env, err := t.getEnv()
+	env, err := t.GetEnv()
 	if err != nil {
 		return nil, err
 	}
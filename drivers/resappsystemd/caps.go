@@ -0,0 +1,19 @@
+//go:build linux
+
+package resappsystemd
+
+import (
+	"github.com/opensvc/om3/util/capabilities"
+	"github.com/opensvc/om3/util/systemd"
+)
+
+func init() {
+	capabilities.Register(capabilitiesScanner)
+}
+
+func capabilitiesScanner() ([]string, error) {
+	if !systemd.HasSystemd() {
+		return nil, nil
+	}
+	return []string{drvID.Cap()}, nil
+}
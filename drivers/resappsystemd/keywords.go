@@ -0,0 +1,33 @@
+//go:build linux
+
+package resappsystemd
+
+import (
+	"embed"
+
+	"github.com/opensvc/om3/core/keywords"
+	"github.com/opensvc/om3/util/converters"
+)
+
+var (
+	//go:embed text
+	fs embed.FS
+
+	Keywords = []keywords.Keyword{
+		{
+			Attr:     "Unit",
+			Example:  "nginx.service",
+			Option:   "unit",
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/unit"),
+		},
+		{
+			Attr:      "StartTimeout",
+			Converter: converters.Duration,
+			Example:   "180",
+			Option:    "start_timeout",
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/start_timeout"),
+		},
+	}
+)
@@ -0,0 +1,316 @@
+//go:build linux
+
+package resappsystemd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/coreos/go-systemd/v22/dbus"
+	"github.com/coreos/go-systemd/v22/unit"
+	godbus "github.com/godbus/dbus/v5"
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/core/actionrollback"
+	"github.com/opensvc/om3/core/resource"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/drivers/resapp"
+	"github.com/opensvc/om3/util/command"
+)
+
+// T is the driver structure.
+type T struct {
+	resapp.T
+	Unit string `json:"unit"`
+}
+
+type (
+	unitState struct {
+		LoadState   string
+		ActiveState string
+		SubState    string
+	}
+)
+
+func New() resource.Driver {
+	return &T{}
+}
+
+// unitName returns the name of the managed unit: the unit keyword value,
+// or the name of the transient unit derived from the object path and the
+// resource id.
+func (t T) unitName() string {
+	if t.Unit != "" {
+		return t.Unit
+	}
+	s := fmt.Sprintf("opensvc/%s/%s/%s/%s", t.Path.Namespace, t.Path.Kind, t.Path.Name, strings.ReplaceAll(t.RID(), "#", "."))
+	return unit.UnitNameEscape(s) + ".service"
+}
+
+func (t T) isTransient() bool {
+	return t.Unit == ""
+}
+
+// transientProperties returns the properties of the transient unit,
+// converted from the start, environment, cwd, user, group, umask and
+// limit_* keywords.
+func (t T) transientProperties() ([]dbus.Property, error) {
+	argv, err := t.BaseCmdArgs(t.StartCmd, "start")
+	if err != nil {
+		return nil, err
+	}
+	if len(argv) == 0 {
+		return nil, fmt.Errorf("the unit or start keyword must be set")
+	}
+	env, err := t.GetEnv()
+	if err != nil {
+		return nil, err
+	}
+	props := []dbus.Property{
+		dbus.PropDescription(fmt.Sprintf("OpenSVC %s %s", t.Path, t.RID())),
+		dbus.PropType("simple"),
+		dbus.PropExecStart(argv, true),
+		{Name: "Environment", Value: godbus.MakeVariant(env)},
+	}
+	if t.Cwd != "" {
+		props = append(props, dbus.Property{Name: "WorkingDirectory", Value: godbus.MakeVariant(t.Cwd)})
+	}
+	if t.User != "" {
+		props = append(props, dbus.Property{Name: "User", Value: godbus.MakeVariant(t.User)})
+	}
+	if t.Group != "" {
+		props = append(props, dbus.Property{Name: "Group", Value: godbus.MakeVariant(t.Group)})
+	}
+	if t.Umask != nil {
+		props = append(props, dbus.Property{Name: "UMask", Value: godbus.MakeVariant(uint32(*t.Umask))})
+	}
+	addLimit := func(name string, v *int64) {
+		if v == nil || *v < 0 {
+			return
+		}
+		props = append(props,
+			dbus.Property{Name: name, Value: godbus.MakeVariant(uint64(*v))},
+			dbus.Property{Name: name + "Soft", Value: godbus.MakeVariant(uint64(*v))},
+		)
+	}
+	if t.Limit.CPU != nil {
+		seconds := int64(t.Limit.CPU.Seconds())
+		addLimit("LimitCPU", &seconds)
+	}
+	addLimit("LimitFSIZE", t.Limit.FSize)
+	addLimit("LimitDATA", t.Limit.Data)
+	addLimit("LimitSTACK", t.Limit.Stack)
+	addLimit("LimitCORE", t.Limit.Core)
+	addLimit("LimitNOFILE", t.Limit.NoFile)
+	addLimit("LimitMEMLOCK", t.Limit.MemLock)
+	addLimit("LimitNPROC", t.Limit.NProc)
+	addLimit("LimitRSS", t.Limit.RSS)
+	if t.Limit.AS != nil {
+		addLimit("LimitAS", t.Limit.AS)
+	} else {
+		addLimit("LimitAS", t.Limit.VMem)
+	}
+	return props, nil
+}
+
+// Start the Resource
+func (t *T) Start(ctx context.Context) error {
+	conn, err := dbus.NewSystemConnectionContext(ctx)
+	if err != nil {
+		return fmt.Errorf("systemd dbus connect: %w", err)
+	}
+	defer conn.Close()
+
+	name := t.unitName()
+	state, err := t.getUnitState(ctx, conn)
+	if err != nil {
+		return err
+	}
+	if stateToStatus(state) == status.Up {
+		t.Log().Infof("%s already up", name)
+		return nil
+	}
+
+	since := time.Now()
+	defer t.logJournal(since)
+
+	ch := make(chan string, 1)
+	if t.isTransient() {
+		props, err := t.transientProperties()
+		if err != nil {
+			return err
+		}
+		if state.ActiveState == "failed" {
+			// a failed transient unit stays loaded, preventing the
+			// creation of a new unit with the same name.
+			if err := conn.ResetFailedUnitContext(ctx, name); err != nil {
+				return fmt.Errorf("reset failed unit %s: %w", name, err)
+			}
+		}
+		t.Log().Infof("start transient unit %s", name)
+		if _, err := conn.StartTransientUnitContext(ctx, name, "replace", props, ch); err != nil {
+			return fmt.Errorf("start transient unit %s: %w", name, err)
+		}
+	} else {
+		t.Log().Infof("start unit %s", name)
+		if _, err := conn.StartUnitContext(ctx, name, "replace", ch); err != nil {
+			return fmt.Errorf("start unit %s: %w", name, err)
+		}
+	}
+	if err := t.waitJob(ctx, "start", ch); err != nil {
+		return err
+	}
+	actionrollback.Register(ctx, func() error {
+		return t.Stop(ctx)
+	})
+	return nil
+}
+
+// Stop the Resource
+func (t *T) Stop(ctx context.Context) error {
+	conn, err := dbus.NewSystemConnectionContext(ctx)
+	if err != nil {
+		return fmt.Errorf("systemd dbus connect: %w", err)
+	}
+	defer conn.Close()
+
+	name := t.unitName()
+	state, err := t.getUnitState(ctx, conn)
+	if err != nil {
+		return err
+	}
+	if state.ActiveState == "inactive" || state.ActiveState == "failed" {
+		t.Log().Infof("%s already down", name)
+		return nil
+	}
+
+	since := time.Now()
+	defer t.logJournal(since)
+
+	ch := make(chan string, 1)
+	t.Log().Infof("stop unit %s", name)
+	if _, err := conn.StopUnitContext(ctx, name, "replace", ch); err != nil {
+		return fmt.Errorf("stop unit %s: %w", name, err)
+	}
+	return t.waitJob(ctx, "stop", ch)
+}
+
+// waitJob waits for the result of the unit job started by the action,
+// within the action timeout.
+func (t *T) waitJob(ctx context.Context, action string, ch <-chan string) error {
+	if timeout := t.GetTimeout(action); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	select {
+	case result := <-ch:
+		if result != "done" {
+			return fmt.Errorf("%s unit %s: job %s", action, t.unitName(), result)
+		}
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("%s unit %s: %w", action, t.unitName(), ctx.Err())
+	}
+}
+
+func (t *T) getUnitState(ctx context.Context, conn *dbus.Conn) (unitState, error) {
+	var state unitState
+	props, err := conn.GetUnitPropertiesContext(ctx, t.unitName())
+	if err != nil {
+		return state, fmt.Errorf("get unit %s properties: %w", t.unitName(), err)
+	}
+	state.LoadState, _ = props["LoadState"].(string)
+	state.ActiveState, _ = props["ActiveState"].(string)
+	state.SubState, _ = props["SubState"].(string)
+	return state, nil
+}
+
+// stateToStatus returns the resource status of the unit state.
+func stateToStatus(state unitState) status.T {
+	switch state.ActiveState {
+	case "active", "reloading":
+		return status.Up
+	case "inactive", "failed":
+		return status.Down
+	case "activating", "deactivating":
+		return status.Warn
+	default:
+		return status.Undef
+	}
+}
+
+func (t *T) Status(ctx context.Context) status.T {
+	conn, err := dbus.NewSystemConnectionContext(ctx)
+	if err != nil {
+		t.StatusLog().Error("systemd dbus connect: %s", err)
+		return status.Undef
+	}
+	defer conn.Close()
+
+	state, err := t.getUnitState(ctx, conn)
+	if err != nil {
+		t.StatusLog().Error("%s", err)
+		return status.Undef
+	}
+	switch {
+	case !t.isTransient() && state.LoadState == "not-found":
+		t.StatusLog().Warn("unit %s not found", t.unitName())
+	case state.ActiveState == "failed":
+		t.StatusLog().Warn("unit %s failed (%s)", t.unitName(), state.SubState)
+	case state.ActiveState == "activating", state.ActiveState == "deactivating":
+		t.StatusLog().Info("unit %s %s (%s)", t.unitName(), state.ActiveState, state.SubState)
+	case t.StatusLogKw:
+		t.StatusLog().Info("%s (%s)", state.ActiveState, state.SubState)
+	}
+	return stateToStatus(state)
+}
+
+// logJournal adds to the resource log the unit journal lines logged since
+// the action began.
+func (t *T) logJournal(since time.Time) {
+	cmd := command.New(
+		command.WithName("journalctl"),
+		command.WithVarArgs(
+			"--unit", t.unitName(),
+			"--since", fmt.Sprintf("@%d", since.Unix()),
+			"--no-pager",
+			"--quiet",
+			"--output", "cat",
+		),
+		command.WithLogger(t.Log()),
+		command.WithStdoutLogLevel(zerolog.Disabled),
+		command.WithStderrLogLevel(zerolog.DebugLevel),
+		command.WithErrorExitCodeLogLevel(zerolog.DebugLevel),
+		command.WithOnStdoutLine(func(s string) {
+			t.Log().Infof("journal: %s", s)
+		}),
+	)
+	if err := cmd.Run(); err != nil {
+		t.Log().Debugf("read unit %s journal: %s", t.unitName(), err)
+	}
+}
+
+func (t *T) Info(ctx context.Context) (resource.InfoKeys, error) {
+	durationToString := func(duration *time.Duration) string {
+		if duration == nil {
+			return ""
+		}
+		return duration.String()
+	}
+	return resource.InfoKeys{
+		{Key: "unit", Value: t.unitName()},
+		{Key: "transient", Value: fmt.Sprint(t.isTransient())},
+		{Key: "start", Value: t.StartCmd},
+		{Key: "timeout", Value: durationToString(t.Timeout)},
+		{Key: "start_timeout", Value: durationToString(t.StartTimeout)},
+		{Key: "stop_timeout", Value: durationToString(t.StopTimeout)},
+	}, nil
+}
+
+// Label returns a formatted short description of the Resource
+func (t T) Label() string {
+	return t.unitName()
+}
@@ -0,0 +1,87 @@
+//go:build linux
+
+package resappsystemd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/core/resource"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/testhelper"
+)
+
+func getAppRid(rid string, resources []resource.Driver) *T {
+	for _, res := range resources {
+		if r, ok := res.(*T); ok && r.ResourceID.Name == rid {
+			return r
+		}
+	}
+	return nil
+}
+
+func TestKeywords(t *testing.T) {
+	env := testhelper.Setup(t)
+	env.InstallFile("../../testdata/cluster.conf", "etc/cluster.conf")
+	env.InstallFile("test-fixtures/svc1.conf", "etc/svc1.conf")
+	object.SetClusterConfig()
+	defer rawconfig.ReloadForTest(env.Root)()
+	p, err := naming.ParsePath("svc1")
+	require.Nil(t, err)
+	o, err := object.NewSvc(p)
+	require.Nil(t, err)
+	resources := o.Resources()
+
+	t.Run("named unit", func(t *testing.T) {
+		app := getAppRid("app#1", resources)
+		require.NotNil(t, app)
+		assert.Equal(t, "nginx.service", app.unitName())
+		assert.False(t, app.isTransient())
+		assert.Equal(t, "nginx.service", app.Label())
+	})
+
+	t.Run("transient unit", func(t *testing.T) {
+		app := getAppRid("app#2", resources)
+		require.NotNil(t, app)
+		assert.True(t, app.isTransient())
+		assert.Equal(t, `opensvc-root-svc-svc1-app.2.service`, app.unitName())
+		assert.Equal(t, time.Minute, *app.StartTimeout)
+
+		props, err := app.transientProperties()
+		require.Nil(t, err)
+		m := make(map[string]any)
+		for _, prop := range props {
+			m[prop.Name] = prop.Value.Value()
+		}
+		assert.Equal(t, "simple", m["Type"])
+		assert.Equal(t, "/tmp", m["WorkingDirectory"])
+		assert.Equal(t, "nobody", m["User"])
+		assert.Equal(t, uint64(128), m["LimitNOFILE"])
+		assert.Equal(t, uint64(128), m["LimitNOFILESoft"])
+		assert.Equal(t, uint64(60), m["LimitCPU"])
+		assert.Contains(t, m["Environment"], "FOO=foo")
+		assert.Contains(t, m["Environment"], "OPENSVC_RID=app#2")
+		assert.NotContains(t, m, "Group")
+	})
+}
+
+func TestStateToStatus(t *testing.T) {
+	cases := map[string]status.T{
+		"active":       status.Up,
+		"reloading":    status.Up,
+		"inactive":     status.Down,
+		"failed":       status.Down,
+		"activating":   status.Warn,
+		"deactivating": status.Warn,
+		"":             status.Undef,
+	}
+	for activeState, expected := range cases {
+		assert.Equal(t, expected, stateToStatus(unitState{ActiveState: activeState}), activeState)
+	}
+}
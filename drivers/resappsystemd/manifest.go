@@ -0,0 +1,56 @@
+//go:build linux
+
+package resappsystemd
+
+import (
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/core/manifest"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/drivers/resapp"
+)
+
+var (
+	drvID = driver.NewID(driver.GroupApp, "systemd")
+)
+
+func init() {
+	driver.Register(drvID, New)
+}
+
+// Manifest ...
+func (t T) Manifest() *manifest.T {
+	m := manifest.New(drvID, &t)
+	m.Kinds.Or(naming.KindSvc)
+	m.Add(
+		manifest.ContextObjectPath,
+		manifest.ContextNodes,
+		manifest.ContextObjectID,
+	)
+	m.AddKeywords(
+		resapp.BaseKeywordTimeout,
+		resapp.BaseKeywordStopTimeout,
+		resapp.BaseKeywordSecretsEnv,
+		resapp.BaseKeywordConfigsEnv,
+		resapp.BaseKeywordEnv,
+		resapp.BaseKeywordUmask,
+		resapp.UnixKeywordScriptPath,
+		resapp.UnixKeywordStartCmd,
+		resapp.UnixKeywordStatusLogKw,
+		resapp.UnixKeywordCwd,
+		resapp.UnixKeywordUser,
+		resapp.UnixKeywordGroup,
+		resapp.UnixKeywordLimitCPU,
+		resapp.UnixKeywordLimitCore,
+		resapp.UnixKeywordLimitData,
+		resapp.UnixKeywordLimitFSize,
+		resapp.UnixKeywordLimitMemLock,
+		resapp.UnixKeywordLimitNoFile,
+		resapp.UnixKeywordLimitNProc,
+		resapp.UnixKeywordLimitRSS,
+		resapp.UnixKeywordLimitStack,
+		resapp.UnixKeywordLimitVmem,
+		resapp.UnixKeywordLimitAS,
+	)
+	m.AddKeywords(Keywords...)
+	return m
+}
@@ -0,0 +1,10 @@
+// Package rescheck provides bases for the check drivers.
+//
+// A check resource probes a service endpoint, and contributes the probe
+// result to the instance availability. Unlike an app check script, the
+// probe verifies the service answers, not only that its process is alive.
+//
+// The check is only probed after the resource start, so a service answering
+// for another instance, like a shared virtual ip, does not make a stopped
+// instance available.
+package rescheck
@@ -0,0 +1,45 @@
+package rescheck
+
+import (
+	"embed"
+
+	"github.com/opensvc/om3/core/keywords"
+	"github.com/opensvc/om3/util/converters"
+)
+
+var (
+	//go:embed text
+	fs embed.FS
+
+	KeywordTimeout = keywords.Keyword{
+		Attr:      "Timeout",
+		Converter: converters.Duration,
+		Default:   "5s",
+		Example:   "10s",
+		Option:    "timeout",
+		Scopable:  true,
+		Text:      keywords.NewText(fs, "text/kw/timeout"),
+	}
+	KeywordMaxLatency = keywords.Keyword{
+		Attr:      "MaxLatency",
+		Converter: converters.Duration,
+		Example:   "500ms",
+		Option:    "max_latency",
+		Scopable:  true,
+		Text:      keywords.NewText(fs, "text/kw/max_latency"),
+	}
+	KeywordStartTimeout = keywords.Keyword{
+		Attr:      "StartTimeout",
+		Converter: converters.Duration,
+		Example:   "1m",
+		Option:    "start_timeout",
+		Scopable:  true,
+		Text:      keywords.NewText(fs, "text/kw/start_timeout"),
+	}
+
+	BaseKeywords = []keywords.Keyword{
+		KeywordTimeout,
+		KeywordMaxLatency,
+		KeywordStartTimeout,
+	}
+)
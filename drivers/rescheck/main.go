@@ -0,0 +1,211 @@
+package rescheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opensvc/om3/core/provisioned"
+	"github.com/opensvc/om3/core/resource"
+	"github.com/opensvc/om3/core/status"
+)
+
+type (
+	// T is the base of the check drivers.
+	T struct {
+		resource.T
+		Timeout      *time.Duration `json:"timeout"`
+		MaxLatency   *time.Duration `json:"max_latency"`
+		StartTimeout *time.Duration `json:"start_timeout"`
+	}
+
+	// ProbeFunc probes the checked service, and returns an error
+	// explaining the failure if the service does not answer as expected.
+	ProbeFunc func(ctx context.Context) error
+)
+
+const (
+	defaultTimeout = 5 * time.Second
+
+	// startProbeInterval is the interval between two probes during the
+	// resource start.
+	startProbeInterval = time.Second
+)
+
+// StartWith installs the started flag, and waits for the probe to succeed
+// if the start_timeout keyword is set.
+func (t *T) StartWith(ctx context.Context, probe ProbeFunc) error {
+	if err := t.installFlag(); err != nil {
+		return err
+	}
+	if t.StartTimeout == nil || *t.StartTimeout <= 0 {
+		return nil
+	}
+	t.Log().Infof("wait for the check to succeed (%s)", *t.StartTimeout)
+	limit := time.Now().Add(*t.StartTimeout)
+	for {
+		_, err := t.Check(ctx, probe)
+		if err == nil {
+			t.Log().Infof("check succeeded")
+			return nil
+		}
+		if time.Now().Add(startProbeInterval).After(limit) {
+			return fmt.Errorf("check still failing after %s: %w", *t.StartTimeout, err)
+		}
+		t.Log().Debugf("check failed: %s", err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(startProbeInterval):
+		}
+	}
+}
+
+// Stop uninstalls the started flag, so the check is no longer probed.
+func (t *T) Stop(ctx context.Context) error {
+	p := t.flagFile()
+	if _, err := os.Stat(p); errors.Is(err, os.ErrNotExist) {
+		t.Log().Infof("check is already stopped")
+		return nil
+	}
+	t.Log().Infof("uninstall the check flag %s", p)
+	return os.Remove(p)
+}
+
+// StatusWith returns status.Down if the resource is not started, and the
+// probe result otherwise.
+func (t *T) StatusWith(ctx context.Context, probe ProbeFunc) status.T {
+	if !t.isStarted() {
+		return status.Down
+	}
+	latency, err := t.Check(ctx, probe)
+	if err != nil {
+		t.StatusLog().Warn("%s", err)
+		return status.Down
+	}
+	t.Log().Debugf("check succeeded in %s", latency)
+	return status.Up
+}
+
+// Check runs the probe with the configured timeout, and returns its
+// latency. A probe exceeding the max_latency is a failure.
+func (t *T) Check(ctx context.Context, probe ProbeFunc) (time.Duration, error) {
+	timeout := defaultTimeout
+	if t.Timeout != nil && *t.Timeout > 0 {
+		timeout = *t.Timeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	begin := time.Now()
+	err := probe(ctx)
+	latency := time.Since(begin)
+	switch {
+	case err != nil && (errors.Is(ctx.Err(), context.DeadlineExceeded) || errors.Is(err, os.ErrDeadlineExceeded)):
+		return latency, fmt.Errorf("timeout after %s: %w", timeout, err)
+	case err != nil:
+		return latency, err
+	case t.MaxLatency != nil && *t.MaxLatency > 0 && latency > *t.MaxLatency:
+		return latency, fmt.Errorf("latency %s exceeds max_latency %s", latency.Round(time.Millisecond), *t.MaxLatency)
+	}
+	return latency, nil
+}
+
+func (t *T) Provision(ctx context.Context) error {
+	return nil
+}
+
+func (t *T) Unprovision(ctx context.Context) error {
+	return nil
+}
+
+func (t *T) Provisioned() (provisioned.T, error) {
+	return provisioned.NotApplicable, nil
+}
+
+// BaseInfo returns the info keys of the base keywords.
+func (t *T) BaseInfo() resource.InfoKeys {
+	durationToString := func(d *time.Duration) string {
+		if d == nil {
+			return ""
+		}
+		return d.String()
+	}
+	return resource.InfoKeys{
+		{Key: "timeout", Value: durationToString(t.Timeout)},
+		{Key: "max_latency", Value: durationToString(t.MaxLatency)},
+		{Key: "start_timeout", Value: durationToString(t.StartTimeout)},
+	}
+}
+
+func (t *T) flagFile() string {
+	return filepath.Join(t.VarDir(), "started")
+}
+
+func (t *T) isStarted() bool {
+	_, err := os.Stat(t.flagFile())
+	return err == nil
+}
+
+func (t *T) installFlag() error {
+	p := t.flagFile()
+	if t.isStarted() {
+		return nil
+	}
+	t.Log().Infof("install the check flag %s", p)
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(p, nil, 0600)
+}
+
+// MatchCode returns true if code matches one of the patterns. A pattern
+// is a code like "200", a code class like "2xx", or a code range like
+// "200-299".
+func MatchCode(patterns []string, code int) (bool, error) {
+	for _, pattern := range patterns {
+		ok, err := matchCode(pattern, code)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func matchCode(pattern string, code int) (bool, error) {
+	invalid := func() (bool, error) {
+		return false, fmt.Errorf("invalid code pattern '%s'", pattern)
+	}
+	switch {
+	case strings.HasSuffix(strings.ToLower(pattern), "xx"):
+		class, err := strconv.Atoi(strings.TrimSuffix(strings.ToLower(pattern), "xx"))
+		if err != nil {
+			return invalid()
+		}
+		return code/100 == class, nil
+	case strings.Contains(pattern, "-"):
+		low, high, _ := strings.Cut(pattern, "-")
+		l, err := strconv.Atoi(low)
+		if err != nil {
+			return invalid()
+		}
+		h, err := strconv.Atoi(high)
+		if err != nil {
+			return invalid()
+		}
+		return l <= code && code <= h, nil
+	default:
+		i, err := strconv.Atoi(pattern)
+		if err != nil {
+			return invalid()
+		}
+		return i == code, nil
+	}
+}
@@ -0,0 +1,82 @@
+package rescheck
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchCode(t *testing.T) {
+	cases := []struct {
+		patterns []string
+		code     int
+		ok       bool
+		err      bool
+	}{
+		{patterns: []string{"200"}, code: 200, ok: true},
+		{patterns: []string{"200"}, code: 201},
+		{patterns: []string{"2xx"}, code: 204, ok: true},
+		{patterns: []string{"2XX"}, code: 302},
+		{patterns: []string{"2xx", "3xx"}, code: 302, ok: true},
+		{patterns: []string{"200-299"}, code: 299, ok: true},
+		{patterns: []string{"200-299"}, code: 300},
+		{patterns: []string{"0"}, code: 0, ok: true},
+		{patterns: []string{"abc"}, code: 0, err: true},
+		{patterns: []string{"1-b"}, code: 0, err: true},
+		{patterns: []string{"yxx"}, code: 0, err: true},
+	}
+	for _, tc := range cases {
+		ok, err := MatchCode(tc.patterns, tc.code)
+		if tc.err {
+			require.Error(t, err, "%v %d", tc.patterns, tc.code)
+			continue
+		}
+		require.NoError(t, err, "%v %d", tc.patterns, tc.code)
+		require.Equal(t, tc.ok, ok, "%v %d", tc.patterns, tc.code)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	sleep := func(d time.Duration) ProbeFunc {
+		return func(ctx context.Context) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(d):
+				return nil
+			}
+		}
+	}
+	duration := func(d time.Duration) *time.Duration {
+		return &d
+	}
+
+	t.Run("success", func(t *testing.T) {
+		r := &T{}
+		_, err := r.Check(context.Background(), sleep(0))
+		require.NoError(t, err)
+	})
+
+	t.Run("probe error", func(t *testing.T) {
+		r := &T{}
+		errProbe := errors.New("probe failed")
+		_, err := r.Check(context.Background(), func(context.Context) error { return errProbe })
+		require.ErrorIs(t, err, errProbe)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		r := &T{Timeout: duration(50 * time.Millisecond)}
+		_, err := r.Check(context.Background(), sleep(time.Second))
+		require.ErrorContains(t, err, "timeout after 50ms")
+	})
+
+	t.Run("max latency", func(t *testing.T) {
+		r := &T{MaxLatency: duration(10 * time.Millisecond)}
+		latency, err := r.Check(context.Background(), sleep(50*time.Millisecond))
+		require.ErrorContains(t, err, "exceeds max_latency")
+		require.GreaterOrEqual(t, latency, 50*time.Millisecond)
+	})
+}
@@ -0,0 +1,98 @@
+package rescheckexec
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/core/resource"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/drivers/rescheck"
+	"github.com/opensvc/om3/util/command"
+	"github.com/opensvc/om3/util/funcopt"
+)
+
+type (
+	T struct {
+		rescheck.T
+		Command        []string `json:"command"`
+		ExpectedStatus []string `json:"expected_status"`
+		ExpectedBody   string   `json:"expected_body"`
+	}
+)
+
+func New() resource.Driver {
+	t := &T{}
+	return t
+}
+
+func (t *T) Start(ctx context.Context) error {
+	return t.StartWith(ctx, t.probe)
+}
+
+func (t *T) Status(ctx context.Context) status.T {
+	if len(t.Command) == 0 {
+		t.StatusLog().Warn("command not set")
+		return status.NotApplicable
+	}
+	return t.StatusWith(ctx, t.probe)
+}
+
+func (t *T) Label() string {
+	return strings.Join(t.Command, " ")
+}
+
+func (t *T) Info(ctx context.Context) (resource.InfoKeys, error) {
+	m := resource.InfoKeys{
+		{Key: "command", Value: strings.Join(t.Command, " ")},
+		{Key: "expected_status", Value: strings.Join(t.ExpectedStatus, " ")},
+		{Key: "expected_body", Value: t.ExpectedBody},
+	}
+	return append(m, t.BaseInfo()...), nil
+}
+
+func (t *T) probe(ctx context.Context) error {
+	if len(t.Command) == 0 {
+		return fmt.Errorf("command not set")
+	}
+	var re *regexp.Regexp
+	if t.ExpectedBody != "" {
+		var err error
+		if re, err = regexp.Compile(t.ExpectedBody); err != nil {
+			return fmt.Errorf("invalid expected_body: %w", err)
+		}
+	}
+	opts := []funcopt.O{
+		command.WithName(t.Command[0]),
+		command.WithArgs(t.Command[1:]),
+		command.WithLogger(t.Log()),
+		command.WithStdoutLogLevel(zerolog.DebugLevel),
+		command.WithStderrLogLevel(zerolog.DebugLevel),
+		command.WithIgnoredExitCodes(),
+		command.WithBufferedStdout(),
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		opts = append(opts, command.WithTimeout(time.Until(deadline)))
+	}
+	cmd := command.New(opts...)
+	if err := cmd.Run(); err != nil {
+		return err
+	}
+	expectedStatus := t.ExpectedStatus
+	if len(expectedStatus) == 0 {
+		expectedStatus = []string{"0"}
+	}
+	if ok, err := rescheck.MatchCode(expectedStatus, cmd.ExitCode()); err != nil {
+		return err
+	} else if !ok {
+		return fmt.Errorf("%s: exit code %d, expected %s", cmd, cmd.ExitCode(), strings.Join(expectedStatus, " "))
+	}
+	if re != nil && !re.Match(cmd.Stdout()) {
+		return fmt.Errorf("%s: output does not match %s", cmd, t.ExpectedBody)
+	}
+	return nil
+}
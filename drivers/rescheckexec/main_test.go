@@ -0,0 +1,73 @@
+package rescheckexec
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbe(t *testing.T) {
+	cases := map[string]struct {
+		command        []string
+		expectedStatus []string
+		expectedBody   string
+		ok             bool
+	}{
+		"success": {
+			command: []string{"true"},
+			ok:      true,
+		},
+		"failure": {
+			command: []string{"false"},
+		},
+		"expected failure": {
+			command:        []string{"false"},
+			expectedStatus: []string{"1"},
+			ok:             true,
+		},
+		"exit code range": {
+			command:        []string{"sh", "-c", "exit 3"},
+			expectedStatus: []string{"0-4"},
+			ok:             true,
+		},
+		"output match": {
+			command:      []string{"echo", "accepting connections"},
+			expectedBody: "^accepting",
+			ok:           true,
+		},
+		"output mismatch": {
+			command:      []string{"echo", "no response"},
+			expectedBody: "^accepting",
+		},
+		"not found": {
+			command: []string{"/nonexistent/check"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := New().(*T)
+			r.Command = tc.command
+			r.ExpectedStatus = tc.expectedStatus
+			r.ExpectedBody = tc.expectedBody
+			err := r.probe(context.Background())
+			if tc.ok {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestProbeTimeout(t *testing.T) {
+	r := New().(*T)
+	r.Command = []string{"sleep", "10"}
+	timeout := 100 * time.Millisecond
+	r.Timeout = &timeout
+	begin := time.Now()
+	_, err := r.Check(context.Background(), r.probe)
+	require.Error(t, err)
+	require.Less(t, time.Since(begin), 5*time.Second)
+}
@@ -0,0 +1,58 @@
+package rescheckexec
+
+import (
+	"embed"
+
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/core/keywords"
+	"github.com/opensvc/om3/core/manifest"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/drivers/rescheck"
+	"github.com/opensvc/om3/util/converters"
+)
+
+var (
+	//go:embed text
+	fs embed.FS
+
+	drvID = driver.NewID(driver.GroupCheck, "exec")
+)
+
+func init() {
+	driver.Register(drvID, New)
+}
+
+// Manifest exposes to the core the input expected by the driver.
+func (t *T) Manifest() *manifest.T {
+	m := manifest.New(drvID, t)
+	m.Kinds.Or(naming.KindSvc, naming.KindVol)
+	m.Add(
+		keywords.Keyword{
+			Attr:      "Command",
+			Converter: converters.Shlex,
+			Example:   "/usr/bin/pg_isready -h {fqdn}",
+			Option:    "command",
+			Required:  true,
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/command"),
+		},
+		keywords.Keyword{
+			Attr:      "ExpectedStatus",
+			Converter: converters.List,
+			Default:   "0",
+			Example:   "0-1",
+			Option:    "expected_status",
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/expected_status"),
+		},
+		keywords.Keyword{
+			Attr:     "ExpectedBody",
+			Example:  "accepting connections",
+			Option:   "expected_body",
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/expected_body"),
+		},
+	)
+	m.AddKeywords(rescheck.BaseKeywords...)
+	return m
+}
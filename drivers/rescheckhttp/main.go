@@ -0,0 +1,129 @@
+package rescheckhttp
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/opensvc/om3/core/resource"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/drivers/rescheck"
+)
+
+type (
+	T struct {
+		rescheck.T
+		URL            string   `json:"url"`
+		Method         string   `json:"method"`
+		Headers        []string `json:"headers"`
+		Insecure       bool     `json:"insecure"`
+		ExpectedStatus []string `json:"expected_status"`
+		ExpectedBody   string   `json:"expected_body"`
+	}
+)
+
+// maxBodySize is the size of the beginning of the response body matched
+// against the expected_body regular expression.
+const maxBodySize = 1024 * 1024
+
+func New() resource.Driver {
+	t := &T{}
+	return t
+}
+
+func (t *T) Start(ctx context.Context) error {
+	return t.StartWith(ctx, t.probe)
+}
+
+func (t *T) Status(ctx context.Context) status.T {
+	if t.URL == "" {
+		t.StatusLog().Warn("url not set")
+		return status.NotApplicable
+	}
+	return t.StatusWith(ctx, t.probe)
+}
+
+func (t *T) Label() string {
+	return t.method() + " " + t.URL
+}
+
+func (t *T) Info(ctx context.Context) (resource.InfoKeys, error) {
+	m := resource.InfoKeys{
+		{Key: "url", Value: t.URL},
+		{Key: "method", Value: t.method()},
+		{Key: "expected_status", Value: strings.Join(t.ExpectedStatus, " ")},
+		{Key: "expected_body", Value: t.ExpectedBody},
+		{Key: "insecure", Value: strconv.FormatBool(t.Insecure)},
+	}
+	return append(m, t.BaseInfo()...), nil
+}
+
+func (t *T) method() string {
+	if t.Method == "" {
+		return http.MethodGet
+	}
+	return t.Method
+}
+
+func (t *T) probe(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, t.method(), t.URL, nil)
+	if err != nil {
+		return err
+	}
+	for _, header := range t.Headers {
+		name, value, ok := strings.Cut(header, ":")
+		if !ok {
+			return fmt.Errorf("invalid header '%s': expected <name>: <value>", header)
+		}
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if strings.EqualFold(name, "host") {
+			req.Host = value
+		} else {
+			req.Header.Add(name, value)
+		}
+	}
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig:   &tls.Config{InsecureSkipVerify: t.Insecure},
+			DisableKeepAlives: true,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	expectedStatus := t.ExpectedStatus
+	if len(expectedStatus) == 0 {
+		expectedStatus = []string{"2xx"}
+	}
+	if ok, err := rescheck.MatchCode(expectedStatus, resp.StatusCode); err != nil {
+		return err
+	} else if !ok {
+		return fmt.Errorf("%s %s: status %d, expected %s", t.method(), t.URL, resp.StatusCode, strings.Join(expectedStatus, " "))
+	}
+	if t.ExpectedBody == "" {
+		return nil
+	}
+	re, err := regexp.Compile(t.ExpectedBody)
+	if err != nil {
+		return fmt.Errorf("invalid expected_body: %w", err)
+	}
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	if err != nil {
+		return err
+	}
+	if !re.Match(b) {
+		return fmt.Errorf("%s %s: body does not match %s", t.method(), t.URL, t.ExpectedBody)
+	}
+	return nil
+}
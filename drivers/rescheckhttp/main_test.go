@@ -0,0 +1,98 @@
+package rescheckhttp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbe(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/healthz":
+			if r.Header.Get("X-Token") != "secret" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			_, _ = w.Write([]byte(`{"status": "ok"}`))
+		case "/moved":
+			http.Redirect(w, r, "/healthz", http.StatusFound)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	cases := map[string]struct {
+		path           string
+		headers        []string
+		expectedStatus []string
+		expectedBody   string
+		ok             bool
+	}{
+		"match": {
+			path:         "/healthz",
+			headers:      []string{"X-Token: secret"},
+			expectedBody: `"status":\s*"ok"`,
+			ok:           true,
+		},
+		"missing header": {
+			path: "/healthz",
+		},
+		"body mismatch": {
+			path:         "/healthz",
+			headers:      []string{"X-Token: secret"},
+			expectedBody: `"status":\s*"ko"`,
+		},
+		"not found": {
+			path: "/notfound",
+		},
+		"expected not found": {
+			path:           "/notfound",
+			expectedStatus: []string{"404"},
+			ok:             true,
+		},
+		"redirection not followed": {
+			path: "/moved",
+		},
+		"redirection accepted": {
+			path:           "/moved",
+			expectedStatus: []string{"2xx", "3xx"},
+			ok:             true,
+		},
+		"invalid header": {
+			path:    "/healthz",
+			headers: []string{"X-Token"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := New().(*T)
+			r.URL = server.URL + tc.path
+			r.Headers = tc.headers
+			r.ExpectedStatus = tc.expectedStatus
+			r.ExpectedBody = tc.expectedBody
+			err := r.probe(context.Background())
+			if tc.ok {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestProbeInsecure(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	r := New().(*T)
+	r.URL = server.URL
+	require.Error(t, r.probe(context.Background()), "self-signed certificate must be rejected")
+
+	r.Insecure = true
+	require.NoError(t, r.probe(context.Background()))
+}
@@ -0,0 +1,81 @@
+package rescheckhttp
+
+import (
+	"embed"
+
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/core/keywords"
+	"github.com/opensvc/om3/core/manifest"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/drivers/rescheck"
+	"github.com/opensvc/om3/util/converters"
+)
+
+var (
+	//go:embed text
+	fs embed.FS
+
+	drvID = driver.NewID(driver.GroupCheck, "http")
+)
+
+func init() {
+	driver.Register(drvID, New)
+}
+
+// Manifest exposes to the core the input expected by the driver.
+func (t *T) Manifest() *manifest.T {
+	m := manifest.New(drvID, t)
+	m.Kinds.Or(naming.KindSvc, naming.KindVol)
+	m.Add(
+		keywords.Keyword{
+			Attr:     "URL",
+			Example:  "https://{fqdn}:8443/healthz",
+			Option:   "url",
+			Required: true,
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/url"),
+		},
+		keywords.Keyword{
+			Attr:       "Method",
+			Candidates: []string{"GET", "HEAD", "POST", "OPTIONS"},
+			Default:    "GET",
+			Option:     "method",
+			Scopable:   true,
+			Text:       keywords.NewText(fs, "text/kw/method"),
+		},
+		keywords.Keyword{
+			Attr:      "Headers",
+			Converter: converters.List,
+			Example:   "Host:app.example.com",
+			Option:    "headers",
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/headers"),
+		},
+		keywords.Keyword{
+			Attr:      "Insecure",
+			Converter: converters.Bool,
+			Default:   "false",
+			Option:    "insecure",
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/insecure"),
+		},
+		keywords.Keyword{
+			Attr:      "ExpectedStatus",
+			Converter: converters.List,
+			Default:   "2xx",
+			Example:   "200 301 302",
+			Option:    "expected_status",
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/expected_status"),
+		},
+		keywords.Keyword{
+			Attr:     "ExpectedBody",
+			Example:  `"status":\s*"ok"`,
+			Option:   "expected_body",
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/expected_body"),
+		},
+	)
+	m.AddKeywords(rescheck.BaseKeywords...)
+	return m
+}
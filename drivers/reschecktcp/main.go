@@ -0,0 +1,118 @@
+package reschecktcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"strconv"
+
+	"github.com/opensvc/om3/core/resource"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/drivers/rescheck"
+)
+
+type (
+	T struct {
+		rescheck.T
+		Address      string `json:"address"`
+		Send         string `json:"send"`
+		ExpectedBody string `json:"expected_body"`
+	}
+)
+
+// maxBodySize is the maximum size of the data read from the peer to match
+// the expected_body regular expression.
+const maxBodySize = 64 * 1024
+
+func New() resource.Driver {
+	t := &T{}
+	return t
+}
+
+func (t *T) Start(ctx context.Context) error {
+	return t.StartWith(ctx, t.probe)
+}
+
+func (t *T) Status(ctx context.Context) status.T {
+	if t.Address == "" {
+		t.StatusLog().Warn("address not set")
+		return status.NotApplicable
+	}
+	return t.StatusWith(ctx, t.probe)
+}
+
+func (t *T) Label() string {
+	return t.Address
+}
+
+func (t *T) Info(ctx context.Context) (resource.InfoKeys, error) {
+	m := resource.InfoKeys{
+		{Key: "address", Value: t.Address},
+		{Key: "send", Value: t.Send},
+		{Key: "expected_body", Value: t.ExpectedBody},
+	}
+	return append(m, t.BaseInfo()...), nil
+}
+
+// payload returns the send keyword value with its escape sequences
+// interpreted.
+func (t *T) payload() ([]byte, error) {
+	if t.Send == "" {
+		return nil, nil
+	}
+	s, err := strconv.Unquote(`"` + t.Send + `"`)
+	if err != nil {
+		return nil, fmt.Errorf("invalid send '%s': %w", t.Send, err)
+	}
+	return []byte(s), nil
+}
+
+func (t *T) probe(ctx context.Context) error {
+	payload, err := t.payload()
+	if err != nil {
+		return err
+	}
+	var re *regexp.Regexp
+	if t.ExpectedBody != "" {
+		if re, err = regexp.Compile(t.ExpectedBody); err != nil {
+			return fmt.Errorf("invalid expected_body: %w", err)
+		}
+	}
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", t.Address)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+	if len(payload) > 0 {
+		if _, err := conn.Write(payload); err != nil {
+			return fmt.Errorf("send to %s: %w", t.Address, err)
+		}
+	}
+	if re == nil {
+		return nil
+	}
+	b := make([]byte, 0, 4096)
+	buf := make([]byte, 4096)
+	for len(b) < maxBodySize {
+		n, err := conn.Read(buf)
+		b = append(b, buf[:n]...)
+		if re.Match(b) {
+			return nil
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("receive from %s: %w", t.Address, err)
+		}
+	}
+	return fmt.Errorf("%s: received data does not match %s", t.Address, t.ExpectedBody)
+}
@@ -0,0 +1,108 @@
+package reschecktcp
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// listen starts a line oriented server answering +PONG to PING.
+func listen(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer func() { _ = conn.Close() }()
+				line, err := bufio.NewReader(conn).ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == "PING\r\n" {
+					_, _ = conn.Write([]byte("+PONG\r\n"))
+				} else {
+					_, _ = conn.Write([]byte("-ERR\r\n"))
+				}
+			}()
+		}
+	}()
+	return l.Addr().String()
+}
+
+func TestProbe(t *testing.T) {
+	addr := listen(t)
+	cases := map[string]struct {
+		send         string
+		expectedBody string
+		ok           bool
+	}{
+		"connect only": {
+			ok: true,
+		},
+		"match": {
+			send:         `PING\r\n`,
+			expectedBody: `^\+PONG`,
+			ok:           true,
+		},
+		"mismatch": {
+			send:         `PONG\r\n`,
+			expectedBody: `^\+PONG`,
+		},
+		"invalid send": {
+			send: `PING\`,
+		},
+		"invalid expected_body": {
+			send:         `PING\r\n`,
+			expectedBody: `(`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := New().(*T)
+			r.Address = addr
+			r.Send = tc.send
+			r.ExpectedBody = tc.expectedBody
+			err := r.probe(context.Background())
+			if tc.ok {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestProbeRefused(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := l.Addr().String()
+	require.NoError(t, l.Close())
+
+	r := New().(*T)
+	r.Address = addr
+	require.Error(t, r.probe(context.Background()))
+}
+
+func TestProbeTimeout(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = l.Close() }()
+
+	r := New().(*T)
+	r.Address = l.Addr().String()
+	r.ExpectedBody = "never sent"
+	timeout := 100 * time.Millisecond
+	r.Timeout = &timeout
+	_, err = r.Check(context.Background(), r.probe)
+	require.ErrorContains(t, err, "timeout after")
+}
@@ -0,0 +1,54 @@
+package reschecktcp
+
+import (
+	"embed"
+
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/core/keywords"
+	"github.com/opensvc/om3/core/manifest"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/drivers/rescheck"
+)
+
+var (
+	//go:embed text
+	fs embed.FS
+
+	drvID = driver.NewID(driver.GroupCheck, "tcp")
+)
+
+func init() {
+	driver.Register(drvID, New)
+}
+
+// Manifest exposes to the core the input expected by the driver.
+func (t *T) Manifest() *manifest.T {
+	m := manifest.New(drvID, t)
+	m.Kinds.Or(naming.KindSvc, naming.KindVol)
+	m.Add(
+		keywords.Keyword{
+			Attr:     "Address",
+			Example:  "{fqdn}:5432",
+			Option:   "address",
+			Required: true,
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/address"),
+		},
+		keywords.Keyword{
+			Attr:     "Send",
+			Example:  `PING\r\n`,
+			Option:   "send",
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/send"),
+		},
+		keywords.Keyword{
+			Attr:     "ExpectedBody",
+			Example:  `^\+PONG`,
+			Option:   "expected_body",
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/expected_body"),
+		},
+	)
+	m.AddKeywords(rescheck.BaseKeywords...)
+	return m
+}
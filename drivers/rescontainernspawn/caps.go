@@ -0,0 +1,23 @@
+package rescontainernspawn
+
+import (
+	"os/exec"
+
+	"github.com/opensvc/om3/util/capabilities"
+)
+
+func init() {
+	capabilities.Register(capabilitiesScanner)
+}
+
+func capabilitiesScanner() ([]string, error) {
+	l := make([]string, 0)
+	if _, err := exec.LookPath("machinectl"); err != nil {
+		return l, nil
+	}
+	if _, err := exec.LookPath("systemd-nspawn"); err != nil {
+		return l, nil
+	}
+	l = append(l, drvID.Cap())
+	return l, nil
+}
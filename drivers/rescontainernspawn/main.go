@@ -0,0 +1,430 @@
+package rescontainernspawn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/core/actionrollback"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/provisioned"
+	"github.com/opensvc/om3/core/resource"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/core/vpath"
+	"github.com/opensvc/om3/util/capabilities"
+	"github.com/opensvc/om3/util/command"
+	"github.com/opensvc/om3/util/file"
+)
+
+const (
+	// machinesDir is the machinectl image directory, where the container
+	// directory or image is linked to.
+	machinesDir = "/var/lib/machines"
+
+	// settingsDir is the directory hosting the trusted systemd-nspawn
+	// settings files.
+	settingsDir = "/etc/systemd/nspawn"
+)
+
+type (
+	T struct {
+		resource.T
+		Path         naming.Path    `json:"path"`
+		Name         string         `json:"name"`
+		Hostname     string         `json:"hostname"`
+		Directory    string         `json:"directory"`
+		Image        string         `json:"image"`
+		Source       string         `json:"source"`
+		RCmd         []string       `json:"rcmd"`
+		StartTimeout *time.Duration `json:"start_timeout"`
+		StopTimeout  *time.Duration `json:"stop_timeout"`
+	}
+)
+
+var (
+	ErrNoRoot = errors.New("the directory or image keyword must be set")
+)
+
+func New() resource.Driver {
+	t := &T{}
+	return t
+}
+
+func (t *T) Label() string {
+	return t.Name
+}
+
+func (t *T) Start(ctx context.Context) error {
+	if v, err := t.isUp(); err != nil {
+		return err
+	} else if v {
+		t.Log().Infof("container %s is already up", t.Name)
+		return nil
+	}
+	if err := t.installSettings(); err != nil {
+		return err
+	}
+	if err := t.installImageLink(); err != nil {
+		return err
+	}
+	if err := t.machinectl(zerolog.InfoLevel, "start", t.Name); err != nil {
+		return err
+	}
+	actionrollback.Register(ctx, func() error {
+		return t.Stop(ctx)
+	})
+	if t.StartTimeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *t.StartTimeout)
+		defer cancel()
+	}
+	return t.waitForExpectation(ctx, "up", t.isUp)
+}
+
+func (t *T) Stop(ctx context.Context) error {
+	if v, err := t.isUp(); err != nil {
+		return err
+	} else if !v {
+		t.Log().Infof("container %s is already down", t.Name)
+		return nil
+	}
+	if err := t.machinectl(zerolog.InfoLevel, "poweroff", t.Name); err != nil {
+		return err
+	}
+	isDown := func() (bool, error) {
+		v, err := t.isUp()
+		return !v, err
+	}
+	stopCtx := ctx
+	if t.StopTimeout != nil {
+		var cancel context.CancelFunc
+		stopCtx, cancel = context.WithTimeout(ctx, *t.StopTimeout)
+		defer cancel()
+	}
+	if err := t.waitForExpectation(stopCtx, "shutdown", isDown); err == nil {
+		return nil
+	}
+	t.Log().Warnf("waited too long for shutdown")
+	if err := t.machinectl(zerolog.InfoLevel, "terminate", t.Name); err != nil {
+		return err
+	}
+	termCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	return t.waitForExpectation(termCtx, "terminate", isDown)
+}
+
+func (t *T) Status(ctx context.Context) status.T {
+	if !capabilities.Has(drvID.Cap()) {
+		t.StatusLog().Info("this node is not nspawn capable")
+		return status.Undef
+	}
+	state, err := t.machineState()
+	if err != nil {
+		t.StatusLog().Error("%s", err)
+		return status.Undef
+	}
+	switch state {
+	case "running":
+		return status.Up
+	case "":
+		return status.Down
+	default:
+		t.StatusLog().Warn("machine state is %s", state)
+		return status.Warn
+	}
+}
+
+// NetNSPath implements the resource.NetNSPather optional interface.
+// Used by ip.netns and ip.route to configure network stuff in the container.
+func (t *T) NetNSPath() (string, error) {
+	if pid, err := t.getPID(); err != nil {
+		return "", err
+	} else if pid == 0 {
+		return "", fmt.Errorf("container %s is not running", t.Name)
+	} else {
+		return fmt.Sprintf("/proc/%d/ns/net", pid), nil
+	}
+}
+
+// PID implements the resource.PIDer optional interface.
+// Used by ip.netns to name the veth pair devices.
+func (t *T) PID() int {
+	pid, _ := t.getPID()
+	return pid
+}
+
+func (t *T) Enter() error {
+	sh := "/bin/bash"
+	rcmd, err := t.rcmd()
+	if err != nil {
+		return err
+	}
+	args := append(rcmd, sh)
+	cmd := exec.Command(args[0], args[1:]...)
+	_ = cmd.Run()
+
+	switch cmd.ProcessState.ExitCode() {
+	case 126, 127:
+		sh = "/bin/sh"
+	}
+	args = append(rcmd, sh)
+	cmd = exec.Command(args[0], args[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+func (t *T) ProvisionLeader(ctx context.Context) error {
+	root, err := t.rootPath()
+	if err != nil {
+		return err
+	}
+	if file.Exists(root) {
+		t.Log().Infof("container %s root %s already exists", t.Name, root)
+		return nil
+	}
+	if t.Source == "" {
+		return fmt.Errorf("the source keyword is mandatory for provision")
+	}
+	src, err := vpath.HostPath(t.Source, t.Path.Namespace)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(root), 0755); err != nil {
+		return err
+	}
+	args := []string{"--archive", "--reflink=auto"}
+	if t.Image != "" {
+		args = append(args, "--sparse=always", src, root)
+	} else {
+		if err := os.MkdirAll(root, 0755); err != nil {
+			return err
+		}
+		args = append(args, src+"/.", root)
+	}
+	cmd := command.New(
+		command.WithName("cp"),
+		command.WithArgs(args),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
+
+func (t *T) UnprovisionLeader(ctx context.Context) error {
+	return t.unprovision()
+}
+
+func (t *T) UnprovisionLeaded(ctx context.Context) error {
+	return t.unprovision()
+}
+
+func (t *T) unprovision() error {
+	for _, p := range []string{t.imageLink(), t.settingsFile()} {
+		if _, err := os.Lstat(p); err != nil {
+			continue
+		}
+		t.Log().Infof("remove %s", p)
+		if err := os.Remove(p); err != nil {
+			return err
+		}
+	}
+	root, err := t.rootPath()
+	if errors.Is(err, vpath.ErrAccess) {
+		t.Log().Infof("skip %s root removal: %s", t.Name, err)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if !file.Exists(root) {
+		t.Log().Infof("%s is already cleaned up", root)
+		return nil
+	}
+	if file.IsProtected(root) {
+		t.Log().Warnf("refuse to remove %s", root)
+		return nil
+	}
+	t.Log().Infof("remove %s", root)
+	return os.RemoveAll(root)
+}
+
+func (t *T) Provisioned() (provisioned.T, error) {
+	root, err := t.rootPath()
+	if err != nil {
+		return provisioned.Undef, err
+	}
+	return provisioned.FromBool(file.Exists(root)), nil
+}
+
+// rootPath returns the host path of the container root directory or raw
+// image.
+func (t *T) rootPath() (string, error) {
+	switch {
+	case t.Image != "":
+		return vpath.HostPath(t.Image, t.Path.Namespace)
+	case t.Directory != "":
+		return vpath.HostPath(t.Directory, t.Path.Namespace)
+	default:
+		return "", ErrNoRoot
+	}
+}
+
+// imageLink returns the path of the machinectl image symlink pointing to
+// the container root directory or raw image.
+func (t *T) imageLink() string {
+	if t.Image != "" {
+		return filepath.Join(machinesDir, t.Name+".raw")
+	}
+	return filepath.Join(machinesDir, t.Name)
+}
+
+func (t *T) settingsFile() string {
+	return filepath.Join(settingsDir, t.Name+".nspawn")
+}
+
+// settings returns the systemd-nspawn settings of the container.
+//
+// The container has a private network namespace without veth, so the
+// ip.netns resources can plumb its interfaces.
+func (t *T) settings() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# managed by opensvc for %s %s\n", t.Path, t.RID())
+	fmt.Fprintf(&b, "[Exec]\nBoot=yes\nHostname=%s\n\n", t.hostname())
+	fmt.Fprintf(&b, "[Network]\nPrivate=yes\nVirtualEthernet=no\n")
+	return b.String()
+}
+
+func (t *T) installSettings() error {
+	p := t.settingsFile()
+	s := t.settings()
+	if b, err := os.ReadFile(p); err == nil && string(b) == s {
+		return nil
+	}
+	if err := os.MkdirAll(settingsDir, 0755); err != nil {
+		return err
+	}
+	t.Log().Infof("install %s", p)
+	return os.WriteFile(p, []byte(s), 0644)
+}
+
+func (t *T) installImageLink() error {
+	root, err := t.rootPath()
+	if err != nil {
+		return err
+	}
+	if !file.Exists(root) {
+		return fmt.Errorf("container %s root %s does not exist", t.Name, root)
+	}
+	link := t.imageLink()
+	if target, err := os.Readlink(link); err == nil {
+		if target == root {
+			return nil
+		}
+		if err := os.Remove(link); err != nil {
+			return err
+		}
+	} else if file.Exists(link) {
+		return fmt.Errorf("%s exists and is not a symlink", link)
+	}
+	if err := os.MkdirAll(machinesDir, 0755); err != nil {
+		return err
+	}
+	t.Log().Infof("link %s to %s", link, root)
+	return os.Symlink(root, link)
+}
+
+func (t *T) machinectl(level zerolog.Level, args ...string) error {
+	cmd := command.New(
+		command.WithName("machinectl"),
+		command.WithArgs(args),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(level),
+		command.WithStdoutLogLevel(level),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
+
+// machineProperty returns the value of the machinectl property of the
+// container. An empty value is returned when the machine is not running.
+func (t *T) machineProperty(name string) (string, error) {
+	cmd := command.New(
+		command.WithName("machinectl"),
+		command.WithVarArgs("show", t.Name, "--property", name, "--value"),
+		command.WithBufferedStdout(),
+		command.WithBufferedStderr(),
+	)
+	if err := cmd.Run(); err != nil {
+		if strings.Contains(string(cmd.Stderr()), "No machine") {
+			return "", nil
+		}
+		return "", fmt.Errorf("machinectl show %s: %w: %s", t.Name, err, strings.TrimSpace(string(cmd.Stderr())))
+	}
+	return strings.TrimSpace(string(cmd.Stdout())), nil
+}
+
+func (t *T) machineState() (string, error) {
+	return t.machineProperty("State")
+}
+
+func (t *T) getPID() (int, error) {
+	s, err := t.machineProperty("Leader")
+	if err != nil || s == "" {
+		return 0, err
+	}
+	return strconv.Atoi(s)
+}
+
+func (t *T) isUp() (bool, error) {
+	state, err := t.machineState()
+	if err != nil {
+		return false, err
+	}
+	return state == "running", nil
+}
+
+func (t *T) waitForExpectation(ctx context.Context, s string, fn func() (bool, error)) error {
+	t.Log().Infof("wait for %s %s", s, t.Name)
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if ok, err := fn(); err != nil {
+				return err
+			} else if ok {
+				return nil
+			}
+		}
+	}
+}
+
+func (t *T) rcmd() ([]string, error) {
+	if len(t.RCmd) > 0 {
+		return t.RCmd, nil
+	}
+	if exe, err := exec.LookPath("machinectl"); err == nil {
+		return []string{exe, "shell", "--quiet", t.Name}, nil
+	}
+	return nil, fmt.Errorf("unable to identify a remote command method. install machinectl or set the rcmd keyword")
+}
+
+func (t *T) hostname() string {
+	if t.Hostname != "" {
+		return t.Hostname
+	}
+	return t.Name
+}
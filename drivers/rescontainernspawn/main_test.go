@@ -0,0 +1,57 @@
+package rescontainernspawn
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/provisioned"
+)
+
+func TestRootPath(t *testing.T) {
+	dir := t.TempDir()
+
+	r := &T{Name: "c1"}
+	_, err := r.rootPath()
+	assert.ErrorIs(t, err, ErrNoRoot)
+
+	r.Directory = filepath.Join(dir, "rootfs")
+	p, err := r.rootPath()
+	require.NoError(t, err)
+	assert.Equal(t, r.Directory, p)
+	assert.Equal(t, "/var/lib/machines/c1", r.imageLink())
+
+	v, err := r.Provisioned()
+	require.NoError(t, err)
+	assert.Equal(t, provisioned.False, v)
+	require.NoError(t, os.Mkdir(r.Directory, 0755))
+	v, err = r.Provisioned()
+	require.NoError(t, err)
+	assert.Equal(t, provisioned.True, v)
+
+	r.Image = filepath.Join(dir, "disk.raw")
+	p, err = r.rootPath()
+	require.NoError(t, err)
+	assert.Equal(t, r.Image, p, "image has precedence over directory")
+	assert.Equal(t, "/var/lib/machines/c1.raw", r.imageLink())
+}
+
+func TestSettings(t *testing.T) {
+	r := &T{
+		Name: "c1",
+		Path: naming.Path{Namespace: "ns1", Kind: naming.KindSvc, Name: "svc1"},
+	}
+	require.NoError(t, r.SetRID("container#1"))
+	assert.Equal(t, "/etc/systemd/nspawn/c1.nspawn", r.settingsFile())
+	expected := "# managed by opensvc for ns1/svc/svc1 container#1\n" +
+		"[Exec]\nBoot=yes\nHostname=c1\n\n" +
+		"[Network]\nPrivate=yes\nVirtualEthernet=no\n"
+	assert.Equal(t, expected, r.settings())
+
+	r.Hostname = "web1"
+	assert.Contains(t, r.settings(), "Hostname=web1\n")
+}
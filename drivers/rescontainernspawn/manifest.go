@@ -0,0 +1,59 @@
+package rescontainernspawn
+
+import (
+	"embed"
+
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/core/keywords"
+	"github.com/opensvc/om3/core/manifest"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/drivers/rescontainer"
+)
+
+var (
+	//go:embed text
+	fs embed.FS
+
+	drvID = driver.NewID(driver.GroupContainer, "nspawn")
+)
+
+func init() {
+	driver.Register(drvID, New)
+}
+
+// Manifest exposes to the core the input expected by the driver.
+func (t T) Manifest() *manifest.T {
+	m := manifest.New(drvID, t)
+	m.Kinds.Or(naming.KindSvc)
+	m.Add(
+		manifest.ContextObjectPath,
+		keywords.Keyword{
+			Attr:     "Directory",
+			Example:  "vol1/rootfs",
+			Option:   "directory",
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/directory"),
+		},
+		keywords.Keyword{
+			Attr:     "Image",
+			Example:  "vol1/disk.raw",
+			Option:   "image",
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/image"),
+		},
+		keywords.Keyword{
+			Attr:         "Source",
+			Example:      "/srv/templates/debian12",
+			Option:       "source",
+			Provisioning: true,
+			Scopable:     true,
+			Text:         keywords.NewText(fs, "text/kw/source"),
+		},
+		rescontainer.KWRCmd,
+		rescontainer.KWName,
+		rescontainer.KWHostname,
+		rescontainer.KWStartTimeout,
+		rescontainer.KWStopTimeout,
+	)
+	return m
+}
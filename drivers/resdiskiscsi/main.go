@@ -0,0 +1,476 @@
+//go:build linux
+
+package resdiskiscsi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/core/actionrollback"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/provisioned"
+	"github.com/opensvc/om3/core/resource"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/drivers/resdisk"
+	"github.com/opensvc/om3/util/command"
+	"github.com/opensvc/om3/util/device"
+	"github.com/opensvc/om3/util/udevadm"
+)
+
+const (
+	iscsiadm = "iscsiadm"
+
+	// exitCodeNoSession is the iscsiadm exit code when no session is
+	// active.
+	exitCodeNoSession = 21
+
+	keyChapUsername   = "chap_username"
+	keyChapPassword   = "chap_password"
+	keyChapUsernameIn = "chap_username_in"
+	keyChapPasswordIn = "chap_password_in"
+)
+
+type (
+	T struct {
+		resdisk.T
+		Portals     []string       `json:"portal"`
+		Target      string         `json:"target"`
+		LUNs        []string       `json:"luns"`
+		Secret      string         `json:"secret"`
+		WaitTimeout *time.Duration `json:"wait_timeout"`
+		Path        naming.Path    `json:"path"`
+	}
+
+	// chap holds the CHAP credentials read from the sec object.
+	chap struct {
+		Username   string
+		Password   string
+		UsernameIn string
+		PasswordIn string
+	}
+)
+
+func New() resource.Driver {
+	t := &T{}
+	return t
+}
+
+func (t T) Info(ctx context.Context) (resource.InfoKeys, error) {
+	m := resource.InfoKeys{
+		{Key: "portal", Value: strings.Join(t.Portals, " ")},
+		{Key: "target", Value: t.Target},
+		{Key: "luns", Value: strings.Join(t.LUNs, " ")},
+		{Key: "secret", Value: t.Secret},
+	}
+	return m, nil
+}
+
+func (t T) Label() string {
+	return t.Target
+}
+
+// ImportDevices logs into the target, so the devices can be listed and
+// reserved before the start.
+func (t *T) ImportDevices() error {
+	return t.login(context.Background())
+}
+
+func (t *T) Start(ctx context.Context) error {
+	if v, err := t.isUp(); err != nil {
+		return err
+	} else if v {
+		t.Log().Infof("%s is already up", t.Target)
+		return nil
+	}
+	if err := t.login(ctx); err != nil {
+		return err
+	}
+	actionrollback.Register(ctx, func() error {
+		return t.logout()
+	})
+	return nil
+}
+
+func (t *T) Stop(ctx context.Context) error {
+	l, err := t.sessions()
+	if err != nil {
+		return err
+	}
+	if !t.hasAnySession(l) {
+		t.Log().Infof("%s is already down", t.Target)
+		return nil
+	}
+	for _, dev := range t.SubDevices() {
+		if err := dev.RemoveHolders(); err != nil {
+			return err
+		}
+	}
+	udevadm.Settle()
+	if t.IsSCSIPersistentReservationEnabled() {
+		// the reservations must be dropped while the devices are
+		// still accessible.
+		if err := resource.SCSIPersistentReservationStop(ctx, t); err != nil {
+			return err
+		}
+	}
+	return t.logout()
+}
+
+func (t *T) Status(ctx context.Context) status.T {
+	l, err := t.sessions()
+	if err != nil {
+		t.StatusLog().Error("%s", err)
+		return status.Undef
+	}
+	var n int
+	for _, portal := range t.Portals {
+		if l.Has(portal, t.Target) {
+			n++
+		} else {
+			t.StatusLog().Info("no session on portal %s", portal)
+		}
+	}
+	switch {
+	case n == 0:
+		return status.Down
+	case n < len(t.Portals):
+		return status.Warn
+	}
+	if missing := t.missingDevices(); len(missing) > 0 {
+		for _, s := range missing {
+			t.StatusLog().Warn("%s not found", s)
+		}
+		return status.Warn
+	}
+	return status.Up
+}
+
+func (t T) Provisioned() (provisioned.T, error) {
+	return provisioned.NotApplicable, nil
+}
+
+// ExposedDevices returns the SCSI devices of the target luns.
+func (t T) ExposedDevices() device.L {
+	return t.SubDevices()
+}
+
+func (t *T) ReservableDevices() device.L {
+	return t.SubDevices()
+}
+
+// SubDevices returns the SCSI devices of the target luns, on all portal
+// paths.
+func (t T) SubDevices() device.L {
+	l := make(device.L, 0)
+	for _, p := range t.devicePaths() {
+		resolved, err := filepath.EvalSymlinks(p)
+		if err != nil {
+			t.Log().Debugf("%s", err)
+			continue
+		}
+		l = append(l, device.New(resolved, device.WithLogger(t.Log())))
+	}
+	return l
+}
+
+// devicePaths returns the by-path links of the target luns devices.
+func (t T) devicePaths() []string {
+	l := make([]string, 0)
+	for _, portal := range t.Portals {
+		for _, lun := range t.lunPatterns() {
+			matches, err := filepath.Glob(byPathGlob(portal, t.Target, lun))
+			if err != nil {
+				t.Log().Debugf("%s", err)
+				continue
+			}
+			sort.Strings(matches)
+			l = append(l, matches...)
+		}
+	}
+	return l
+}
+
+func (t T) lunPatterns() []string {
+	if len(t.LUNs) == 0 {
+		return []string{"*"}
+	}
+	return t.LUNs
+}
+
+// missingDevices returns the by-path links expected but not found.
+func (t T) missingDevices() []string {
+	l := make([]string, 0)
+	for _, portal := range t.Portals {
+		for _, lun := range t.lunPatterns() {
+			pattern := byPathGlob(portal, t.Target, lun)
+			if matches, _ := filepath.Glob(pattern); len(matches) == 0 {
+				l = append(l, fmt.Sprintf("lun %s device on portal %s", lun, portal))
+			}
+		}
+	}
+	return l
+}
+
+func (t T) isUp() (bool, error) {
+	l, err := t.sessions()
+	if err != nil {
+		return false, err
+	}
+	for _, portal := range t.Portals {
+		if !l.Has(portal, t.Target) {
+			return false, nil
+		}
+	}
+	return len(t.missingDevices()) == 0, nil
+}
+
+func (t T) hasAnySession(l sessions) bool {
+	for _, portal := range t.Portals {
+		if l.Has(portal, t.Target) {
+			return true
+		}
+	}
+	return false
+}
+
+// login discovers the target on each portal, logs into the target
+// through the portals not logged in yet, and waits for the lun devices.
+func (t T) login(ctx context.Context) error {
+	if len(t.Portals) == 0 {
+		return fmt.Errorf("no portal")
+	}
+	if t.Target == "" {
+		return fmt.Errorf("no target")
+	}
+	creds, err := t.chap()
+	if err != nil {
+		return err
+	}
+	l, err := t.sessions()
+	if err != nil {
+		return err
+	}
+	for _, portal := range t.Portals {
+		if l.Has(portal, t.Target) {
+			t.Log().Infof("%s already logged in through portal %s", t.Target, portal)
+			continue
+		}
+		if err := t.discover(portal, creds); err != nil {
+			return err
+		}
+		if err := t.configureNode(portal, creds); err != nil {
+			return err
+		}
+		if err := t.iscsiadm("-m", "node", "-T", t.Target, "-p", normalizePortal(portal), "--login"); err != nil {
+			return err
+		}
+	}
+	return t.waitDevices(ctx)
+}
+
+func (t T) logout() error {
+	l, err := t.sessions()
+	if err != nil {
+		return err
+	}
+	var errs error
+	for _, portal := range t.Portals {
+		if !l.Has(portal, t.Target) {
+			continue
+		}
+		if err := t.iscsiadm("-m", "node", "-T", t.Target, "-p", normalizePortal(portal), "--logout"); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+	return errs
+}
+
+func (t T) waitDevices(ctx context.Context) error {
+	timeout := 30 * time.Second
+	if t.WaitTimeout != nil {
+		timeout = *t.WaitTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	udevadm.Settle()
+	for {
+		missing := t.missingDevices()
+		if len(missing) == 0 {
+			t.Log().Infof("%s devices: %s", t.Target, t.SubDevices())
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait for %s: %w", strings.Join(missing, ", "), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// discover runs a sendtargets discovery on the portal, and verifies the
+// target is discovered.
+func (t T) discover(portal string, creds *chap) error {
+	portal = normalizePortal(portal)
+	args := []string{"-m", "discoverydb", "-t", "sendtargets", "-p", portal}
+	if creds != nil {
+		if err := t.iscsiadmQuiet(append(args, "-o", "new")...); err != nil {
+			return err
+		}
+		if err := t.setAuth(args, "discovery.sendtargets.auth", creds); err != nil {
+			return err
+		}
+	}
+	cmd := command.New(
+		command.WithName(iscsiadm),
+		command.WithArgs(append(args, "--discover")),
+		command.WithBufferedStdout(),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.DebugLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	b, err := cmd.Output()
+	if err != nil {
+		return err
+	}
+	if !parseDiscovery(string(b), t.Target) {
+		return fmt.Errorf("target %s not discovered on portal %s", t.Target, portal)
+	}
+	return nil
+}
+
+// configureNode disables the login of the target on boot, the agent being
+// responsible for the login, and sets the session CHAP credentials.
+func (t T) configureNode(portal string, creds *chap) error {
+	args := []string{"-m", "node", "-T", t.Target, "-p", normalizePortal(portal)}
+	if err := t.iscsiadmQuiet(append(args, "-o", "update", "-n", "node.startup", "-v", "manual")...); err != nil {
+		return err
+	}
+	if creds == nil {
+		return nil
+	}
+	return t.setAuth(args, "node.session.auth", creds)
+}
+
+// setAuth sets the CHAP credentials parameters of the discoverydb or node
+// record. The commands are not logged, to not leak the passwords.
+func (t T) setAuth(args []string, prefix string, creds *chap) error {
+	params := [][2]string{
+		{prefix + ".authmethod", "CHAP"},
+		{prefix + ".username", creds.Username},
+		{prefix + ".password", creds.Password},
+	}
+	if creds.UsernameIn != "" {
+		params = append(params,
+			[2]string{prefix + ".username_in", creds.UsernameIn},
+			[2]string{prefix + ".password_in", creds.PasswordIn},
+		)
+	}
+	t.Log().Infof("set %s chap credentials from %s", prefix, t.Secret)
+	for _, p := range params {
+		cmd := command.New(
+			command.WithName(iscsiadm),
+			command.WithArgs(append(args, "-o", "update", "-n", p[0], "-v", p[1])),
+			command.WithBufferedStdout(),
+			command.WithBufferedStderr(),
+		)
+		if _, err := cmd.Output(); err != nil {
+			return fmt.Errorf("%s %s update %s: %w: %s", iscsiadm, strings.Join(args, " "), p[0], err, strings.TrimSpace(string(cmd.Stderr())))
+		}
+	}
+	return nil
+}
+
+// chap returns the CHAP credentials read from the sec object, or nil if
+// the secret keyword is not set.
+func (t T) chap() (*chap, error) {
+	if t.Secret == "" {
+		return nil, nil
+	}
+	p, err := naming.NewPath(t.Path.Namespace, naming.KindSec, t.Secret)
+	if err != nil {
+		return nil, err
+	}
+	sec, err := object.NewSec(p)
+	if err != nil {
+		return nil, err
+	}
+	if !p.Exists() {
+		return nil, fmt.Errorf("%s does not exist", p)
+	}
+	get := func(keyname string, required bool) (string, error) {
+		if !sec.HasKey(keyname) {
+			if required {
+				return "", fmt.Errorf("%s has no %s key", p, keyname)
+			}
+			return "", nil
+		}
+		b, err := sec.DecodeKey(keyname)
+		return strings.TrimSpace(string(b)), err
+	}
+	var creds chap
+	if creds.Username, err = get(keyChapUsername, true); err != nil {
+		return nil, err
+	}
+	if creds.Password, err = get(keyChapPassword, true); err != nil {
+		return nil, err
+	}
+	if creds.UsernameIn, err = get(keyChapUsernameIn, false); err != nil {
+		return nil, err
+	}
+	if creds.PasswordIn, err = get(keyChapPasswordIn, creds.UsernameIn != ""); err != nil {
+		return nil, err
+	}
+	return &creds, nil
+}
+
+func (t T) sessions() (sessions, error) {
+	cmd := command.New(
+		command.WithName(iscsiadm),
+		command.WithVarArgs("-m", "session"),
+		command.WithBufferedStdout(),
+		command.WithLogger(t.Log()),
+		command.WithIgnoredExitCodes(0, exitCodeNoSession),
+		command.WithStdoutLogLevel(zerolog.DebugLevel),
+		command.WithStderrLogLevel(zerolog.DebugLevel),
+	)
+	b, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	return parseSessions(string(b)), nil
+}
+
+func (t T) iscsiadm(args ...string) error {
+	cmd := command.New(
+		command.WithName(iscsiadm),
+		command.WithArgs(args),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
+
+func (t T) iscsiadmQuiet(args ...string) error {
+	cmd := command.New(
+		command.WithName(iscsiadm),
+		command.WithArgs(args),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.DebugLevel),
+		command.WithStdoutLogLevel(zerolog.DebugLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
@@ -0,0 +1,78 @@
+//go:build linux
+
+package resdiskiscsi
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/status"
+)
+
+const testTarget = "iqn.2009-11.com.opensvc.test:resdiskiscsi"
+
+// setupTarget exports a file backed lun through a local targetcli target,
+// without authentication.
+func setupTarget(t *testing.T) {
+	t.Helper()
+	if os.Getuid() != 0 {
+		t.Skip("skipped for non root user")
+	}
+	for _, prog := range []string{"targetcli", iscsiadm} {
+		if _, err := exec.LookPath(prog); err != nil {
+			t.Skipf("%s not found, skip test", prog)
+		}
+	}
+	img := filepath.Join(t.TempDir(), "lun0.img")
+	targetcli := func(args ...string) error {
+		b, err := exec.Command("targetcli", args...).CombinedOutput()
+		if err != nil {
+			t.Logf("targetcli %v: %s", args, b)
+		}
+		return err
+	}
+	if err := targetcli("/backstores/fileio", "create", "resdiskiscsi", img, "64M"); err != nil {
+		t.Skip("can not setup the targetcli backstore")
+	}
+	t.Cleanup(func() {
+		_ = targetcli("/iscsi", "delete", testTarget)
+		_ = targetcli("/backstores/fileio", "delete", "resdiskiscsi")
+	})
+	tpg := "/iscsi/" + testTarget + "/tpg1"
+	require.NoError(t, targetcli("/iscsi", "create", testTarget))
+	require.NoError(t, targetcli(tpg+"/luns", "create", "/backstores/fileio/resdiskiscsi"))
+	require.NoError(t, targetcli(tpg, "set", "attribute", "authentication=0", "demo_mode_write_protect=0", "generate_node_acls=1", "cache_dynamic_acls=1"))
+}
+
+func TestLoginLogout(t *testing.T) {
+	setupTarget(t)
+	ctx := context.Background()
+	r := &T{
+		Portals: []string{"127.0.0.1"},
+		Target:  testTarget,
+		LUNs:    []string{"0"},
+	}
+	require.NoError(t, r.SetRID("disk#1"))
+	timeout := 20 * time.Second
+	r.WaitTimeout = &timeout
+
+	assert.Equal(t, status.Down, r.Status(ctx))
+	require.NoError(t, r.Start(ctx))
+	t.Cleanup(func() { _ = r.logout() })
+
+	assert.Equal(t, status.Up, r.Status(ctx))
+	devs := r.SubDevices()
+	require.Len(t, devs, 1)
+	assert.FileExists(t, devs[0].Path())
+
+	require.NoError(t, r.Stop(ctx))
+	assert.Equal(t, status.Down, r.Status(ctx))
+	assert.Empty(t, r.SubDevices())
+}
@@ -0,0 +1,76 @@
+//go:build linux
+
+package resdiskiscsi
+
+import (
+	"embed"
+
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/core/keywords"
+	"github.com/opensvc/om3/core/manifest"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/drivers/resdisk"
+	"github.com/opensvc/om3/util/converters"
+)
+
+var (
+	//go:embed text
+	fs embed.FS
+
+	drvID = driver.NewID(driver.GroupDisk, "iscsi")
+)
+
+func init() {
+	driver.Register(drvID, New)
+}
+
+// Manifest exposes to the core the input expected by the driver.
+func (t T) Manifest() *manifest.T {
+	m := manifest.New(drvID, t)
+	m.Kinds.Or(naming.KindSvc, naming.KindVol)
+	m.Add(manifest.ContextObjectPath)
+	m.AddKeywords(resdisk.BaseKeywords...)
+	m.Add(
+		keywords.Keyword{
+			Attr:      "Portals",
+			Converter: converters.List,
+			Example:   "192.168.10.10:3260 192.168.11.10:3260",
+			Option:    "portal",
+			Required:  true,
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/portal"),
+		},
+		keywords.Keyword{
+			Attr:     "Target",
+			Example:  "iqn.2009-11.com.opensvc.srv:{fqdn}",
+			Option:   "target",
+			Required: true,
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/target"),
+		},
+		keywords.Keyword{
+			Attr:      "LUNs",
+			Converter: converters.List,
+			Example:   "0 1",
+			Option:    "luns",
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/luns"),
+		},
+		keywords.Keyword{
+			Attr:     "Secret",
+			Example:  "{name}",
+			Option:   "secret",
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/secret"),
+		},
+		keywords.Keyword{
+			Attr:      "WaitTimeout",
+			Converter: converters.Duration,
+			Default:   "30s",
+			Option:    "wait_timeout",
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/wait_timeout"),
+		},
+	)
+	return m
+}
@@ -0,0 +1,104 @@
+//go:build linux
+
+package resdiskiscsi
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+type (
+	// session is an iscsi session, as listed by "iscsiadm -m session".
+	session struct {
+		ID     string
+		Portal string
+		Target string
+	}
+
+	sessions []session
+)
+
+const defaultPort = "3260"
+
+// normalizePortal returns the portal in the <address>:<port> format, with
+// the default port if not set.
+func normalizePortal(s string) string {
+	if _, _, err := net.SplitHostPort(s); err == nil {
+		return s
+	}
+	if strings.Contains(s, ":") && !strings.HasPrefix(s, "[") {
+		// ipv6 address without port
+		return net.JoinHostPort(s, defaultPort)
+	}
+	return net.JoinHostPort(strings.Trim(s, "[]"), defaultPort)
+}
+
+// parseSessions parses the "iscsiadm -m session" output lines, like:
+//
+//	tcp: [1] 192.168.10.10:3260,1 iqn.2009-11.com.opensvc.srv:tgt1 (non-flash)
+func parseSessions(s string) sessions {
+	l := make(sessions, 0)
+	for _, line := range strings.Split(s, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 4 || !strings.HasPrefix(fields[1], "[") {
+			continue
+		}
+		portal, _, _ := strings.Cut(fields[2], ",")
+		l = append(l, session{
+			ID:     strings.Trim(fields[1], "[]"),
+			Portal: normalizePortal(portal),
+			Target: fields[3],
+		})
+	}
+	return l
+}
+
+// Has returns true if a session is logged into the target through the
+// portal.
+func (t sessions) Has(portal, target string) bool {
+	portal = normalizePortal(portal)
+	for _, e := range t {
+		if e.Portal == portal && e.Target == target {
+			return true
+		}
+	}
+	return false
+}
+
+// parseDiscovery parses the "iscsiadm -m discovery" output lines, like:
+//
+//	192.168.10.10:3260,1 iqn.2009-11.com.opensvc.srv:tgt1
+//
+// and returns true if the target is discovered.
+func parseDiscovery(s, target string) bool {
+	for _, line := range strings.Split(s, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		if fields[1] == target {
+			return true
+		}
+	}
+	return false
+}
+
+// byPathGlob returns the glob pattern of the /dev/disk/by-path links of
+// the target lun devices logged in through the portal. lun is "*" to match
+// all luns.
+func byPathGlob(portal, target, lun string) string {
+	return fmt.Sprintf("/dev/disk/by-path/ip-%s-iscsi-%s-lun-%s", escapeGlob(normalizePortal(portal)), escapeGlob(target), lun)
+}
+
+func escapeGlob(s string) string {
+	var b strings.Builder
+	for _, c := range s {
+		switch c {
+		case '*', '?', '[', ']', '\\':
+			b.WriteRune('\\')
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
@@ -0,0 +1,51 @@
+//go:build linux
+
+package resdiskiscsi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizePortal(t *testing.T) {
+	cases := map[string]string{
+		"192.168.10.10":       "192.168.10.10:3260",
+		"192.168.10.10:3261":  "192.168.10.10:3261",
+		"fe80::1":             "[fe80::1]:3260",
+		"[fe80::1]":           "[fe80::1]:3260",
+		"[fe80::1]:3261":      "[fe80::1]:3261",
+		"storage.example.com": "storage.example.com:3260",
+	}
+	for s, expected := range cases {
+		assert.Equalf(t, expected, normalizePortal(s), "normalizePortal(%s)", s)
+	}
+}
+
+func TestParseSessions(t *testing.T) {
+	s := "tcp: [1] 192.168.10.10:3260,1 iqn.2009-11.com.opensvc.srv:tgt1 (non-flash)\n" +
+		"tcp: [2] [fe80::1]:3260,1 iqn.2009-11.com.opensvc.srv:tgt1 (non-flash)\n" +
+		"tcp: [3] 192.168.11.10:3260,1 iqn.2009-11.com.opensvc.srv:tgt2 (non-flash)\n"
+	l := parseSessions(s)
+	assert.Len(t, l, 3)
+	assert.Equal(t, session{ID: "1", Portal: "192.168.10.10:3260", Target: "iqn.2009-11.com.opensvc.srv:tgt1"}, l[0])
+	assert.True(t, l.Has("192.168.10.10", "iqn.2009-11.com.opensvc.srv:tgt1"))
+	assert.True(t, l.Has("fe80::1", "iqn.2009-11.com.opensvc.srv:tgt1"))
+	assert.False(t, l.Has("192.168.11.10", "iqn.2009-11.com.opensvc.srv:tgt1"))
+	assert.Empty(t, parseSessions("iscsiadm: No active sessions.\n"))
+}
+
+func TestParseDiscovery(t *testing.T) {
+	s := "192.168.10.10:3260,1 iqn.2009-11.com.opensvc.srv:tgt1\n192.168.10.10:3260,1 iqn.2009-11.com.opensvc.srv:tgt2\n"
+	assert.True(t, parseDiscovery(s, "iqn.2009-11.com.opensvc.srv:tgt2"))
+	assert.False(t, parseDiscovery(s, "iqn.2009-11.com.opensvc.srv:tgt3"))
+}
+
+func TestByPathGlob(t *testing.T) {
+	assert.Equal(t,
+		"/dev/disk/by-path/ip-192.168.10.10:3260-iscsi-iqn.2009-11.com.opensvc.srv:tgt1-lun-*",
+		byPathGlob("192.168.10.10", "iqn.2009-11.com.opensvc.srv:tgt1", "*"))
+	assert.Equal(t,
+		`/dev/disk/by-path/ip-\[fe80::1\]:3260-iscsi-iqn.2009-11.com.opensvc.srv:tgt1-lun-0`,
+		byPathGlob("fe80::1", "iqn.2009-11.com.opensvc.srv:tgt1", "0"))
+}
@@ -0,0 +1,288 @@
+package resfsbind
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/core/actionresdeps"
+	"github.com/opensvc/om3/core/actionrollback"
+	"github.com/opensvc/om3/core/provisioned"
+	"github.com/opensvc/om3/core/resource"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/drivers/resfsdir"
+	"github.com/opensvc/om3/drivers/resfshost"
+	"github.com/opensvc/om3/util/command"
+	"github.com/opensvc/om3/util/findmnt"
+)
+
+type (
+	T struct {
+		resource.T
+		MountPoint   string         `json:"mnt"`
+		Source       string         `json:"dev"`
+		MountOptions string         `json:"mnt_opt"`
+		StatTimeout  *time.Duration `json:"stat_timeout"`
+		User         *user.User     `json:"user"`
+		Group        *user.Group    `json:"group"`
+		Perm         *os.FileMode   `json:"perm"`
+		PromoteRW    bool           `json:"promote_rw"`
+		CheckRead    bool           `json:"check_read"`
+	}
+)
+
+func New() resource.Driver {
+	t := &T{}
+	return t
+}
+
+// ActionResourceDeps orders the bind mount after the resources hosting
+// its source and mount point.
+func (t *T) ActionResourceDeps() []actionresdeps.Dep {
+	return resfshost.HeadDeps(t.GetObject(), t.RID(), t.Source, t.MountPoint)
+}
+
+func (t *T) Start(ctx context.Context) error {
+	if v, err := t.isMounted(); err != nil {
+		return err
+	} else if v {
+		t.Log().Infof("%s already bind mounted on %s", t.Source, t.MountPoint)
+		return nil
+	}
+	fi, err := os.Stat(t.Source)
+	if err != nil {
+		return err
+	}
+	if err := t.createMountPoint(fi.IsDir()); err != nil {
+		return err
+	}
+	recursive, opts := parseOptions(t.MountOptions)
+	flag := "--bind"
+	if recursive {
+		flag = "--rbind"
+	}
+	if err := t.mount(flag, t.Source, t.MountPoint); err != nil {
+		return err
+	}
+	actionrollback.Register(ctx, func() error {
+		return t.umount()
+	})
+	if len(opts) > 0 {
+		if err := t.mount("-o", "remount,bind,"+strings.Join(opts, ","), t.MountPoint); err != nil {
+			return err
+		}
+	}
+	if t.PromoteRW {
+		t.Log().Debugf("promote_rw ignored: a bind mount has no device to promote")
+	}
+	if t.User != nil || t.Group != nil || t.Perm != nil {
+		if err := t.fsDir().Start(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *T) Stop(ctx context.Context) error {
+	if v, err := t.isMounted(); err != nil {
+		return err
+	} else if !v {
+		t.Log().Infof("%s already umounted from %s", t.Source, t.MountPoint)
+		return nil
+	}
+	return t.umount()
+}
+
+func (t *T) Status(ctx context.Context) status.T {
+	if t.Source == "" {
+		t.StatusLog().Info("dev is not defined")
+		return status.NotApplicable
+	}
+	if t.MountPoint == "" {
+		t.StatusLog().Info("mnt is not defined")
+		return status.NotApplicable
+	}
+	if v, err := t.isMounted(); err != nil {
+		t.StatusLog().Error("%s", err)
+		return status.Undef
+	} else if !v {
+		return status.Down
+	}
+	if t.canCheckReadAccess() {
+		if err := t.checkReadAccess(); err != nil {
+			t.StatusLog().Error("%s", err)
+			return status.Warn
+		}
+	}
+	return status.Up
+}
+
+func (t *T) Label() string {
+	return t.Source + "@" + t.MountPoint
+}
+
+func (t *T) Provision(ctx context.Context) error {
+	return nil
+}
+
+func (t *T) Unprovision(ctx context.Context) error {
+	return nil
+}
+
+func (t *T) Provisioned() (provisioned.T, error) {
+	return provisioned.NotApplicable, nil
+}
+
+func (t *T) Info(ctx context.Context) (resource.InfoKeys, error) {
+	m := resource.InfoKeys{
+		{Key: "dev", Value: t.Source},
+		{Key: "mnt", Value: t.MountPoint},
+		{Key: "mnt_opt", Value: t.MountOptions},
+	}
+	return m, nil
+}
+
+// Head returns the mount point, so the resources bind mounting or
+// overlaying a path under it can be ordered after this resource.
+func (t *T) Head() string {
+	return t.MountPoint
+}
+
+// fsDir returns a fs.dir resource applying the user, group and perm
+// keywords to the mount point.
+func (t *T) fsDir() *resfsdir.T {
+	r := resfsdir.New().(*resfsdir.T)
+	r.SetRID(t.RID())
+	r.SetObject(t.GetObject())
+	r.Path = t.MountPoint
+	r.User = t.User
+	r.Group = t.Group
+	r.Perm = t.Perm
+	return r
+}
+
+func (t *T) canCheckReadAccess() bool {
+	if !t.CheckRead {
+		return false
+	}
+	_, opts := parseOptions(t.MountOptions)
+	return !slices.Contains(opts, "nointr")
+}
+
+// checkReadAccess stats the mount point, bounded by the stat_timeout.
+func (t *T) checkReadAccess() error {
+	var (
+		cmd  *exec.Cmd
+		name = "stat"
+		arg  = []string{"-f", t.MountPoint}
+		now  = time.Now()
+	)
+	if t.StatTimeout != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), *t.StatTimeout)
+		defer cancel()
+		cmd = exec.CommandContext(ctx, name, arg...)
+	} else {
+		cmd = exec.Command(name, arg...)
+	}
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("check read access failed after %d ms: %w", time.Since(now).Milliseconds(), err)
+	}
+	return nil
+}
+
+// isMounted returns true if a filesystem is mounted on the mount point and
+// the mount point is the source file or directory.
+func (t *T) isMounted() (bool, error) {
+	l, err := findmnt.ListMountPoint(t.MountPoint)
+	if err != nil {
+		return false, err
+	}
+	if len(l) == 0 {
+		return false, nil
+	}
+	srcInfo, err := os.Stat(t.Source)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	mntInfo, err := os.Stat(t.MountPoint)
+	if err != nil {
+		return false, err
+	}
+	return os.SameFile(srcInfo, mntInfo), nil
+}
+
+// createMountPoint creates the missing mount point, as a directory if the
+// source is a directory, or as an empty file if the source is a file.
+func (t *T) createMountPoint(isDir bool) error {
+	if _, err := os.Stat(t.MountPoint); err == nil {
+		return nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	t.Log().Infof("create missing mountpoint %s", t.MountPoint)
+	if isDir {
+		return os.MkdirAll(t.MountPoint, 0755)
+	}
+	if err := os.MkdirAll(filepath.Dir(t.MountPoint), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(t.MountPoint, os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+func (t *T) mount(args ...string) error {
+	return t.run("mount", args...)
+}
+
+func (t *T) umount() error {
+	return t.run("umount", t.MountPoint)
+}
+
+func (t *T) run(name string, args ...string) error {
+	cmd := command.New(
+		command.WithName(name),
+		command.WithVarArgs(args...),
+		command.WithLogger(t.Log()),
+		command.WithTimeout(time.Minute),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	cmd.Run()
+	if exitCode := cmd.ExitCode(); exitCode != 0 {
+		return fmt.Errorf("%s exit code %d", cmd, exitCode)
+	}
+	return nil
+}
+
+// parseOptions splits the mount options into the recursive bind flag and
+// the options to apply with a bind remount.
+func parseOptions(s string) (recursive bool, opts []string) {
+	opts = make([]string, 0)
+	for _, opt := range strings.Split(s, ",") {
+		opt = strings.TrimSpace(opt)
+		switch opt {
+		case "":
+		case "bind":
+		case "rbind":
+			recursive = true
+		default:
+			opts = append(opts, opt)
+		}
+	}
+	return
+}
@@ -0,0 +1,92 @@
+package resfsbind
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/status"
+)
+
+func TestParseOptions(t *testing.T) {
+	recursive, opts := parseOptions("")
+	assert.False(t, recursive)
+	assert.Empty(t, opts)
+
+	recursive, opts = parseOptions("bind, ro,nosuid")
+	assert.False(t, recursive)
+	assert.Equal(t, []string{"ro", "nosuid"}, opts)
+
+	recursive, opts = parseOptions("rbind")
+	assert.True(t, recursive)
+	assert.Empty(t, opts)
+}
+
+func TestStartStop(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("skipped for non root user")
+	}
+	ctx := context.Background()
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src")
+	require.NoError(t, os.Mkdir(src, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "foo"), []byte("foo"), 0644))
+
+	r := &T{
+		Source:       src,
+		MountPoint:   filepath.Join(dir, "mnt"),
+		MountOptions: "ro",
+	}
+	require.NoError(t, r.SetRID("fs#1"))
+	assert.Equal(t, status.Down, r.Status(ctx))
+	if err := r.Start(ctx); err != nil {
+		t.Skipf("can not bind mount: %s", err)
+	}
+	t.Cleanup(func() { _ = r.umount() })
+
+	assert.Equal(t, status.Up, r.Status(ctx))
+	assert.FileExists(t, filepath.Join(r.MountPoint, "foo"))
+	assert.Error(t, os.WriteFile(filepath.Join(r.MountPoint, "bar"), []byte("bar"), 0644), "the bind mount is read-only")
+	require.NoError(t, r.Start(ctx), "start is idempotent")
+
+	require.NoError(t, r.Stop(ctx))
+	assert.Equal(t, status.Down, r.Status(ctx))
+	assert.NoFileExists(t, filepath.Join(r.MountPoint, "foo"))
+	require.NoError(t, r.Stop(ctx), "stop is idempotent")
+}
+
+func TestStartFile(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("skipped for non root user")
+	}
+	ctx := context.Background()
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src.conf")
+	require.NoError(t, os.WriteFile(src, []byte("foo"), 0644))
+
+	r := &T{
+		Source:     src,
+		MountPoint: filepath.Join(dir, "etc", "mnt.conf"),
+	}
+	require.NoError(t, r.SetRID("fs#1"))
+	if err := r.Start(ctx); err != nil {
+		t.Skipf("can not bind mount: %s", err)
+	}
+	t.Cleanup(func() { _ = r.umount() })
+
+	assert.Equal(t, status.Up, r.Status(ctx))
+	b, err := os.ReadFile(r.MountPoint)
+	require.NoError(t, err)
+	assert.Equal(t, "foo", string(b))
+	require.NoError(t, r.Stop(ctx))
+}
+
+func TestCanCheckReadAccess(t *testing.T) {
+	assert.False(t, (&T{}).canCheckReadAccess())
+	assert.True(t, (&T{CheckRead: true, MountOptions: "ro"}).canCheckReadAccess())
+	assert.False(t, (&T{CheckRead: true, MountOptions: "ro,nointr"}).canCheckReadAccess())
+}
@@ -0,0 +1,64 @@
+package resfsbind
+
+import (
+	"embed"
+
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/core/keywords"
+	"github.com/opensvc/om3/core/manifest"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/drivers/resfshost"
+)
+
+var (
+	//go:embed text
+	fs embed.FS
+
+	drvID = driver.NewID(driver.GroupFS, "bind")
+)
+
+func init() {
+	driver.Register(drvID, New)
+}
+
+// Manifest exposes to the core the input expected by the driver.
+func (t *T) Manifest() *manifest.T {
+	m := manifest.New(drvID, t)
+	m.Kinds.Or(naming.KindSvc, naming.KindVol)
+	m.Add(
+		keywords.Keyword{
+			Attr:     "MountPoint",
+			Example:  "/srv/{fqdn}/conf",
+			Option:   "mnt",
+			Required: true,
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/mnt"),
+		},
+		keywords.Keyword{
+			Attr:     "Source",
+			Example:  "{volume#1.mnt}/conf",
+			Option:   "dev",
+			Required: true,
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/dev"),
+		},
+		keywords.Keyword{
+			Attr:     "MountOptions",
+			Example:  "ro,nosuid",
+			Option:   "mnt_opt",
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/mnt_opt"),
+		},
+	)
+	// the keywords supported by the fs.host driver handling the bind
+	// mounts before this driver.
+	m.AddKeywords(
+		resfshost.KeywordStatTimeout,
+		resfshost.KeywordPromoteRW,
+		resfshost.KeywordUser,
+		resfshost.KeywordGroup,
+		resfshost.KeywordPerm,
+		resfshost.KeywordCheckRead,
+	)
+	return m
+}
@@ -0,0 +1,80 @@
+package resfshost
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/opensvc/om3/core/actionresdeps"
+	"github.com/opensvc/om3/core/resource"
+	"github.com/opensvc/om3/core/xconfig"
+)
+
+type (
+	configer interface {
+		Config() *xconfig.T
+	}
+
+	header interface {
+		Head() string
+	}
+)
+
+// HeadDeps returns the action resource dependencies of the resource rid on
+// the resources of the object o whose head directory contains one of the
+// paths, like the filesystem hosting the source directory of a bind mount.
+//
+// The start of the resource rid waits for the start of these resources,
+// and their stop waits for the stop of the resource rid.
+//
+// The object registers the action resource dependencies after all its
+// resources are configured, so all the object resources are considered,
+// whatever their position in the configuration.
+func HeadDeps(o any, rid string, paths ...string) []actionresdeps.Dep {
+	deps := make([]actionresdeps.Dep, 0)
+	c, ok := o.(configer)
+	if !ok {
+		return deps
+	}
+	od, ok := o.(resource.ObjectDriver)
+	if !ok {
+		return deps
+	}
+	for _, section := range c.Config().SectionStrings() {
+		if section == rid {
+			continue
+		}
+		r := od.ResourceByID(section)
+		if r == nil {
+			continue
+		}
+		h, ok := r.(header)
+		if !ok {
+			continue
+		}
+		head := h.Head()
+		for _, p := range paths {
+			if isSubPath(p, head) {
+				deps = append(deps,
+					actionresdeps.Dep{Action: "start", A: rid, B: section},
+					actionresdeps.Dep{Action: "stop", A: section, B: rid},
+				)
+				break
+			}
+		}
+	}
+	return deps
+}
+
+// isSubPath returns true if p is head or a path under head. The root
+// directory is not considered a head.
+func isSubPath(p, head string) bool {
+	if p == "" || head == "" {
+		return false
+	}
+	p = filepath.Clean(p)
+	head = filepath.Clean(head)
+	if head == string(filepath.Separator) {
+		return false
+	}
+	return p == head || strings.HasPrefix(p, head+string(filepath.Separator))
+}
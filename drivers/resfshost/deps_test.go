@@ -0,0 +1,27 @@
+package resfshost
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSubPath(t *testing.T) {
+	cases := []struct {
+		path     string
+		head     string
+		expected bool
+	}{
+		{"/srv/svc1", "/srv/svc1", true},
+		{"/srv/svc1/data", "/srv/svc1", true},
+		{"/srv/svc1/data/", "/srv/svc1/", true},
+		{"/srv/svc10", "/srv/svc1", false},
+		{"/srv", "/srv/svc1", false},
+		{"/srv/svc1", "/", false},
+		{"", "/srv/svc1", false},
+		{"/srv/svc1", "", false},
+	}
+	for _, c := range cases {
+		assert.Equalf(t, c.expected, isSubPath(c.path, c.head), "isSubPath(%s, %s)", c.path, c.head)
+	}
+}
@@ -140,6 +140,10 @@ var (
 
 func init() {
 	for _, t := range filesystems.Types() {
+		if t == "bind" {
+			// handled by the fs.bind driver
+			continue
+		}
 		driver.Register(driver.NewID(driver.GroupFS, t), NewF(t))
 	}
 }
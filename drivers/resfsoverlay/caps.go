@@ -0,0 +1,20 @@
+//go:build linux
+
+package resfsoverlay
+
+import (
+	"os/exec"
+
+	"github.com/opensvc/om3/util/capabilities"
+)
+
+func init() {
+	capabilities.Register(capabilitiesScanner)
+}
+
+func capabilitiesScanner() ([]string, error) {
+	if _, err := exec.LookPath("mount"); err != nil {
+		return []string{}, nil
+	}
+	return []string{drvID.Cap()}, nil
+}
@@ -0,0 +1,268 @@
+//go:build linux
+
+package resfsoverlay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/core/actionresdeps"
+	"github.com/opensvc/om3/core/actionrollback"
+	"github.com/opensvc/om3/core/provisioned"
+	"github.com/opensvc/om3/core/resource"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/drivers/resfshost"
+	"github.com/opensvc/om3/util/command"
+	"github.com/opensvc/om3/util/findmnt"
+)
+
+type (
+	T struct {
+		resource.T
+		MountPoint   string   `json:"mnt"`
+		Lower        []string `json:"lower"`
+		Upper        string   `json:"upper"`
+		Work         string   `json:"work"`
+		MountOptions string   `json:"mnt_opt"`
+	}
+)
+
+const fsType = "overlay"
+
+func New() resource.Driver {
+	t := &T{}
+	return t
+}
+
+// ActionResourceDeps orders the overlay mount after the resources hosting
+// its mount point, lower, upper and work directories.
+func (t *T) ActionResourceDeps() []actionresdeps.Dep {
+	paths := append([]string{t.MountPoint, t.Upper, t.Work}, t.Lower...)
+	return resfshost.HeadDeps(t.GetObject(), t.RID(), paths...)
+}
+
+func (t *T) Start(ctx context.Context) error {
+	if v, err := t.isMounted(); err != nil {
+		return err
+	} else if v {
+		t.Log().Infof("overlay already mounted on %s", t.MountPoint)
+		return nil
+	}
+	if err := t.validate(); err != nil {
+		return err
+	}
+	if _, err := os.Stat(t.MountPoint); errors.Is(err, os.ErrNotExist) {
+		t.Log().Infof("create missing mountpoint %s", t.MountPoint)
+		if err := os.MkdirAll(t.MountPoint, 0755); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+	if err := t.run("mount", "-t", fsType, "-o", t.mountOptions(), fsType, t.MountPoint); err != nil {
+		return err
+	}
+	actionrollback.Register(ctx, func() error {
+		return t.umount()
+	})
+	return nil
+}
+
+func (t *T) Stop(ctx context.Context) error {
+	if v, err := t.isMounted(); err != nil {
+		return err
+	} else if !v {
+		t.Log().Infof("overlay already umounted from %s", t.MountPoint)
+		return nil
+	}
+	return t.umount()
+}
+
+func (t *T) Status(ctx context.Context) status.T {
+	if t.MountPoint == "" {
+		t.StatusLog().Info("mnt is not defined")
+		return status.NotApplicable
+	}
+	mi, err := t.mountInfo()
+	if err != nil {
+		t.StatusLog().Error("%s", err)
+		return status.Undef
+	}
+	if mi == nil {
+		return status.Down
+	}
+	if issues := t.checkOptions(mi.Options); len(issues) > 0 {
+		for _, issue := range issues {
+			t.StatusLog().Warn("%s", issue)
+		}
+		return status.Warn
+	}
+	return status.Up
+}
+
+func (t *T) Label() string {
+	return fsType + "@" + t.MountPoint
+}
+
+// Provision creates the upper and work directories.
+func (t *T) Provision(ctx context.Context) error {
+	for _, p := range []string{t.Upper, t.Work} {
+		if p == "" {
+			continue
+		}
+		if _, err := os.Stat(p); err == nil {
+			continue
+		}
+		t.Log().Infof("create %s", p)
+		if err := os.MkdirAll(p, 0755); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Unprovision removes the work directory. The upper directory is kept, as
+// it hosts the data written through the overlay.
+func (t *T) Unprovision(ctx context.Context) error {
+	if t.Work == "" {
+		return nil
+	}
+	if _, err := os.Stat(t.Work); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	t.Log().Infof("remove %s", t.Work)
+	return os.RemoveAll(t.Work)
+}
+
+func (t *T) Provisioned() (provisioned.T, error) {
+	if t.Upper == "" {
+		return provisioned.NotApplicable, nil
+	}
+	for _, p := range []string{t.Upper, t.Work} {
+		if p == "" {
+			continue
+		}
+		if _, err := os.Stat(p); errors.Is(err, os.ErrNotExist) {
+			return provisioned.False, nil
+		} else if err != nil {
+			return provisioned.Undef, err
+		}
+	}
+	return provisioned.True, nil
+}
+
+func (t *T) Info(ctx context.Context) (resource.InfoKeys, error) {
+	m := resource.InfoKeys{
+		{Key: "mnt", Value: t.MountPoint},
+		{Key: "lower", Value: strings.Join(t.Lower, " ")},
+		{Key: "upper", Value: t.Upper},
+		{Key: "work", Value: t.Work},
+		{Key: "mnt_opt", Value: t.MountOptions},
+	}
+	return m, nil
+}
+
+// Head returns the mount point, so the resources bind mounting or
+// overlaying a path under it can be ordered after this resource.
+func (t *T) Head() string {
+	return t.MountPoint
+}
+
+// validate verifies the directories to overlay exist.
+func (t *T) validate() error {
+	if len(t.Lower) == 0 {
+		return fmt.Errorf("lower is not defined")
+	}
+	if t.Upper != "" && t.Work == "" {
+		return fmt.Errorf("work is required when upper is set")
+	}
+	for _, p := range append([]string{t.Upper, t.Work}, t.Lower...) {
+		if p == "" {
+			continue
+		}
+		if _, err := os.Stat(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mountOptions returns the options passed to the overlay mount command.
+func (t *T) mountOptions() string {
+	l := []string{"lowerdir=" + strings.Join(t.Lower, ":")}
+	if t.Upper != "" {
+		l = append(l, "upperdir="+t.Upper, "workdir="+t.Work)
+	}
+	if t.MountOptions != "" {
+		l = append(l, t.MountOptions)
+	}
+	return strings.Join(l, ",")
+}
+
+// checkOptions returns the differences between the lowerdir and upperdir
+// options of the mounted overlay and the resource configuration.
+func (t *T) checkOptions(s string) []string {
+	issues := make([]string, 0)
+	opts := make(map[string]string)
+	for _, opt := range strings.Split(s, ",") {
+		k, v, _ := strings.Cut(opt, "=")
+		opts[k] = v
+	}
+	if v, expected := opts["lowerdir"], strings.Join(t.Lower, ":"); v != expected {
+		issues = append(issues, fmt.Sprintf("lowerdir is '%s', expected '%s'", v, expected))
+	}
+	if v := opts["upperdir"]; v != t.Upper {
+		issues = append(issues, fmt.Sprintf("upperdir is '%s', expected '%s'", v, t.Upper))
+	}
+	return issues
+}
+
+// mountInfo returns the information of the overlay mounted at the top of
+// the mount point stack, or nil if the mount point is not the mount point
+// of an overlay.
+func (t *T) mountInfo() (*findmnt.MountInfo, error) {
+	l, err := findmnt.ListMountPoint(t.MountPoint)
+	if err != nil {
+		return nil, err
+	}
+	if len(l) == 0 {
+		return nil, nil
+	}
+	mi := l[len(l)-1]
+	if mi.FsType != fsType {
+		return nil, nil
+	}
+	return &mi, nil
+}
+
+func (t *T) isMounted() (bool, error) {
+	mi, err := t.mountInfo()
+	return mi != nil, err
+}
+
+func (t *T) umount() error {
+	return t.run("umount", t.MountPoint)
+}
+
+func (t *T) run(name string, args ...string) error {
+	cmd := command.New(
+		command.WithName(name),
+		command.WithVarArgs(args...),
+		command.WithLogger(t.Log()),
+		command.WithTimeout(time.Minute),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	cmd.Run()
+	if exitCode := cmd.ExitCode(); exitCode != 0 {
+		return fmt.Errorf("%s exit code %d", cmd, exitCode)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+//go:build linux
+
+package resfsoverlay
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/provisioned"
+	"github.com/opensvc/om3/core/status"
+)
+
+func TestMountOptions(t *testing.T) {
+	r := T{Lower: []string{"/a", "/b"}}
+	assert.Equal(t, "lowerdir=/a:/b", r.mountOptions())
+
+	r.Upper = "/u"
+	r.Work = "/w"
+	r.MountOptions = "index=on"
+	assert.Equal(t, "lowerdir=/a:/b,upperdir=/u,workdir=/w,index=on", r.mountOptions())
+
+	assert.Empty(t, r.checkOptions("rw,relatime,lowerdir=/a:/b,upperdir=/u,workdir=/w,index=on"))
+	assert.Equal(t, []string{
+		"lowerdir is '/a', expected '/a:/b'",
+		"upperdir is '', expected '/u'",
+	}, r.checkOptions("ro,relatime,lowerdir=/a"))
+}
+
+func TestValidate(t *testing.T) {
+	dir := t.TempDir()
+	r := T{}
+	assert.Error(t, r.validate(), "lower is required")
+	r.Lower = []string{dir}
+	assert.NoError(t, r.validate())
+	r.Upper = filepath.Join(dir, "upper")
+	assert.Error(t, r.validate(), "work is required with upper")
+	r.Work = filepath.Join(dir, "work")
+	assert.Error(t, r.validate(), "upper and work must exist")
+}
+
+func TestLifecycle(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("skipped for non root user")
+	}
+	ctx := context.Background()
+	dir := t.TempDir()
+	lower := filepath.Join(dir, "lower")
+	require.NoError(t, os.Mkdir(lower, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(lower, "foo"), []byte("foo"), 0644))
+
+	r := &T{
+		MountPoint: filepath.Join(dir, "mnt"),
+		Lower:      []string{lower},
+		Upper:      filepath.Join(dir, "data", "upper"),
+		Work:       filepath.Join(dir, "data", "work"),
+	}
+	require.NoError(t, r.SetRID("fs#1"))
+
+	v, err := r.Provisioned()
+	require.NoError(t, err)
+	assert.Equal(t, provisioned.False, v)
+	require.NoError(t, r.Provision(ctx))
+	v, err = r.Provisioned()
+	require.NoError(t, err)
+	assert.Equal(t, provisioned.True, v)
+
+	assert.Equal(t, status.Down, r.Status(ctx))
+	if err := r.Start(ctx); err != nil {
+		t.Skipf("can not mount overlay: %s", err)
+	}
+	t.Cleanup(func() { _ = r.umount() })
+	assert.Equal(t, status.Up, r.Status(ctx))
+
+	require.NoError(t, os.WriteFile(filepath.Join(r.MountPoint, "bar"), []byte("bar"), 0644))
+	assert.FileExists(t, filepath.Join(r.MountPoint, "foo"))
+	assert.FileExists(t, filepath.Join(r.Upper, "bar"), "writes go to the upper directory")
+	assert.NoFileExists(t, filepath.Join(lower, "bar"), "the lower directory is not modified")
+
+	require.NoError(t, r.Stop(ctx))
+	assert.Equal(t, status.Down, r.Status(ctx))
+
+	require.NoError(t, r.Unprovision(ctx))
+	assert.NoDirExists(t, r.Work)
+	assert.FileExists(t, filepath.Join(r.Upper, "bar"), "unprovision keeps the upper data")
+}
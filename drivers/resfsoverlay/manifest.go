@@ -0,0 +1,71 @@
+//go:build linux
+
+package resfsoverlay
+
+import (
+	"embed"
+
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/core/keywords"
+	"github.com/opensvc/om3/core/manifest"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/util/converters"
+)
+
+var (
+	//go:embed text
+	fs embed.FS
+
+	drvID = driver.NewID(driver.GroupFS, "overlay")
+)
+
+func init() {
+	driver.Register(drvID, New)
+}
+
+// Manifest exposes to the core the input expected by the driver.
+func (t *T) Manifest() *manifest.T {
+	m := manifest.New(drvID, t)
+	m.Kinds.Or(naming.KindSvc, naming.KindVol)
+	m.Add(
+		keywords.Keyword{
+			Attr:     "MountPoint",
+			Example:  "/srv/{fqdn}/root",
+			Option:   "mnt",
+			Required: true,
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/mnt"),
+		},
+		keywords.Keyword{
+			Attr:      "Lower",
+			Converter: converters.List,
+			Example:   "/srv/{fqdn}/base /opt/image",
+			Option:    "lower",
+			Required:  true,
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/lower"),
+		},
+		keywords.Keyword{
+			Attr:     "Upper",
+			Example:  "{volume#1.mnt}/upper",
+			Option:   "upper",
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/upper"),
+		},
+		keywords.Keyword{
+			Attr:     "Work",
+			Example:  "{volume#1.mnt}/work",
+			Option:   "work",
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/work"),
+		},
+		keywords.Keyword{
+			Attr:     "MountOptions",
+			Example:  "index=on",
+			Option:   "mnt_opt",
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/mnt_opt"),
+		},
+	)
+	return m
+}
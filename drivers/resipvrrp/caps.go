@@ -0,0 +1,13 @@
+//go:build linux
+
+package resipvrrp
+
+import "github.com/opensvc/om3/util/capabilities"
+
+func init() {
+	capabilities.Register(capabilitiesScanner)
+}
+
+func capabilitiesScanner() ([]string, error) {
+	return []string{drvID.Cap()}, nil
+}
@@ -0,0 +1,584 @@
+//go:build linux
+
+package resipvrrp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/j-keck/arping"
+
+	"github.com/opensvc/om3/core/actionrollback"
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/provisioned"
+	"github.com/opensvc/om3/core/resource"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/netif"
+	"github.com/opensvc/om3/util/vrrp"
+)
+
+type (
+	T struct {
+		resource.T
+
+		Path naming.Path
+
+		IPName         string         `json:"ipname"`
+		IPDev          string         `json:"ipdev"`
+		Netmask        string         `json:"netmask"`
+		VRID           int            `json:"vrid"`
+		AdvertInterval *time.Duration `json:"advert_interval"`
+		Preempt        bool           `json:"preempt"`
+	}
+
+	// speakerState is the state of the speaker process, as exposed to the
+	// resource status through the state file.
+	speakerState struct {
+		PID       int       `json:"pid"`
+		State     string    `json:"state"`
+		Priority  uint8     `json:"priority"`
+		Master    string    `json:"master,omitempty"`
+		UpdatedAt time.Time `json:"updated_at"`
+	}
+)
+
+const (
+	// speakerCommand is the hidden om command running the speaker of a
+	// resource.
+	speakerCommand = "vrrp"
+
+	// priorityRefreshInterval is the interval between two reads of the
+	// placement rank of the local instance in the local daemon.
+	priorityRefreshInterval = 5 * time.Second
+
+	speakerStartTimeout = 5 * time.Second
+	speakerStopTimeout  = 10 * time.Second
+)
+
+func New() resource.Driver {
+	t := &T{}
+	return t
+}
+
+func (t *T) Start(ctx context.Context) error {
+	if pid := t.speakerPID(); pid > 0 {
+		t.Log().Infof("vrrp speaker is already running (pid %d)", pid)
+		return nil
+	}
+	if _, err := t.ipnet(); err != nil {
+		return err
+	}
+	if t.VRID < 1 || t.VRID > 255 {
+		return fmt.Errorf("vrid %d is out of the [1, 255] range", t.VRID)
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(t.VarDir(), 0700); err != nil {
+		return err
+	}
+	_ = os.Remove(t.stateFile())
+	cmd := exec.Command(exe, speakerCommand, "--path", t.Path.String(), "--rid", t.RID())
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+	t.Log().Infof("start the vrrp speaker: %s", cmd)
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	pid := cmd.Process.Pid
+	exited := make(chan error, 1)
+	go func() { exited <- cmd.Wait() }()
+	if err := os.WriteFile(t.pidFile(), []byte(strconv.Itoa(pid)), 0600); err != nil {
+		_ = cmd.Process.Kill()
+		return err
+	}
+	actionrollback.Register(ctx, func() error {
+		return t.stopSpeaker(pid)
+	})
+	timer := time.NewTimer(speakerStartTimeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case err := <-exited:
+			return fmt.Errorf("vrrp speaker exited: %v", err)
+		case <-timer.C:
+			return fmt.Errorf("vrrp speaker did not report its state in %s", speakerStartTimeout)
+		case <-ticker.C:
+			if state, err := t.readState(); err == nil && state.PID == pid {
+				t.Log().Infof("vrrp speaker started (pid %d, %s, priority %d)", pid, state.State, state.Priority)
+				return nil
+			}
+		}
+	}
+}
+
+func (t *T) Stop(ctx context.Context) error {
+	pid := t.speakerPID()
+	if pid == 0 {
+		t.Log().Infof("vrrp speaker is already stopped")
+	} else if err := t.stopSpeaker(pid); err != nil {
+		return err
+	}
+	// the speaker unplumbs the virtual ip on exit, unless killed
+	return t.unplumb()
+}
+
+func (t *T) Status(ctx context.Context) status.T {
+	if t.IPName == "" {
+		t.StatusLog().Warn("ipname not set")
+		return status.NotApplicable
+	}
+	if t.IPDev == "" {
+		t.StatusLog().Warn("ipdev not set")
+		return status.NotApplicable
+	}
+	if t.speakerPID() == 0 {
+		if v, err := t.isPlumbed(); err == nil && v {
+			t.StatusLog().Warn("%s is plumbed on %s without vrrp speaker", t.IPName, t.IPDev)
+			return status.Warn
+		}
+		return status.Down
+	}
+	state, err := t.readState()
+	if err != nil {
+		t.StatusLog().Warn("vrrp speaker state: %s", err)
+		return status.Warn
+	}
+	switch state.State {
+	case vrrp.StateMaster.String():
+		t.StatusLog().Info("vrrp master, priority %d", state.Priority)
+	default:
+		t.StatusLog().Info("vrrp %s, priority %d, master %s", state.State, state.Priority, state.Master)
+	}
+	return status.Up
+}
+
+func (t *T) Label() string {
+	ipnet, err := t.ipnet()
+	if err != nil {
+		return fmt.Sprintf("%s %s vrid %d", t.IPName, t.IPDev, t.VRID)
+	}
+	return fmt.Sprintf("%s %s vrid %d", ipnet, t.IPDev, t.VRID)
+}
+
+func (t *T) Provision(ctx context.Context) error {
+	return nil
+}
+
+func (t *T) Unprovision(ctx context.Context) error {
+	return nil
+}
+
+func (t *T) Provisioned() (provisioned.T, error) {
+	return provisioned.NotApplicable, nil
+}
+
+func (t *T) Info(ctx context.Context) (resource.InfoKeys, error) {
+	m := resource.InfoKeys{
+		{Key: "ipname", Value: t.IPName},
+		{Key: "ipdev", Value: t.IPDev},
+		{Key: "vrid", Value: strconv.Itoa(t.VRID)},
+		{Key: "advert_interval", Value: t.advertInterval().String()},
+		{Key: "preempt", Value: strconv.FormatBool(t.Preempt)},
+	}
+	if state, err := t.readState(); err == nil {
+		m = append(m,
+			resource.InfoKey{Key: "state", Value: state.State},
+			resource.InfoKey{Key: "priority", Value: strconv.Itoa(int(state.Priority))},
+		)
+	}
+	return m, nil
+}
+
+// Run runs the vrrp speaker of the ip.vrrp resource rid of the object p,
+// until ctx is done. It is the entrypoint of the speaker process started
+// by the resource.
+func Run(ctx context.Context, p naming.Path, rid string) error {
+	o, err := object.NewActor(p)
+	if err != nil {
+		return err
+	}
+	o.Resources()
+	r := o.ResourceByID(rid)
+	if r == nil {
+		return fmt.Errorf("%s: resource %s not found", p, rid)
+	}
+	t, ok := r.(*T)
+	if !ok {
+		return fmt.Errorf("%s: resource %s is not a %s resource", p, rid, drvID)
+	}
+	return t.run(ctx)
+}
+
+func (t *T) run(ctx context.Context) error {
+	ipnet, err := t.ipnet()
+	if err != nil {
+		return err
+	}
+	// the speaker starts as backup, so a virtual ip left plumbed by a
+	// killed speaker is not advertised by two masters.
+	if err := t.unplumb(); err != nil {
+		return err
+	}
+	conn, err := vrrp.Listen(t.IPDev, []net.IP{ipnet.IP})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	priority, err := t.priority(ctx)
+	if err != nil {
+		t.Log().Warnf("%s, use the lowest priority", err)
+	}
+	s := vrrp.NewSpeaker(conn, uint8(t.VRID), []net.IP{ipnet.IP}, priority)
+	s.Interval = t.advertInterval()
+	s.Preempt = t.Preempt
+	s.OnMaster = func() error {
+		t.Log().Infof("become vrrp master, priority %d", s.Priority())
+		return t.plumb()
+	}
+	s.OnBackup = func() error {
+		t.Log().Infof("leave the vrrp master state")
+		return t.unplumb()
+	}
+	// writeState is called by the speaker state change callback and the
+	// priority refresh goroutine. The lock serializes the writes of the
+	// state file, so the last write holds the latest state.
+	var stateMu sync.Mutex
+	writeState := func() {
+		stateMu.Lock()
+		defer stateMu.Unlock()
+		state := speakerState{
+			PID:       os.Getpid(),
+			State:     s.State().String(),
+			Priority:  s.Priority(),
+			UpdatedAt: time.Now(),
+		}
+		if master := s.Master(); master != nil {
+			state.Master = master.String()
+		}
+		if err := t.writeState(state); err != nil {
+			t.Log().Warnf("write vrrp speaker state: %s", err)
+		}
+	}
+	s.OnStateChange = func(state vrrp.State) {
+		t.Log().Infof("vrrp state %s", state)
+		writeState()
+	}
+
+	go func() {
+		ticker := time.NewTicker(priorityRefreshInterval)
+		defer ticker.Stop()
+		master := ""
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if priority, err := t.priority(ctx); err != nil {
+				t.Log().Debugf("%s, keep priority %d", err, s.Priority())
+			} else if priority != s.Priority() {
+				t.Log().Infof("change vrrp priority %d -> %d", s.Priority(), priority)
+				s.SetPriority(priority)
+				writeState()
+			}
+			// also refresh the master seen by a backup
+			if m := s.Master().String(); m != master {
+				master = m
+				writeState()
+			}
+		}
+	}()
+
+	t.Log().Infof("run the vrrp speaker of vrid %d on %s, priority %d", t.VRID, t.IPDev, priority)
+	return s.Run(ctx)
+}
+
+// priority returns the vrrp priority of the local speaker, derived from
+// the placement rank of the local instance, so the virtual ip is held by
+// the instance the placement policy prefers.
+func (t *T) priority(ctx context.Context) (uint8, error) {
+	rank, err := t.placementRank(ctx)
+	if err != nil {
+		return priorityFromRank(0), err
+	}
+	return priorityFromRank(rank), nil
+}
+
+// priorityFromRank returns 254 for the 1st placement candidate, 253 for
+// the 2nd, and so on. The nodes not candidate have the lowest priority.
+func priorityFromRank(rank int) uint8 {
+	if rank < 1 || rank > 253 {
+		return 1
+	}
+	return uint8(255 - rank)
+}
+
+func (t *T) placementRank(ctx context.Context) (int, error) {
+	c, err := client.New()
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, priorityRefreshInterval)
+	defer cancel()
+	resp, err := c.GetInstanceWithResponse(ctx, hostname.Hostname(), t.Path.Namespace, t.Path.Kind, t.Path.Name)
+	if err != nil {
+		return 0, fmt.Errorf("get the local instance placement rank: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return 0, fmt.Errorf("get the local instance placement rank: unexpected status %s", resp.Status())
+	}
+	if resp.JSON200.Data.Monitor == nil {
+		return 0, nil
+	}
+	return resp.JSON200.Data.Monitor.PlacementRank, nil
+}
+
+func (t *T) plumb() error {
+	ipnet, err := t.ipnet()
+	if err != nil {
+		return err
+	}
+	if v, err := t.isPlumbed(); err != nil {
+		return err
+	} else if !v {
+		t.Log().Infof("add %s to %s", ipnet, t.IPDev)
+		if err := netif.AddAddr(t.IPDev, ipnet); err != nil {
+			return err
+		}
+	}
+	t.Log().Infof("send gratuitous arp to announce %s over %s", ipnet.IP, t.IPDev)
+	if err := arping.GratuitousArpOverIfaceByName(ipnet.IP, t.IPDev); err != nil {
+		t.Log().Warnf("gratuitous arp: %s", err)
+	}
+	return nil
+}
+
+func (t *T) unplumb() error {
+	if v, err := t.isPlumbed(); err != nil {
+		return err
+	} else if !v {
+		return nil
+	}
+	ipnet, err := t.ipnet()
+	if err != nil {
+		return err
+	}
+	t.Log().Infof("delete %s from %s", ipnet, t.IPDev)
+	return netif.DelAddr(t.IPDev, ipnet)
+}
+
+func (t *T) isPlumbed() (bool, error) {
+	ipnet, err := t.ipnet()
+	if err != nil {
+		return false, err
+	}
+	iface, err := net.InterfaceByName(t.IPDev)
+	if err != nil {
+		return false, err
+	}
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return false, err
+	}
+	for _, addr := range addrs {
+		if ip, _, err := net.ParseCIDR(addr.String()); err == nil && ip.Equal(ipnet.IP) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ipnet returns the virtual ip and its netmask.
+func (t *T) ipnet() (*net.IPNet, error) {
+	ip := net.ParseIP(t.IPName)
+	if ip == nil {
+		l, err := net.LookupIP(t.IPName)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range l {
+			if e.To4() != nil {
+				ip = e
+				break
+			}
+		}
+	}
+	if ip == nil || ip.To4() == nil {
+		return nil, fmt.Errorf("ipname %s is not an ipv4 address", t.IPName)
+	}
+	mask, err := t.netmask()
+	if err != nil {
+		return nil, err
+	}
+	return &net.IPNet{IP: ip.To4(), Mask: mask}, nil
+}
+
+func (t *T) netmask() (net.IPMask, error) {
+	if t.Netmask == "" {
+		return t.defaultMask()
+	}
+	return parseMask(t.Netmask)
+}
+
+// defaultMask returns the netmask of the first ipv4 address of the
+// interface.
+func (t *T) defaultMask() (net.IPMask, error) {
+	iface, err := net.InterfaceByName(t.IPDev)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return nil, err
+	}
+	for _, addr := range addrs {
+		if ipNet, ok := addr.(*net.IPNet); ok && ipNet.IP.To4() != nil {
+			return ipNet.Mask, nil
+		}
+	}
+	return nil, fmt.Errorf("no ipv4 address on %s to guess the netmask from", t.IPDev)
+}
+
+// parseMask parses a netmask in the dotted or octal format.
+func parseMask(s string) (net.IPMask, error) {
+	if i, err := strconv.Atoi(s); err == nil {
+		if i < 0 || i > 32 {
+			return nil, fmt.Errorf("invalid netmask %s", s)
+		}
+		return net.CIDRMask(i, 32), nil
+	}
+	if ip := net.ParseIP(s).To4(); ip != nil {
+		mask := net.IPMask(ip)
+		if _, bits := mask.Size(); bits == 0 {
+			return nil, fmt.Errorf("invalid netmask %s", s)
+		}
+		return mask, nil
+	}
+	return nil, fmt.Errorf("invalid netmask %s", s)
+}
+
+func (t *T) advertInterval() time.Duration {
+	if t.AdvertInterval == nil || *t.AdvertInterval <= 0 {
+		return time.Second
+	}
+	return *t.AdvertInterval
+}
+
+// speakerPID returns the pid of the running speaker process, or 0 if the
+// speaker is not running.
+func (t *T) speakerPID() int {
+	b, err := os.ReadFile(t.pidFile())
+	if err != nil {
+		return 0
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(b)))
+	if err != nil || pid <= 0 {
+		return 0
+	}
+	if !t.isSpeaker(pid) {
+		return 0
+	}
+	return pid
+}
+
+// isSpeaker returns true if the process pid is the speaker of this
+// resource, so a recycled pid is not mistaken for the speaker.
+func (t *T) isSpeaker(pid int) bool {
+	b, err := os.ReadFile(fmt.Sprintf("/proc/%d/cmdline", pid))
+	if err != nil {
+		return false
+	}
+	args := strings.Split(strings.TrimRight(string(b), "\x00"), "\x00")
+	return isSpeakerCmdline(args, t.Path.String(), t.RID())
+}
+
+func isSpeakerCmdline(args []string, path, rid string) bool {
+	if len(args) < 6 || args[1] != speakerCommand {
+		return false
+	}
+	var hasPath, hasRID bool
+	for i := 2; i+1 < len(args); i++ {
+		switch {
+		case args[i] == "--path" && args[i+1] == path:
+			hasPath = true
+		case args[i] == "--rid" && args[i+1] == rid:
+			hasRID = true
+		}
+	}
+	return hasPath && hasRID
+}
+
+// stopSpeaker sends a SIGTERM to the speaker process, so it resigns the
+// mastership and unplumbs the virtual ip, and kills it if still running
+// after speakerStopTimeout.
+func (t *T) stopSpeaker(pid int) error {
+	t.Log().Infof("stop the vrrp speaker (pid %d)", pid)
+	if err := syscall.Kill(pid, syscall.SIGTERM); err != nil && !errors.Is(err, syscall.ESRCH) {
+		return err
+	}
+	limit := time.Now().Add(speakerStopTimeout)
+	for t.isSpeaker(pid) {
+		if time.Now().After(limit) {
+			t.Log().Warnf("kill the vrrp speaker (pid %d) still running after %s", pid, speakerStopTimeout)
+			if err := syscall.Kill(pid, syscall.SIGKILL); err != nil && !errors.Is(err, syscall.ESRCH) {
+				return err
+			}
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	for _, p := range []string{t.pidFile(), t.stateFile()} {
+		if err := os.Remove(p); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *T) pidFile() string {
+	return filepath.Join(t.VarDir(), "vrrp.pid")
+}
+
+func (t *T) stateFile() string {
+	return filepath.Join(t.VarDir(), "vrrp.json")
+}
+
+func (t *T) readState() (speakerState, error) {
+	var state speakerState
+	b, err := os.ReadFile(t.stateFile())
+	if err != nil {
+		return state, err
+	}
+	err = json.Unmarshal(b, &state)
+	return state, err
+}
+
+func (t *T) writeState(state speakerState) error {
+	b, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	tmp := t.stateFile() + ".tmp"
+	if err := os.WriteFile(tmp, b, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, t.stateFile())
+}
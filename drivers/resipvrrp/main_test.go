@@ -0,0 +1,50 @@
+//go:build linux
+
+package resipvrrp
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriorityFromRank(t *testing.T) {
+	assert.Equal(t, uint8(254), priorityFromRank(1))
+	assert.Equal(t, uint8(253), priorityFromRank(2))
+	assert.Equal(t, uint8(2), priorityFromRank(253))
+	assert.Equal(t, uint8(1), priorityFromRank(254), "out of range ranks have the lowest priority")
+	assert.Equal(t, uint8(1), priorityFromRank(0), "not candidate nodes have the lowest priority")
+}
+
+func TestParseMask(t *testing.T) {
+	for _, s := range []string{"24", "255.255.255.0"} {
+		m, err := parseMask(s)
+		require.NoErrorf(t, err, "parseMask(%s)", s)
+		assert.Equalf(t, net.CIDRMask(24, 32), m, "parseMask(%s)", s)
+	}
+	for _, s := range []string{"33", "255.0.255.0", "foo"} {
+		_, err := parseMask(s)
+		assert.Errorf(t, err, "parseMask(%s)", s)
+	}
+}
+
+func TestIPNet(t *testing.T) {
+	r := T{IPName: "10.0.0.100", Netmask: "24", IPDev: "lo"}
+	ipnet, err := r.ipnet()
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.100/24", ipnet.String())
+
+	r.IPName = "fe80::100"
+	_, err = r.ipnet()
+	assert.Error(t, err, "ipv6 is not supported")
+}
+
+func TestIsSpeakerCmdline(t *testing.T) {
+	args := []string{"/usr/bin/om", "vrrp", "--path", "svc1", "--rid", "ip#1"}
+	assert.True(t, isSpeakerCmdline(args, "svc1", "ip#1"))
+	assert.False(t, isSpeakerCmdline(args, "svc2", "ip#1"))
+	assert.False(t, isSpeakerCmdline(args, "svc1", "ip#2"))
+	assert.False(t, isSpeakerCmdline([]string{"/bin/sleep", "60"}, "svc1", "ip#1"))
+}
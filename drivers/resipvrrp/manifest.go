@@ -0,0 +1,82 @@
+//go:build linux
+
+package resipvrrp
+
+import (
+	"embed"
+
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/core/keywords"
+	"github.com/opensvc/om3/core/manifest"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/util/converters"
+)
+
+var (
+	//go:embed text
+	fs embed.FS
+
+	drvID = driver.NewID(driver.GroupIP, "vrrp")
+)
+
+func init() {
+	driver.Register(drvID, New)
+}
+
+// Manifest exposes to the core the input expected by the driver.
+func (t *T) Manifest() *manifest.T {
+	m := manifest.New(drvID, t)
+	m.Kinds.Or(naming.KindSvc)
+	m.Add(
+		manifest.ContextObjectPath,
+		keywords.Keyword{
+			Attr:     "IPName",
+			Example:  "1.2.3.4",
+			Option:   "ipname",
+			Required: true,
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/ipname"),
+		},
+		keywords.Keyword{
+			Attr:     "IPDev",
+			Example:  "eth0",
+			Option:   "ipdev",
+			Required: true,
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/ipdev"),
+		},
+		keywords.Keyword{
+			Attr:     "Netmask",
+			Example:  "24",
+			Option:   "netmask",
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/netmask"),
+		},
+		keywords.Keyword{
+			Attr:      "VRID",
+			Converter: converters.Int,
+			Example:   "51",
+			Option:    "vrid",
+			Required:  true,
+			Text:      keywords.NewText(fs, "text/kw/vrid"),
+		},
+		keywords.Keyword{
+			Attr:      "AdvertInterval",
+			Converter: converters.Duration,
+			Default:   "1s",
+			Example:   "500ms",
+			Option:    "advert_interval",
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/advert_interval"),
+		},
+		keywords.Keyword{
+			Attr:      "Preempt",
+			Converter: converters.Bool,
+			Default:   "true",
+			Option:    "preempt",
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/preempt"),
+		},
+	)
+	return m
+}
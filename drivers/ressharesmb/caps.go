@@ -0,0 +1,20 @@
+package ressharesmb
+
+import (
+	"os/exec"
+
+	"github.com/opensvc/om3/util/capabilities"
+)
+
+func init() {
+	capabilities.Register(capabilitiesScanner)
+}
+
+func capabilitiesScanner() ([]string, error) {
+	for _, prog := range []string{"smbd", "smbcontrol"} {
+		if _, err := exec.LookPath(prog); err != nil {
+			return []string{}, nil
+		}
+	}
+	return []string{drvID.Cap()}, nil
+}
@@ -0,0 +1,359 @@
+package ressharesmb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/core/actionrollback"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/provisioned"
+	"github.com/opensvc/om3/core/resource"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/util/capabilities"
+	"github.com/opensvc/om3/util/command"
+)
+
+// T is the driver structure.
+type T struct {
+	resource.T
+	Path      naming.Path `json:"path"`
+	ShareName string      `json:"name"`
+	SharePath string      `json:"share_path"`
+	Comment   string      `json:"comment"`
+	ACL       []string    `json:"acl"`
+	GuestOk   bool        `json:"guest_ok"`
+	Options   []string    `json:"options"`
+	Backend   string      `json:"backend"`
+}
+
+const (
+	backendInclude  = "include"
+	backendRegistry = "registry"
+)
+
+var (
+	// includeDir is the directory hosting the share definition files
+	// of the include backend.
+	includeDir = "/etc/samba/opensvc.d"
+
+	// includeIndex is the file including all the share definition files
+	// of the include backend. It must be included by smb.conf.
+	includeIndex = "/etc/samba/opensvc.conf"
+)
+
+func New() resource.Driver {
+	return &T{}
+}
+
+// Label returns a formatted short description of the Resource
+func (t T) Label() string {
+	return t.name() + " " + t.SharePath
+}
+
+func (t T) name() string {
+	if t.ShareName != "" {
+		return t.ShareName
+	}
+	return t.Path.Name
+}
+
+// Start the Resource
+func (t T) Start(ctx context.Context) error {
+	if !capabilities.Has(drvID.Cap()) {
+		return fmt.Errorf("samba is not installed")
+	}
+	desired, err := t.params()
+	if err != nil {
+		return err
+	}
+	current, err := t.currentParams()
+	if err != nil {
+		return err
+	}
+	if current != nil && len(desired.Diff(current)) == 0 {
+		if ok, err := t.isServed(); err == nil && ok {
+			t.Log().Infof("share %s already up", t.name())
+			return nil
+		}
+	}
+	if err := t.install(desired); err != nil {
+		return err
+	}
+	actionrollback.Register(ctx, func() error {
+		return t.stop()
+	})
+	t.reload()
+	return nil
+}
+
+// Stop the Resource
+func (t T) Stop(ctx context.Context) error {
+	if !capabilities.Has(drvID.Cap()) {
+		return fmt.Errorf("samba is not installed")
+	}
+	current, err := t.currentParams()
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		t.Log().Infof("share %s already down", t.name())
+		return nil
+	}
+	return t.stop()
+}
+
+func (t T) stop() error {
+	if err := t.uninstall(); err != nil {
+		return err
+	}
+	t.reload()
+	t.closeShare()
+	return nil
+}
+
+// Status evaluates and display the Resource status and logs
+func (t *T) Status(ctx context.Context) status.T {
+	if !capabilities.Has(drvID.Cap()) {
+		t.StatusLog().Error("samba is not installed")
+		return status.NotApplicable
+	}
+	desired, err := t.params()
+	if err != nil {
+		t.StatusLog().Error("%s", err)
+		return status.Undef
+	}
+	current, err := t.currentParams()
+	if err != nil {
+		t.StatusLog().Error("%s", err)
+		return status.Undef
+	}
+	if current == nil {
+		return status.Down
+	}
+	s := status.Up
+	for _, issue := range desired.Diff(current) {
+		t.StatusLog().Warn("%s", issue)
+		s = status.Warn
+	}
+	if ok, err := t.isServed(); err != nil {
+		t.StatusLog().Warn("live share list: %s", err)
+		s = status.Warn
+	} else if !ok {
+		t.StatusLog().Warn("share %s is defined but not served by smbd", t.name())
+		s = status.Warn
+	}
+	return s
+}
+
+// params returns the share parameters converted from the keywords.
+func (t T) params() (params, error) {
+	if strings.ContainsAny(t.name(), "/[]") {
+		return nil, fmt.Errorf("invalid share name '%s'", t.name())
+	}
+	p := params{
+		{Key: "path", Value: t.SharePath},
+	}
+	if t.Comment != "" {
+		p = append(p, param{Key: "comment", Value: t.Comment})
+	}
+	p = append(p, param{Key: "guest ok", Value: boolValue(t.GuestOk)})
+	if len(t.ACL) > 0 {
+		valid, read, write, err := parseACL(t.ACL)
+		if err != nil {
+			return nil, err
+		}
+		p = append(p, param{Key: "read only", Value: "yes"})
+		p = append(p, param{Key: "valid users", Value: strings.Join(valid, ", ")})
+		if len(read) > 0 {
+			p = append(p, param{Key: "read list", Value: strings.Join(read, ", ")})
+		}
+		if len(write) > 0 {
+			p = append(p, param{Key: "write list", Value: strings.Join(write, ", ")})
+		}
+	}
+	options, err := parseOptions(t.Options)
+	if err != nil {
+		return nil, err
+	}
+	return append(p, options...), nil
+}
+
+func (t T) isRegistry() bool {
+	return t.Backend == backendRegistry
+}
+
+func (t T) includeFile() string {
+	return filepath.Join(includeDir, t.name()+".conf")
+}
+
+// currentParams returns the parameters of the installed share definition,
+// or nil if the share is not defined.
+func (t T) currentParams() (params, error) {
+	var s string
+	if t.isRegistry() {
+		names, err := t.net(zerolog.DebugLevel, "conf", "listshares")
+		if err != nil {
+			return nil, err
+		}
+		if !containsFold(strings.Fields(names), t.name()) {
+			return nil, nil
+		}
+		if s, err = t.net(zerolog.DebugLevel, "conf", "showshare", t.name()); err != nil {
+			return nil, err
+		}
+	} else {
+		b, err := os.ReadFile(t.includeFile())
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+		s = string(b)
+	}
+	_, p := parseSection(s)
+	return p, nil
+}
+
+func (t T) install(p params) error {
+	if !t.isRegistry() {
+		t.Log().Infof("install share %s definition in %s", t.name(), t.includeFile())
+		return doWithIncludeLock("install "+t.name(), func() error {
+			if err := writeFileAtomic(t.includeFile(), []byte(formatSection(t.name(), p))); err != nil {
+				return err
+			}
+			return writeIncludeIndex(includeDir, includeIndex)
+		})
+	}
+	if current, err := t.currentParams(); err != nil {
+		return err
+	} else if current != nil {
+		// remove the parameters set by a previous definition
+		if _, err := t.net(zerolog.InfoLevel, "conf", "delshare", t.name()); err != nil {
+			return err
+		}
+	}
+	for _, e := range p {
+		if _, err := t.net(zerolog.InfoLevel, "conf", "setparm", t.name(), e.Key, e.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t T) uninstall() error {
+	if t.isRegistry() {
+		_, err := t.net(zerolog.InfoLevel, "conf", "delshare", t.name())
+		return err
+	}
+	t.Log().Infof("remove share %s definition %s", t.name(), t.includeFile())
+	return doWithIncludeLock("uninstall "+t.name(), func() error {
+		if err := os.Remove(t.includeFile()); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return writeIncludeIndex(includeDir, includeIndex)
+	})
+}
+
+// reload asks smbd to reload its configuration. A failure is not fatal,
+// as a stopped smbd loads the share definitions when started.
+func (t T) reload() {
+	cmd := command.New(
+		command.WithName("smbcontrol"),
+		command.WithVarArgs("smbd", "reload-config"),
+		command.WithLogger(t.Log()),
+		command.WithTimeout(10*time.Second),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.WarnLevel),
+		command.WithErrorExitCodeLogLevel(zerolog.WarnLevel),
+	)
+	if err := cmd.Run(); err != nil {
+		t.Log().Warnf("smbd reload: %s", err)
+	}
+}
+
+// closeShare disconnects the clients of the share, so they reconnect to
+// the node where the instance is started next.
+func (t T) closeShare() {
+	cmd := command.New(
+		command.WithName("smbcontrol"),
+		command.WithVarArgs("smbd", "close-share", t.name()),
+		command.WithLogger(t.Log()),
+		command.WithTimeout(10*time.Second),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.DebugLevel),
+		command.WithErrorExitCodeLogLevel(zerolog.DebugLevel),
+	)
+	_ = cmd.Run()
+}
+
+// isServed returns true if the share is in the live share list of the
+// local smbd. It returns true if smbclient is not installed, as the live
+// share list can not be verified.
+func (t T) isServed() (bool, error) {
+	if _, err := exec.LookPath("smbclient"); err != nil {
+		return true, nil
+	}
+	cmd := command.New(
+		command.WithName("smbclient"),
+		command.WithVarArgs("--no-pass", "--grepable", "--list", "127.0.0.1"),
+		command.WithBufferedStdout(),
+		command.WithLogger(t.Log()),
+		command.WithTimeout(10*time.Second),
+		command.WithCommandLogLevel(zerolog.DebugLevel),
+		command.WithStdoutLogLevel(zerolog.DebugLevel),
+		command.WithStderrLogLevel(zerolog.DebugLevel),
+	)
+	out, err := cmd.Output()
+	if err != nil {
+		return false, fmt.Errorf("smbclient: %w", err)
+	}
+	return containsFold(parseShareList(string(out)), t.name()), nil
+}
+
+func (t T) net(logLevel zerolog.Level, args ...string) (string, error) {
+	cmd := command.New(
+		command.WithName("net"),
+		command.WithArgs(args),
+		command.WithBufferedStdout(),
+		command.WithLogger(t.Log()),
+		command.WithTimeout(10*time.Second),
+		command.WithCommandLogLevel(logLevel),
+		command.WithStdoutLogLevel(zerolog.DebugLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	out, err := cmd.Output()
+	return string(out), err
+}
+
+// containsFold returns true if l contains s, with the case insensitive
+// share names comparison.
+func containsFold(l []string, s string) bool {
+	for _, e := range l {
+		if strings.EqualFold(e, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t T) Provision(ctx context.Context) error {
+	return nil
+}
+
+func (t T) Unprovision(ctx context.Context) error {
+	return nil
+}
+
+func (t T) Provisioned() (provisioned.T, error) {
+	return provisioned.NotApplicable, nil
+}
@@ -0,0 +1,86 @@
+package ressharesmb
+
+import (
+	"embed"
+
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/core/keywords"
+	"github.com/opensvc/om3/core/manifest"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/util/converters"
+)
+
+var (
+	//go:embed text
+	fs embed.FS
+
+	drvID = driver.NewID(driver.GroupShare, "smb")
+)
+
+func init() {
+	driver.Register(drvID, New)
+}
+
+// Manifest exposes to the core the input expected by the driver.
+func (t T) Manifest() *manifest.T {
+	m := manifest.New(drvID, t)
+	m.Kinds.Or(naming.KindSvc, naming.KindVol)
+	m.Add(
+		manifest.ContextObjectPath,
+		keywords.Keyword{
+			Attr:     "ShareName",
+			Example:  "data",
+			Option:   "name",
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/name"),
+		},
+		keywords.Keyword{
+			Attr:     "SharePath",
+			Example:  "/srv/{fqdn}/share",
+			Option:   "path",
+			Required: true,
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/path"),
+		},
+		keywords.Keyword{
+			Attr:     "Comment",
+			Example:  "The {name} data",
+			Option:   "comment",
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/comment"),
+		},
+		keywords.Keyword{
+			Attr:      "ACL",
+			Converter: converters.List,
+			Example:   "@staff:rw alice:ro",
+			Option:    "acl",
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/acl"),
+		},
+		keywords.Keyword{
+			Attr:      "GuestOk",
+			Converter: converters.Bool,
+			Default:   "false",
+			Option:    "guest_ok",
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/guest_ok"),
+		},
+		keywords.Keyword{
+			Attr:      "Options",
+			Converter: converters.Shlex,
+			Example:   "\"browseable=no\" \"vfs objects=acl_xattr\"",
+			Option:    "options",
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/options"),
+		},
+		keywords.Keyword{
+			Attr:       "Backend",
+			Candidates: []string{backendInclude, backendRegistry},
+			Default:    backendInclude,
+			Option:     "backend",
+			Scopable:   true,
+			Text:       keywords.NewText(fs, "text/kw/backend"),
+		},
+	)
+	return m
+}
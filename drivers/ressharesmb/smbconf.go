@@ -0,0 +1,227 @@
+package ressharesmb
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/opensvc/fcntllock"
+	"github.com/opensvc/flock"
+
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/util/xsession"
+)
+
+type (
+	// param is a share parameter, as written in a smb.conf share section.
+	param struct {
+		Key   string
+		Value string
+	}
+
+	params []param
+)
+
+var (
+	// includeLockTimeout is the maximum wait for the include backend lock.
+	includeLockTimeout = 20 * time.Second
+)
+
+// parseACL converts the acl keyword entries to the "valid users", "read
+// list" and "write list" share parameters values.
+func parseACL(l []string) (valid, read, write []string, err error) {
+	for _, s := range l {
+		i := strings.LastIndex(s, ":")
+		if i <= 0 {
+			err = fmt.Errorf("malformed acl entry '%s': must be in the <principal>:<ro|rw> format", s)
+			return
+		}
+		principal, mode := s[:i], s[i+1:]
+		principal = quotePrincipal(principal)
+		switch mode {
+		case "ro":
+			read = append(read, principal)
+		case "rw":
+			write = append(write, principal)
+		default:
+			err = fmt.Errorf("malformed acl entry '%s': unknown access mode '%s': must be ro or rw", s, mode)
+			return
+		}
+		valid = append(valid, principal)
+	}
+	return
+}
+
+func quotePrincipal(s string) string {
+	if strings.ContainsAny(s, " \t") {
+		return `"` + s + `"`
+	}
+	return s
+}
+
+// parseOptions converts the options keyword entries to share parameters.
+func parseOptions(l []string) (params, error) {
+	p := make(params, 0, len(l))
+	for _, s := range l {
+		k, v, ok := strings.Cut(s, "=")
+		k = strings.TrimSpace(k)
+		if !ok || k == "" {
+			return nil, fmt.Errorf("malformed option '%s': must be in the <parameter>=<value> format", s)
+		}
+		p = append(p, param{Key: k, Value: strings.TrimSpace(v)})
+	}
+	return p, nil
+}
+
+func boolValue(v bool) string {
+	if v {
+		return "yes"
+	}
+	return "no"
+}
+
+// Get returns the value of the parameter k, with the Samba case and space
+// insensitive parameter names comparison.
+func (t params) Get(k string) (string, bool) {
+	k = normalizeKey(k)
+	for _, p := range t {
+		if normalizeKey(p.Key) == k {
+			return p.Value, true
+		}
+	}
+	return "", false
+}
+
+// Diff returns the descriptions of the parameters of t whose value differs
+// in the current parameters.
+func (t params) Diff(current params) []string {
+	l := make([]string, 0)
+	for _, p := range t {
+		v, ok := current.Get(p.Key)
+		switch {
+		case !ok:
+			l = append(l, fmt.Sprintf("%s is not set, expected '%s'", p.Key, p.Value))
+		case normalizeValue(v) != normalizeValue(p.Value):
+			l = append(l, fmt.Sprintf("%s is '%s', expected '%s'", p.Key, v, p.Value))
+		}
+	}
+	return l
+}
+
+func normalizeKey(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), ""))
+}
+
+func normalizeValue(s string) string {
+	s = strings.TrimSpace(s)
+	switch strings.ToLower(s) {
+	case "yes", "true", "1":
+		return "yes"
+	case "no", "false", "0":
+		return "no"
+	}
+	return s
+}
+
+// formatSection returns the smb.conf section defining the share.
+func formatSection(name string, p params) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[%s]\n", name)
+	for _, e := range p {
+		fmt.Fprintf(&b, "\t%s = %s\n", e.Key, e.Value)
+	}
+	return b.String()
+}
+
+// parseSection parses a smb.conf share section, as written by
+// formatSection or output by "net conf showshare".
+func parseSection(s string) (name string, p params) {
+	p = make(params, 0)
+	scanner := bufio.NewScanner(strings.NewReader(s))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "#"), strings.HasPrefix(line, ";"):
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			name = line[1 : len(line)-1]
+		default:
+			k, v, ok := strings.Cut(line, "=")
+			if !ok {
+				continue
+			}
+			p = append(p, param{Key: strings.TrimSpace(k), Value: strings.TrimSpace(v)})
+		}
+	}
+	return
+}
+
+// parseShareList returns the disk share names from the
+// "smbclient --grepable --list" output.
+func parseShareList(s string) []string {
+	l := make([]string, 0)
+	for _, line := range strings.Split(s, "\n") {
+		fields := strings.Split(line, "|")
+		if len(fields) < 2 || fields[0] != "Disk" {
+			continue
+		}
+		l = append(l, fields[1])
+	}
+	return l
+}
+
+// doWithIncludeLock runs f holding the node-wide lock serializing the
+// share definition files and index writes of the include backend, so
+// concurrent share installs and uninstalls don't lose an include.
+func doWithIncludeLock(intent string, f func() error) error {
+	p := filepath.Join(rawconfig.Paths.Lock, "share.smb.include")
+	lock := flock.New(p, xsession.ID.String(), fcntllock.New)
+	if err := lock.Lock(includeLockTimeout, intent); err != nil {
+		return err
+	}
+	defer func() { _ = lock.UnLock() }()
+	return f()
+}
+
+// writeIncludeIndex regenerates the index file including all the share
+// definition files of the directory.
+func writeIncludeIndex(dir, index string) error {
+	l, err := filepath.Glob(filepath.Join(dir, "*.conf"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(l)
+	var b strings.Builder
+	b.WriteString("# generated by opensvc share.smb resources. do not edit.\n")
+	for _, p := range l {
+		fmt.Fprintf(&b, "include = %s\n", p)
+	}
+	return writeFileAtomic(index, []byte(b.String()))
+}
+
+func writeFileAtomic(p string, b []byte) error {
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(p), "."+filepath.Base(p)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(b); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Chmod(0644); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), p)
+}
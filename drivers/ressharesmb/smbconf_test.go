@@ -0,0 +1,94 @@
+package ressharesmb
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/naming"
+)
+
+func TestParams(t *testing.T) {
+	r := T{
+		Path:      naming.Path{Name: "svc1", Namespace: "root", Kind: naming.KindSvc},
+		SharePath: "/srv/svc1/data",
+		Comment:   "svc1 data",
+		ACL:       []string{"@staff:rw", "alice:ro", `DOM\john doe:ro`},
+		Options:   []string{"browseable=no", "vfs objects = acl_xattr"},
+	}
+	assert.Equal(t, "svc1", r.name(), "the share name defaults to the object name")
+	p, err := r.params()
+	require.NoError(t, err)
+	assert.Equal(t, params{
+		{Key: "path", Value: "/srv/svc1/data"},
+		{Key: "comment", Value: "svc1 data"},
+		{Key: "guest ok", Value: "no"},
+		{Key: "read only", Value: "yes"},
+		{Key: "valid users", Value: `@staff, alice, "DOM\john doe"`},
+		{Key: "read list", Value: `alice, "DOM\john doe"`},
+		{Key: "write list", Value: "@staff"},
+		{Key: "browseable", Value: "no"},
+		{Key: "vfs objects", Value: "acl_xattr"},
+	}, p)
+
+	t.Run("malformed acl", func(t *testing.T) {
+		r := r
+		r.ACL = []string{"alice:full"}
+		_, err := r.params()
+		assert.Error(t, err)
+	})
+
+	t.Run("malformed option", func(t *testing.T) {
+		r := r
+		r.Options = []string{"browseable"}
+		_, err := r.params()
+		assert.Error(t, err)
+	})
+}
+
+func TestSectionRoundTrip(t *testing.T) {
+	p := params{
+		{Key: "path", Value: "/srv/data"},
+		{Key: "guest ok", Value: "yes"},
+	}
+	s := formatSection("data", p)
+	assert.Equal(t, "[data]\n\tpath = /srv/data\n\tguest ok = yes\n", s)
+	name, parsed := parseSection(s)
+	assert.Equal(t, "data", name)
+	assert.Equal(t, p, parsed)
+	assert.Empty(t, p.Diff(parsed))
+
+	// net conf showshare output style
+	_, current := parseSection("[data]\n\tpath = /srv/data\n\tguestok = True\n")
+	assert.Empty(t, p.Diff(current))
+
+	_, current = parseSection("[data]\n\tpath = /srv/other\n")
+	assert.Equal(t, []string{
+		"path is '/srv/other', expected '/srv/data'",
+		"guest ok is not set, expected 'yes'",
+	}, p.Diff(current))
+}
+
+func TestParseShareList(t *testing.T) {
+	s := "Disk|data|svc1 data\nIPC|IPC$|IPC Service\nDisk|Home|\nWorkgroup|WG|SRV\n"
+	l := parseShareList(s)
+	assert.Equal(t, []string{"data", "Home"}, l)
+	assert.True(t, containsFold(l, "home"))
+	assert.False(t, containsFold(l, "IPC$"))
+}
+
+func TestWriteIncludeIndex(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "opensvc.d")
+	index := filepath.Join(filepath.Dir(dir), "opensvc.conf")
+	require.NoError(t, writeFileAtomic(filepath.Join(dir, "b.conf"), []byte("[b]\n")))
+	require.NoError(t, writeFileAtomic(filepath.Join(dir, "a.conf"), []byte("[a]\n")))
+	require.NoError(t, writeIncludeIndex(dir, index))
+	b, err := os.ReadFile(index)
+	require.NoError(t, err)
+	assert.Equal(t, "# generated by opensvc share.smb resources. do not edit.\n"+
+		"include = "+filepath.Join(dir, "a.conf")+"\n"+
+		"include = "+filepath.Join(dir, "b.conf")+"\n", string(b))
+}
@@ -17,6 +17,11 @@ type (
 		ReceivedBytes uint64
 		Begin         time.Time
 		End           time.Time
+
+		// DataTime is the time the sent data was frozen at, for example
+		// the creation time of the sent snapshot. It is used to compute
+		// the replication lag.
+		DataTime time.Time
 	}
 )
 
@@ -41,12 +46,7 @@ func (t *T) CopyWithStats(ctx context.Context, dst io.Writer, src io.Reader, sta
 	go progressRoutine(q)
 	defer poisonProgressRoutine()
 	defer t.ProgressStats(ctx, stats)
-	defer t.Log().
-		Attr("speed_bps", stats.SpeedBPS()).
-		Attr("duration", stats.Duration()).
-		Attr("sent_b", stats.SentBytes).
-		Attr("received_b", stats.ReceivedBytes).
-		Infof("sync stat")
+	defer t.LogStats(stats)
 
 	for {
 		n, err := src.Read(buf)
@@ -64,6 +64,23 @@ func (t *T) CopyWithStats(ctx context.Context, dst io.Writer, src io.Reader, sta
 	return stats.SentBytes, nil
 }
 
+// LogStats logs the transfer statistics, including the replication lag
+// when the stats DataTime is set.
+func (t *T) LogStats(stats *Stats) {
+	if stats.End.IsZero() {
+		stats.Close()
+	}
+	l := t.Log().
+		Attr("speed_bps", stats.SpeedBPS()).
+		Attr("duration", stats.Duration()).
+		Attr("sent_b", stats.SentBytes).
+		Attr("received_b", stats.ReceivedBytes)
+	if !stats.DataTime.IsZero() {
+		l = l.Attr("lag", stats.Lag())
+	}
+	l.Infof("sync stat")
+}
+
 func (t *T) ProgressNode(ctx context.Context, nodename string, cols ...any) {
 	if view := progress.ViewFromContext(ctx); view != nil {
 		key := append(t.ProgressKey(), nodename)
@@ -105,3 +122,17 @@ func (t *Stats) SpeedBPS() (speed float64) {
 	speed = float64(t.SentBytes+t.ReceivedBytes) / duration.Seconds()
 	return
 }
+
+// Lag returns the age of the sent data at the end of the transfer, or at
+// the current time if the transfer is not closed yet. Lag returns 0 if the
+// DataTime is not set.
+func (t *Stats) Lag() time.Duration {
+	if t.DataTime.IsZero() {
+		return 0
+	}
+	end := t.End
+	if end.IsZero() {
+		end = time.Now()
+	}
+	return end.Sub(t.DataTime)
+}
@@ -0,0 +1,56 @@
+package ressyncblockdelta
+
+import (
+	"embed"
+
+	"github.com/opensvc/om3/core/keywords"
+	"github.com/opensvc/om3/util/converters"
+)
+
+var (
+	//go:embed text
+	fs embed.FS
+
+	Keywords = []keywords.Keyword{
+		{
+			Attr:      "Timeout",
+			Converter: converters.Duration,
+			Example:   "5m",
+			Option:    "timeout",
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/timeout"),
+		},
+		{
+			Attr:     "Src",
+			Example:  "disk#1",
+			Option:   "src",
+			Required: true,
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/src"),
+		},
+		{
+			Attr:     "Dst",
+			Example:  "/dev/{fqdn}/data",
+			Option:   "dst",
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/dst"),
+		},
+		{
+			Attr:      "BlockSize",
+			Converter: converters.Size,
+			Default:   "1m",
+			Example:   "64k",
+			Option:    "block_size",
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/block_size"),
+		},
+		{
+			Attr:       "Target",
+			Candidates: []string{"nodes", "drpnodes"},
+			Converter:  converters.List,
+			Option:     "target",
+			Scopable:   true,
+			Text:       keywords.NewText(fs, "text/kw/target"),
+		},
+	}
+)
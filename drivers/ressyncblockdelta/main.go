@@ -0,0 +1,580 @@
+package ressyncblockdelta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/core/actioncontext"
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/nodesinfo"
+	"github.com/opensvc/om3/core/provisioned"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/core/resource"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/core/topology"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/drivers/ressync"
+	"github.com/opensvc/om3/util/blockdelta"
+	"github.com/opensvc/om3/util/device"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/sizeconv"
+)
+
+// T is the driver structure.
+type (
+	T struct {
+		ressync.T
+		Src       string
+		Dst       string
+		BlockSize *int64
+		Target    []string
+		Nodes     []string
+		DRPNodes  []string
+		ObjectID  uuid.UUID
+		Timeout   *time.Duration
+		Topology  topology.T
+	}
+
+	modeT uint
+
+	devicesExposer interface {
+		ExposedDevices() device.L
+	}
+
+	// countWriter updates the sent bytes counter of the sync stats.
+	countWriter struct {
+		w     io.Writer
+		stats *ressync.Stats
+	}
+
+	// timedReaderAt records the time of the first read of the source
+	// device, which is the time of the oldest sent data.
+	timedReaderAt struct {
+		r    io.ReaderAt
+		once sync.Once
+		t    time.Time
+	}
+)
+
+const (
+	modeFull modeT = iota
+	modeIncr
+
+	lockName = "sync"
+
+	// mapDirName is the name of the directory hosting the checksum maps,
+	// in the resource var directory. The map of the data sent to a peer
+	// is named after the peer.
+	mapDirName = "blockdelta"
+
+	// receivedIDFileName is the name of the file hosting the id of the
+	// last stream applied to the dst device, in the map directory.
+	receivedIDFileName = "received.id"
+
+	defaultBlockSize = 1024 * 1024
+)
+
+func New() resource.Driver {
+	return &T{}
+}
+
+func (t countWriter) Write(b []byte) (int, error) {
+	n, err := t.w.Write(b)
+	t.stats.SentBytes += uint64(n)
+	return n, err
+}
+
+func (t *timedReaderAt) ReadAt(b []byte, off int64) (int, error) {
+	t.once.Do(func() { t.t = time.Now() })
+	return t.r.ReadAt(b, off)
+}
+
+func (t T) IsRunning() bool {
+	unlock, err := t.Lock(false, time.Second*0, lockName)
+	if err != nil {
+		return true
+	}
+	defer unlock()
+	return false
+}
+
+func (t T) Full(ctx context.Context) error {
+	return t.lockedAction(ctx, func(ctx context.Context, target []string) error {
+		return t.lockedSync(ctx, modeFull, target)
+	})
+}
+
+func (t T) Update(ctx context.Context) error {
+	return t.lockedAction(ctx, func(ctx context.Context, target []string) error {
+		return t.lockedSync(ctx, modeIncr, target)
+	})
+}
+
+// Resync forgets the checksum maps of the peers, and does a full sync.
+func (t T) Resync(ctx context.Context) error {
+	return t.lockedAction(ctx, func(ctx context.Context, target []string) error {
+		if err := t.deleteMaps(); err != nil {
+			return err
+		}
+		return t.lockedSync(ctx, modeFull, target)
+	})
+}
+
+func (t T) lockedAction(ctx context.Context, fn func(context.Context, []string) error) error {
+	disable := actioncontext.IsLockDisabled(ctx)
+	timeout := actioncontext.LockTimeout(ctx)
+	target := actioncontext.Target(ctx)
+	cancel, err := t.Lock(disable, timeout, lockName)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	if t.Timeout != nil && *t.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *t.Timeout)
+		defer cancel()
+	}
+	return fn(ctx, target)
+}
+
+func (t T) lockedSync(ctx context.Context, mode modeT, target []string) error {
+	if len(target) == 0 {
+		target = t.Target
+	}
+
+	isCron := actioncontext.IsCron(ctx)
+
+	if t.isFlexAndNotPrimary() {
+		return fmt.Errorf("this flex instance is not primary. only %s can sync", t.Nodes[0])
+	}
+
+	if v, rids := t.IsInstanceSufficientlyStarted(ctx); !v {
+		return fmt.Errorf("the instance is not sufficiently started (%s). refuse to sync to protect the data of the started remote instance", strings.Join(rids, ","))
+	}
+
+	nodenames := t.GetTargetPeernames(target, t.Nodes, t.DRPNodes)
+	if len(nodenames) == 0 {
+		t.Log().Infof("no target nodes")
+		return nil
+	}
+
+	for _, nodename := range nodenames {
+		if err := t.isSendAllowedToPeerEnv(nodename); err != nil {
+			if isCron {
+				t.Log().Debugf("%s", err)
+			} else {
+				t.Log().Infof("%s", err)
+			}
+			continue
+		}
+		t.ProgressNode(ctx, nodename, nil, nil)
+		if err := t.peerSync(ctx, mode, nodename); err != nil {
+			return err
+		}
+		if err := t.WritePeerLastSync(nodename, nodenames); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t T) peerSync(ctx context.Context, mode modeT, nodename string) error {
+	err := func() error {
+		var (
+			prev *blockdelta.Map
+			base string
+		)
+		if mode == modeIncr {
+			m, err := blockdelta.LoadMap(t.mapFile(nodename))
+			if err != nil {
+				t.Log().Warnf("%s: send full", err)
+			} else if b, err := os.ReadFile(t.idFile(nodename)); err != nil {
+				t.Log().Warnf("%s: send full", err)
+			} else {
+				prev, base = m, strings.TrimSpace(string(b))
+			}
+		}
+		id := uuid.New().String()
+		m, err := t.send(ctx, nodename, prev, base, id)
+		if errors.Is(err, blockdelta.ErrBaseMismatch) {
+			t.Log().Infof("node %s dst device changed since the last sync: send full", nodename)
+			m, err = t.send(ctx, nodename, nil, "", id)
+		}
+		if err != nil {
+			return err
+		}
+		return t.installMap(nodename, m, id)
+	}()
+
+	var icon string
+	if err != nil {
+		icon = rawconfig.Colorize.Error("✓")
+	} else {
+		icon = rawconfig.Colorize.Optimal("✓")
+	}
+	t.ProgressNode(ctx, nodename, icon, nil, nil)
+	return err
+}
+
+// send streams the blocks of the src device changed since the prev
+// checksum map to the peer node daemon, which applies them to its dst
+// device. It returns the checksum map of the sent data.
+//
+// The stream is identified by id. A delta stream is computed against
+// the stream identified by base, and the peer refuses it with
+// blockdelta.ErrBaseMismatch if base is not the last stream it applied.
+func (t T) send(ctx context.Context, nodename string, prev *blockdelta.Map, base, id string) (*blockdelta.Map, error) {
+	src, err := t.devicePath(t.Src)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(src)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, fmt.Errorf("%s: get size: %w", src, err)
+	}
+	blockSize := t.blockSize()
+	if !prev.Compatible(blockSize, size) {
+		if prev != nil {
+			t.Log().Infof("the checksum map of node %s does not match the %s size or the block size: send full", nodename, src)
+		}
+		prev = nil
+	}
+	if prev == nil {
+		base = ""
+	}
+	var what string
+	if prev == nil {
+		what = "full"
+	} else {
+		what = "delta"
+	}
+
+	c, err := client.New(client.WithURL(nodename))
+	if err != nil {
+		return nil, err
+	}
+
+	type result struct {
+		m     *blockdelta.Map
+		stats blockdelta.SendStats
+		err   error
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	pr, pw := io.Pipe()
+	stats := ressync.NewStats(nodename)
+	ra := &timedReaderAt{r: f}
+	q := make(chan result, 1)
+	go func() {
+		var r result
+		r.m, r.stats, r.err = blockdelta.Send(ctx, countWriter{w: pw, stats: stats}, ra, size, blockSize, prev)
+		_ = pw.CloseWithError(r.err)
+		q <- r
+	}()
+
+	t.Log().Infof("%s send %s to node %s (%s, block size %s)", src, what, nodename, sizeconv.BSizeCompact(float64(size)), sizeconv.BSizeCompact(float64(blockSize)))
+	rid := t.RID()
+	params := api.PostInstanceSyncBlockdeltaParams{Rid: &rid, Id: &id}
+	if base != "" {
+		params.Base = &base
+	}
+	resp, err := c.PostInstanceSyncBlockdeltaWithBody(ctx, nodename, t.Path.Namespace, t.Path.Kind, t.Path.Name, &params, "application/octet-stream", pr)
+	if err == nil {
+		defer resp.Body.Close()
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+		case http.StatusPreconditionFailed:
+			err = fmt.Errorf("node %s: %w", nodename, blockdelta.ErrBaseMismatch)
+		default:
+			b, _ := io.ReadAll(resp.Body)
+			err = fmt.Errorf("node %s: unexpected response: %s: %s", nodename, resp.Status, strings.TrimSpace(string(b)))
+		}
+	}
+	if err != nil {
+		cancel()
+		_ = pr.CloseWithError(err)
+		<-q
+		return nil, err
+	}
+	r := <-q
+	if r.err != nil {
+		return nil, r.err
+	}
+	// The src device is read live, so the sent data is not older than the
+	// first block read.
+	stats.DataTime = ra.t
+	t.LogStats(stats)
+	t.Log().
+		Attr("block_count", r.stats.BlockCount).
+		Attr("changed_count", r.stats.ChangedCount).
+		Infof("%d/%d blocks sent to node %s", r.stats.ChangedCount, r.stats.BlockCount, nodename)
+	return r.m, nil
+}
+
+// installMap saves the checksum map of the data sent to the peer node,
+// and the id of the stream, for the next delta sync to this peer.
+//
+// The map describes the peer dst device content only, so it is never
+// sent to the peer: after a failover, the new source node sends full.
+func (t T) installMap(nodename string, m *blockdelta.Map, id string) error {
+	if err := m.Save(t.mapFile(nodename)); err != nil {
+		return err
+	}
+	return os.WriteFile(t.idFile(nodename), []byte(id), 0o644)
+}
+
+// Start forgets the id of the last stream applied to the dst device, as
+// the started instance may change the device content. The next stream
+// received must be full.
+func (t *T) Start(ctx context.Context) error {
+	return t.deleteReceivedID()
+}
+
+// ReceiveBlockDelta applies the block delta stream read from r to the dst
+// device. It is called by the daemon api handler on the peer nodes.
+//
+// A delta stream computed against a base other than the id of the last
+// stream applied is refused with blockdelta.ErrBaseMismatch. The id is
+// recorded only when the stream is fully applied.
+//
+// The checksum maps of the data previously sent from this node are
+// removed, as the dst device content no longer matches them.
+func (t *T) ReceiveBlockDelta(ctx context.Context, r io.Reader, id, base string) (int64, error) {
+	dst, err := t.devicePath(t.dst())
+	if err != nil {
+		return 0, err
+	}
+	if base != "" {
+		if b, err := os.ReadFile(t.receivedIDFile()); err != nil || strings.TrimSpace(string(b)) != base {
+			return 0, blockdelta.ErrBaseMismatch
+		}
+	}
+	if err := t.deleteReceivedID(); err != nil {
+		return 0, err
+	}
+	if err := t.deleteMaps(); err != nil {
+		return 0, err
+	}
+	f, err := os.OpenFile(dst, os.O_WRONLY, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, fmt.Errorf("%s: get size: %w", dst, err)
+	}
+	t.Log().Infof("%s receive blocks", dst)
+	n, err := blockdelta.Receive(r, f, size)
+	if err != nil {
+		return n, fmt.Errorf("%s: %w", dst, err)
+	}
+	if err := f.Sync(); err != nil {
+		return n, fmt.Errorf("%s: sync: %w", dst, err)
+	}
+	t.Log().Infof("%s received %s", dst, sizeconv.BSizeCompact(float64(n)))
+	if id != "" {
+		if err := os.MkdirAll(t.mapDir(), 0o755); err != nil {
+			return n, err
+		}
+		if err := os.WriteFile(t.receivedIDFile(), []byte(id), 0o644); err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// devicePath returns the path of the device designated by s, a device
+// path or the id of a disk resource of the object.
+func (t T) devicePath(s string) (string, error) {
+	if s == "" {
+		return "", fmt.Errorf("empty device")
+	}
+	if strings.HasPrefix(s, string(filepath.Separator)) {
+		return s, nil
+	}
+	r := t.GetObjectDriver().ResourceByID(s)
+	if r == nil {
+		return "", fmt.Errorf("resource %s not found", s)
+	}
+	i, ok := r.(devicesExposer)
+	if !ok {
+		return "", fmt.Errorf("resource %s does not expose devices", s)
+	}
+	l := i.ExposedDevices()
+	if len(l) == 0 {
+		return "", fmt.Errorf("resource %s exposes no device", s)
+	}
+	return l[0].Path(), nil
+}
+
+func (t T) dst() string {
+	if t.Dst != "" {
+		return t.Dst
+	}
+	return t.Src
+}
+
+func (t T) blockSize() int64 {
+	if t.BlockSize == nil || *t.BlockSize <= 0 {
+		return defaultBlockSize
+	}
+	return *t.BlockSize
+}
+
+func (t T) mapDir() string {
+	return filepath.Join(t.VarDir(), mapDirName)
+}
+
+func (t T) mapFile(nodename string) string {
+	return filepath.Join(t.mapDir(), nodename+".map")
+}
+
+func (t T) idFile(nodename string) string {
+	return filepath.Join(t.mapDir(), nodename+".id")
+}
+
+func (t T) receivedIDFile() string {
+	return filepath.Join(t.mapDir(), receivedIDFileName)
+}
+
+func (t T) deleteReceivedID() error {
+	if err := os.Remove(t.receivedIDFile()); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (t T) deleteMaps() error {
+	l, err := filepath.Glob(filepath.Join(t.mapDir(), "*.map"))
+	if err != nil {
+		return err
+	}
+	for _, p := range l {
+		t.Log().Infof("remove checksum map %s", p)
+		if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *T) Kill(ctx context.Context) error {
+	return nil
+}
+
+func (t *T) Status(ctx context.Context) status.T {
+	var isSourceNode bool
+	if v, _ := t.IsInstanceSufficientlyStarted(ctx); !v {
+		isSourceNode = false
+	} else if t.isFlexAndNotPrimary() {
+		isSourceNode = false
+	} else {
+		isSourceNode = true
+	}
+	nodenames := t.getTargetNodenames(isSourceNode)
+	return t.StatusLastSync(nodenames)
+}
+
+// Label returns a formatted short description of the Resource
+func (t T) Label() string {
+	switch {
+	case t.Src != "" && len(t.Target) > 0:
+		return t.Src + " to " + strings.Join(t.Target, " ")
+	case t.Src != "":
+		return t.Src + " to void"
+	case len(t.Target) > 0:
+		return "nothing to " + strings.Join(t.Target, " ")
+	default:
+		return ""
+	}
+}
+
+func (t T) ScheduleOptions() resource.ScheduleOptions {
+	return resource.ScheduleOptions{
+		Action: "sync_update",
+		Option: "schedule",
+		Base:   "",
+	}
+}
+
+func (t T) Provisioned() (provisioned.T, error) {
+	return provisioned.NotApplicable, nil
+}
+
+func (t T) Info(ctx context.Context) (resource.InfoKeys, error) {
+	target := sort.StringSlice(t.Target)
+	sort.Sort(target)
+	m := resource.InfoKeys{
+		{Key: "src", Value: t.Src},
+		{Key: "dst", Value: t.dst()},
+		{Key: "block_size", Value: fmt.Sprintf("%d", t.blockSize())},
+		{Key: "target", Value: strings.Join(target, " ")},
+	}
+	if t.Timeout != nil {
+		m = append(m, resource.InfoKey{Key: "timeout", Value: fmt.Sprintf("%s", t.Timeout)})
+	}
+	return m, nil
+}
+
+func (t *T) isFlexAndNotPrimary() bool {
+	if t.Topology != topology.Flex {
+		return false
+	}
+	if hostname.Hostname() == t.Nodes[0] {
+		return false
+	}
+	return true
+}
+
+func (t *T) isSendAllowedToPeerEnv(nodename string) error {
+	var localEnv, peerEnv string
+	nodesInfo, err := nodesinfo.Load()
+	if err != nil {
+		return fmt.Errorf("get nodes info: %w", err)
+	}
+	getEnv := func(n string, s *string) error {
+		if m, ok := nodesInfo[n]; !ok {
+			return fmt.Errorf("node %s not found in nodes_info.json", n)
+		} else {
+			*s = m.Env
+		}
+		return nil
+	}
+	if err := getEnv(hostname.Hostname(), &localEnv); err != nil {
+		return err
+	}
+	if err := getEnv(nodename, &peerEnv); err != nil {
+		return err
+	}
+	if localEnv != "PRD" && peerEnv == "PRD" {
+		return fmt.Errorf("refuse to sync from a non-PRD node to a PRD node")
+	}
+	return nil
+}
+
+func (t *T) getTargetNodenames(isSourceNode bool) []string {
+	if isSourceNode {
+		// if the instance is active, check last sync timestamp for each peer
+		return t.GetTargetPeernames(t.Target, t.Nodes, t.DRPNodes)
+	} else {
+		// if the instance is passive, check last sync timestamp for the local node (received from the source node)
+		return []string{hostname.Hostname()}
+	}
+}
@@ -0,0 +1,83 @@
+package ressyncblockdelta
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/util/blockdelta"
+)
+
+func TestDevices(t *testing.T) {
+	r := &T{Src: "/dev/vg1/lv1"}
+	assert.Equal(t, "/dev/vg1/lv1", r.dst(), "dst defaults to src")
+	assert.Equal(t, int64(defaultBlockSize), r.blockSize())
+
+	r.Dst = "/dev/vg2/lv1"
+	blockSize := int64(64 * 1024)
+	r.BlockSize = &blockSize
+	assert.Equal(t, "/dev/vg2/lv1", r.dst())
+	assert.Equal(t, blockSize, r.blockSize())
+
+	p, err := r.devicePath(r.dst())
+	require.NoError(t, err)
+	assert.Equal(t, "/dev/vg2/lv1", p)
+
+	_, err = r.devicePath("")
+	assert.Error(t, err)
+}
+
+func TestReceiveBlockDeltaBase(t *testing.T) {
+	td := t.TempDir()
+	rawconfig.Load(map[string]string{"OSVC_ROOT_PATH": td})
+	defer rawconfig.Load(map[string]string{})
+
+	ctx := context.Background()
+	blockSize := int64(4096)
+	data := bytes.Repeat([]byte("a"), int(blockSize)*4)
+	dst := filepath.Join(td, "dst")
+	require.NoError(t, os.WriteFile(dst, make([]byte, len(data)), 0o600))
+
+	o, err := object.NewSvc(naming.Path{Kind: naming.KindSvc, Name: "ooo"}, object.WithVolatile(true))
+	require.NoError(t, err)
+	r := &T{Dst: dst}
+	r.SetRID("sync#1")
+	r.SetObject(o)
+
+	stream := func(prev *blockdelta.Map) (*bytes.Buffer, *blockdelta.Map) {
+		var b bytes.Buffer
+		m, _, err := blockdelta.Send(ctx, &b, bytes.NewReader(data), int64(len(data)), blockSize, prev)
+		require.NoError(t, err)
+		return &b, m
+	}
+
+	b, m := stream(nil)
+	_, err = r.ReceiveBlockDelta(ctx, b, "id1", "")
+	require.NoError(t, err, "full stream")
+
+	data[0] = 'b'
+	b, m = stream(m)
+	_, err = r.ReceiveBlockDelta(ctx, b, "id2", "id1")
+	require.NoError(t, err, "delta stream on the last applied stream")
+
+	got, err := os.ReadFile(dst)
+	require.NoError(t, err)
+	assert.Equal(t, data, got)
+
+	b, _ = stream(m)
+	_, err = r.ReceiveBlockDelta(ctx, b, "id3", "id1")
+	assert.ErrorIs(t, err, blockdelta.ErrBaseMismatch, "delta stream on an older stream")
+
+	require.NoError(t, r.Start(ctx))
+	b, _ = stream(m)
+	_, err = r.ReceiveBlockDelta(ctx, b, "id3", "id2")
+	assert.ErrorIs(t, err, blockdelta.ErrBaseMismatch, "delta stream after the instance start")
+}
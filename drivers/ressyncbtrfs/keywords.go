@@ -0,0 +1,57 @@
+package ressyncbtrfs
+
+import (
+	"embed"
+
+	"github.com/opensvc/om3/core/keywords"
+	"github.com/opensvc/om3/util/converters"
+)
+
+var (
+	//go:embed text
+	fs embed.FS
+
+	Keywords = []keywords.Keyword{
+		{
+			Attr:      "Timeout",
+			Converter: converters.Duration,
+			Example:   "5m",
+			Option:    "timeout",
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/timeout"),
+		},
+		{
+			Attr:     "Src",
+			Example:  "/srv/{fqdn}/data",
+			Option:   "src",
+			Required: true,
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/src"),
+		},
+		{
+			Attr:     "Dst",
+			Example:  "/srv/{fqdn}/data",
+			Option:   "dst",
+			Required: true,
+			Scopable: true,
+			Text:     keywords.NewText(fs, "text/kw/dst"),
+		},
+		{
+			Attr:       "Target",
+			Candidates: []string{"nodes", "drpnodes"},
+			Converter:  converters.List,
+			Option:     "target",
+			Scopable:   true,
+			Text:       keywords.NewText(fs, "text/kw/target"),
+		},
+		{
+			Attr:      "Keep",
+			Converter: converters.Int,
+			Default:   "2",
+			Example:   "5",
+			Option:    "keep",
+			Scopable:  true,
+			Text:      keywords.NewText(fs, "text/kw/keep"),
+		},
+	}
+)
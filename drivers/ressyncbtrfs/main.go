@@ -0,0 +1,541 @@
+package ressyncbtrfs
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kballard/go-shellquote"
+	"github.com/rs/zerolog"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/opensvc/om3/core/actioncontext"
+	"github.com/opensvc/om3/core/nodesinfo"
+	"github.com/opensvc/om3/core/provisioned"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/core/resource"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/core/topology"
+	"github.com/opensvc/om3/drivers/ressync"
+	"github.com/opensvc/om3/util/command"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/sshnode"
+)
+
+// T is the driver structure.
+type (
+	T struct {
+		ressync.T
+		Src      string
+		Dst      string
+		Target   []string
+		Keep     int
+		Nodes    []string
+		DRPNodes []string
+		ObjectID uuid.UUID
+		Timeout  *time.Duration
+		Topology topology.T
+	}
+
+	modeT uint
+)
+
+const (
+	modeFull modeT = iota
+	modeIncr
+
+	lockName = "sync"
+
+	// snapDirName is the name of the directory hosting the snapshots,
+	// created in the parent directory of the src and dst subvolumes.
+	snapDirName = ".osvc-snap"
+
+	// snapTimeLayout is the layout of the snapshot creation time in
+	// the snapshot names. It sorts lexicographically.
+	snapTimeLayout = "20060102T150405.000000000Z"
+)
+
+func New() resource.Driver {
+	return &T{}
+}
+
+func (t T) IsRunning() bool {
+	unlock, err := t.Lock(false, time.Second*0, lockName)
+	if err != nil {
+		return true
+	}
+	defer unlock()
+	return false
+}
+
+func (t T) Full(ctx context.Context) error {
+	return t.lockedAction(ctx, func(ctx context.Context, target []string) error {
+		return t.lockedSync(ctx, modeFull, target)
+	})
+}
+
+func (t T) Update(ctx context.Context) error {
+	return t.lockedAction(ctx, func(ctx context.Context, target []string) error {
+		return t.lockedSync(ctx, modeIncr, target)
+	})
+}
+
+// Resync destroys the local and peer snapshots of the resource, and does
+// a full sync.
+func (t T) Resync(ctx context.Context) error {
+	return t.lockedAction(ctx, func(ctx context.Context, target []string) error {
+		if len(target) == 0 {
+			target = t.Target
+		}
+		for _, nodename := range t.GetTargetPeernames(target, t.Nodes, t.DRPNodes) {
+			snaps, err := t.peerSnapshots(nodename)
+			if err != nil {
+				return err
+			}
+			if err := t.deletePeerSnapshots(nodename, snaps); err != nil {
+				return err
+			}
+		}
+		snaps, err := t.localSnapshots()
+		if err != nil {
+			return err
+		}
+		if err := t.deleteLocalSnapshots(snaps); err != nil {
+			return err
+		}
+		return t.lockedSync(ctx, modeFull, target)
+	})
+}
+
+func (t T) lockedAction(ctx context.Context, fn func(context.Context, []string) error) error {
+	disable := actioncontext.IsLockDisabled(ctx)
+	timeout := actioncontext.LockTimeout(ctx)
+	target := actioncontext.Target(ctx)
+	cancel, err := t.Lock(disable, timeout, lockName)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	if t.Timeout != nil && *t.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *t.Timeout)
+		defer cancel()
+	}
+	return fn(ctx, target)
+}
+
+func (t T) lockedSync(ctx context.Context, mode modeT, target []string) error {
+	if len(target) == 0 {
+		target = t.Target
+	}
+
+	isCron := actioncontext.IsCron(ctx)
+
+	if t.isFlexAndNotPrimary() {
+		return fmt.Errorf("this flex instance is not primary. only %s can sync", t.Nodes[0])
+	}
+
+	if v, rids := t.IsInstanceSufficientlyStarted(ctx); !v {
+		return fmt.Errorf("the instance is not sufficiently started (%s). refuse to sync to protect the data of the started remote instance", strings.Join(rids, ","))
+	}
+
+	nodenames := t.GetTargetPeernames(target, t.Nodes, t.DRPNodes)
+	if len(nodenames) == 0 {
+		t.Log().Infof("no target nodes")
+		return nil
+	}
+
+	parents, err := t.localSnapshots()
+	if err != nil {
+		return err
+	}
+	snap, err := t.createSnapshot(time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, nodename := range nodenames {
+		if err := t.isSendAllowedToPeerEnv(nodename); err != nil {
+			if isCron {
+				t.Log().Debugf("%s", err)
+			} else {
+				t.Log().Infof("%s", err)
+			}
+			continue
+		}
+		t.ProgressNode(ctx, nodename, nil, nil)
+		if err := t.peerSync(ctx, mode, nodename, snap, parents); err != nil {
+			return err
+		}
+		if err := t.WritePeerLastSync(nodename, nodenames); err != nil {
+			return err
+		}
+	}
+
+	snaps, err := t.localSnapshots()
+	if err != nil {
+		return err
+	}
+	return t.deleteLocalSnapshots(expiredSnapshots(snaps, t.keep()))
+}
+
+func (t T) peerSync(ctx context.Context, mode modeT, nodename string, snap snapshot, parents []snapshot) error {
+	err := func() error {
+		peerSnaps, err := t.peerSnapshots(nodename)
+		if err != nil {
+			return err
+		}
+		var parent *snapshot
+		if mode == modeIncr {
+			parent = commonParent(parents, peerSnaps)
+			if parent == nil {
+				t.Log().Infof("no snapshot in common with node %s: can't send delta, send full", nodename)
+			}
+		}
+		if err := t.send(ctx, nodename, snap, parent); err != nil {
+			return err
+		}
+		if err := t.installPeerDst(nodename, snap); err != nil {
+			return err
+		}
+		peerSnaps, err = t.peerSnapshots(nodename)
+		if err != nil {
+			return err
+		}
+		return t.deletePeerSnapshots(nodename, expiredSnapshots(peerSnaps, t.keep()))
+	}()
+
+	var icon string
+	if err != nil {
+		icon = rawconfig.Colorize.Error("✓")
+	} else {
+		icon = rawconfig.Colorize.Optimal("✓")
+	}
+	t.ProgressNode(ctx, nodename, icon, nil, nil)
+	return err
+}
+
+// send pipes the btrfs send stream of the snapshot, incremental from the
+// parent snapshot if not nil, to a btrfs receive on the peer node.
+func (t T) send(ctx context.Context, nodename string, snap snapshot, parent *snapshot) error {
+	var b bytes.Buffer
+
+	args := t.sendCmd(snap, parent)
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+
+	client, err := sshnode.NewClient(nodename)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	session, err := client.NewSession()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	stdinPipe, err := session.StdinPipe()
+	if err != nil {
+		return err
+	}
+	defer stdinPipe.Close()
+
+	stdoutPipe, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	defer stdoutPipe.Close()
+
+	session.Stdout = &b
+	session.Stderr = &b
+
+	rcmdStr := shellquote.Join(t.receiveCmd()...)
+	cmdStr := cmd.String()
+	var what string
+	if parent == nil {
+		what = "full"
+	} else {
+		what = "delta"
+	}
+	t.Log().Attr("cmd", fmt.Sprintf("%s | ssh %s '%s'", cmdStr, nodename, rcmdStr)).Infof("%s send %s to node %s", t.Src, what, nodename)
+	if err := session.Start(rcmdStr); err != nil {
+		return fmt.Errorf("rexec '%s' on host %s: %w", rcmdStr, nodename, err)
+	}
+	cmd.Stderr = &b
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	stats := ressync.NewStats(nodename)
+	stats.DataTime = snap.Time
+	if _, err := t.CopyWithStats(ctx, stdinPipe, stdoutPipe, stats); err != nil {
+		return err
+	}
+	if err := cmd.Wait(); err != nil {
+		return fmt.Errorf("exec '%s': %w: %s", cmdStr, err, b.String())
+	}
+	_ = stdinPipe.Close()
+	if err := session.Wait(); err != nil {
+		return fmt.Errorf("rexec '%s' on host %s: %w: %s", rcmdStr, nodename, err, b.String())
+	}
+	return nil
+}
+
+func (t T) sendCmd(snap snapshot, parent *snapshot) []string {
+	cmd := []string{"btrfs", "send"}
+	if parent != nil {
+		cmd = append(cmd, "-p", filepath.Join(t.srcSnapDir(), parent.Name))
+	}
+	cmd = append(cmd, filepath.Join(t.srcSnapDir(), snap.Name))
+	return cmd
+}
+
+func (t T) receiveCmd() []string {
+	return []string{"btrfs", "receive", t.dstSnapDir()}
+}
+
+// installPeerDst replaces the dst subvolume of the peer node with a
+// writable snapshot of the received snapshot.
+func (t T) installPeerDst(nodename string, snap snapshot) error {
+	dst := shellquote.Join(t.Dst)
+	received := shellquote.Join(filepath.Join(t.dstSnapDir(), snap.Name))
+	s := fmt.Sprintf("if btrfs subvolume show %s >/dev/null 2>&1; then btrfs subvolume delete %s; fi && btrfs subvolume snapshot %s %s", dst, dst, received, dst)
+	t.Log().Infof("install %s from %s on node %s", t.Dst, snap.Name, nodename)
+	_, err := t.rexec(nodename, s)
+	return err
+}
+
+func (t T) createSnapshot(tm time.Time) (snapshot, error) {
+	snap := snapshot{
+		Name: t.snapshotPrefix() + tm.UTC().Format(snapTimeLayout),
+		Time: tm,
+	}
+	dir := t.srcSnapDir()
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return snap, err
+	}
+	err := t.btrfs("subvolume", "snapshot", "-r", t.Src, filepath.Join(dir, snap.Name))
+	return snap, err
+}
+
+func (t T) localSnapshots() ([]snapshot, error) {
+	entries, err := os.ReadDir(t.srcSnapDir())
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(entries))
+	for i, e := range entries {
+		names[i] = e.Name()
+	}
+	return parseSnapshots(t.snapshotPrefix(), names), nil
+}
+
+func (t T) peerSnapshots(nodename string) ([]snapshot, error) {
+	dir := shellquote.Join(t.dstSnapDir())
+	b, err := t.rexec(nodename, fmt.Sprintf("mkdir -p %s && ls -1 %s", dir, dir))
+	if err != nil {
+		return nil, err
+	}
+	return parseSnapshots(t.snapshotPrefix(), strings.Fields(string(b))), nil
+}
+
+func (t T) deleteLocalSnapshots(snaps []snapshot) error {
+	for _, snap := range snaps {
+		if err := t.btrfs("subvolume", "delete", filepath.Join(t.srcSnapDir(), snap.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t T) deletePeerSnapshots(nodename string, snaps []snapshot) error {
+	if len(snaps) == 0 {
+		return nil
+	}
+	args := []string{"btrfs", "subvolume", "delete"}
+	for _, snap := range snaps {
+		args = append(args, filepath.Join(t.dstSnapDir(), snap.Name))
+	}
+	s := shellquote.Join(args...)
+	t.Log().Infof("rexec '%s' on node %s", s, nodename)
+	_, err := t.rexec(nodename, s)
+	return err
+}
+
+func (t T) btrfs(args ...string) error {
+	cmd := command.New(
+		command.WithName("btrfs"),
+		command.WithArgs(args),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.DebugLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
+
+func (t T) rexec(nodename, cmd string) ([]byte, error) {
+	client, err := sshnode.NewClient(nodename)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+	session, err := client.NewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer session.Close()
+	b, err := session.CombinedOutput(cmd)
+	if err != nil {
+		if ee, ok := err.(*ssh.ExitError); ok {
+			t.Log().
+				Attr("exitcode", ee.Waitmsg.ExitStatus()).
+				Attr("cmd", cmd).
+				Attr("host", nodename).
+				Debugf("rexec '%s' on host %s exited with code %d", cmd, nodename, ee.Waitmsg.ExitStatus())
+		}
+		return b, fmt.Errorf("rexec '%s' on host %s: %w: %s", cmd, nodename, err, strings.TrimSpace(string(b)))
+	}
+	return b, nil
+}
+
+// snapshotPrefix returns the prefix of the names of the snapshots created
+// by the resource.
+func (t T) snapshotPrefix() string {
+	return strings.Replace(t.RID(), "#", ".", 1) + "."
+}
+
+func (t T) srcSnapDir() string {
+	return snapDir(t.Src)
+}
+
+func (t T) dstSnapDir() string {
+	return snapDir(t.Dst)
+}
+
+func snapDir(subvolume string) string {
+	subvolume = filepath.Clean(subvolume)
+	return filepath.Join(filepath.Dir(subvolume), snapDirName, filepath.Base(subvolume))
+}
+
+func (t T) keep() int {
+	if t.Keep < 1 {
+		return 1
+	}
+	return t.Keep
+}
+
+func (t *T) Kill(ctx context.Context) error {
+	return nil
+}
+
+func (t *T) Status(ctx context.Context) status.T {
+	var isSourceNode bool
+	if v, _ := t.IsInstanceSufficientlyStarted(ctx); !v {
+		isSourceNode = false
+	} else if t.isFlexAndNotPrimary() {
+		isSourceNode = false
+	} else {
+		isSourceNode = true
+	}
+	nodenames := t.getTargetNodenames(isSourceNode)
+	return t.StatusLastSync(nodenames)
+}
+
+// Label returns a formatted short description of the Resource
+func (t T) Label() string {
+	switch {
+	case t.Src != "" && len(t.Target) > 0:
+		return t.Src + " to " + strings.Join(t.Target, " ")
+	case t.Src != "":
+		return t.Src + " to void"
+	case len(t.Target) > 0:
+		return "nothing to " + strings.Join(t.Target, " ")
+	default:
+		return ""
+	}
+}
+
+func (t T) ScheduleOptions() resource.ScheduleOptions {
+	return resource.ScheduleOptions{
+		Action: "sync_update",
+		Option: "schedule",
+		Base:   "",
+	}
+}
+
+func (t T) Provisioned() (provisioned.T, error) {
+	return provisioned.NotApplicable, nil
+}
+
+func (t T) Info(ctx context.Context) (resource.InfoKeys, error) {
+	target := sort.StringSlice(t.Target)
+	sort.Sort(target)
+	m := resource.InfoKeys{
+		{Key: "src", Value: t.Src},
+		{Key: "dst", Value: t.Dst},
+		{Key: "keep", Value: fmt.Sprintf("%d", t.keep())},
+		{Key: "target", Value: strings.Join(target, " ")},
+	}
+	if t.Timeout != nil {
+		m = append(m, resource.InfoKey{Key: "timeout", Value: fmt.Sprintf("%s", t.Timeout)})
+	}
+	if snaps, err := t.localSnapshots(); err == nil && len(snaps) > 0 {
+		m = append(m, resource.InfoKey{Key: "last_snapshot", Value: snaps[len(snaps)-1].Name})
+	}
+	return m, nil
+}
+
+func (t *T) isFlexAndNotPrimary() bool {
+	if t.Topology != topology.Flex {
+		return false
+	}
+	if hostname.Hostname() == t.Nodes[0] {
+		return false
+	}
+	return true
+}
+
+func (t *T) isSendAllowedToPeerEnv(nodename string) error {
+	var localEnv, peerEnv string
+	nodesInfo, err := nodesinfo.Load()
+	if err != nil {
+		return fmt.Errorf("get nodes info: %w", err)
+	}
+	getEnv := func(n string, s *string) error {
+		if m, ok := nodesInfo[n]; !ok {
+			return fmt.Errorf("node %s not found in nodes_info.json", n)
+		} else {
+			*s = m.Env
+		}
+		return nil
+	}
+	if err := getEnv(hostname.Hostname(), &localEnv); err != nil {
+		return err
+	}
+	if err := getEnv(nodename, &peerEnv); err != nil {
+		return err
+	}
+	if localEnv != "PRD" && peerEnv == "PRD" {
+		return fmt.Errorf("refuse to sync from a non-PRD node to a PRD node")
+	}
+	return nil
+}
+
+func (t *T) getTargetNodenames(isSourceNode bool) []string {
+	if isSourceNode {
+		// if the instance is active, check last sync timestamp for each peer
+		return t.GetTargetPeernames(t.Target, t.Nodes, t.DRPNodes)
+	} else {
+		// if the instance is passive, check last sync timestamp for the local node (received from the source node)
+		return []string{hostname.Hostname()}
+	}
+}
@@ -0,0 +1,98 @@
+//go:build linux
+
+package ressyncbtrfs
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupBtrfs mounts a loop btrfs image and returns the mount point.
+func setupBtrfs(t *testing.T) string {
+	t.Helper()
+	if os.Getuid() != 0 {
+		t.Skip("skipped for non root user")
+	}
+	for _, prog := range []string{"btrfs", "mkfs.btrfs"} {
+		if _, err := exec.LookPath(prog); err != nil {
+			t.Skipf("%s not found, skip test", prog)
+		}
+	}
+	dir := t.TempDir()
+	img := filepath.Join(dir, "btrfs.img")
+	mnt := filepath.Join(dir, "mnt")
+	require.NoError(t, os.Mkdir(mnt, 0755))
+	f, err := os.Create(img)
+	require.NoError(t, err)
+	require.NoError(t, f.Truncate(256*1024*1024))
+	require.NoError(t, f.Close())
+	run := func(name string, args ...string) {
+		b, err := exec.Command(name, args...).CombinedOutput()
+		require.NoError(t, err, "%s %v: %s", name, args, b)
+	}
+	run("mkfs.btrfs", "-q", img)
+	if b, err := exec.Command("mount", "-o", "loop", img, mnt).CombinedOutput(); err != nil {
+		t.Skipf("can not mount the loop btrfs image: %s", b)
+	}
+	t.Cleanup(func() {
+		_ = exec.Command("umount", mnt).Run()
+	})
+	return mnt
+}
+
+func TestSnapshotSendReceive(t *testing.T) {
+	mnt := setupBtrfs(t)
+	src := filepath.Join(mnt, "src")
+	dst := filepath.Join(mnt, "dst", "data")
+	b, err := exec.Command("btrfs", "subvolume", "create", src).CombinedOutput()
+	require.NoError(t, err, "%s", b)
+	require.NoError(t, os.MkdirAll(filepath.Join(mnt, "dst"), 0755))
+
+	r := &T{Src: src, Dst: dst, Keep: 2}
+	require.NoError(t, r.SetRID("sync#1"))
+
+	pipe := func(snap snapshot, parent *snapshot) {
+		require.NoError(t, os.MkdirAll(r.dstSnapDir(), 0755))
+		send := r.sendCmd(snap, parent)
+		receive := r.receiveCmd()
+		sendCmd := exec.Command(send[0], send[1:]...)
+		receiveCmd := exec.Command(receive[0], receive[1:]...)
+		stdout, err := sendCmd.StdoutPipe()
+		require.NoError(t, err)
+		receiveCmd.Stdin = stdout
+		require.NoError(t, receiveCmd.Start())
+		require.NoError(t, sendCmd.Run())
+		require.NoError(t, receiveCmd.Wait())
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(src, "f1"), []byte("v1"), 0644))
+	s1, err := r.createSnapshot(time.Now())
+	require.NoError(t, err)
+	pipe(s1, nil)
+
+	require.NoError(t, os.WriteFile(filepath.Join(src, "f1"), []byte("v2"), 0644))
+	s2, err := r.createSnapshot(time.Now())
+	require.NoError(t, err)
+	pipe(s2, &s1)
+
+	b, err = os.ReadFile(filepath.Join(r.dstSnapDir(), s2.Name, "f1"))
+	require.NoError(t, err)
+	assert.Equal(t, "v2", string(b))
+
+	_, err = r.createSnapshot(time.Now())
+	require.NoError(t, err)
+	snaps, err := r.localSnapshots()
+	require.NoError(t, err)
+	require.Len(t, snaps, 3)
+	require.NoError(t, r.deleteLocalSnapshots(expiredSnapshots(snaps, r.keep())))
+	snaps, err = r.localSnapshots()
+	require.NoError(t, err)
+	require.Len(t, snaps, 2)
+	assert.Equal(t, s2.Name, snaps[0].Name)
+}
@@ -0,0 +1,60 @@
+package ressyncbtrfs
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+type (
+	// snapshot is a read-only snapshot of the src subvolume, named
+	// <rid>.<creation time>.
+	snapshot struct {
+		Name string
+		Time time.Time
+	}
+)
+
+// parseSnapshots returns the snapshots of the names having the prefix and
+// a valid creation time suffix, sorted from the oldest to the most recent.
+func parseSnapshots(prefix string, names []string) []snapshot {
+	l := make([]snapshot, 0)
+	for _, name := range names {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		tm, err := time.Parse(snapTimeLayout, name[len(prefix):])
+		if err != nil {
+			continue
+		}
+		l = append(l, snapshot{Name: name, Time: tm})
+	}
+	sort.Slice(l, func(i, j int) bool {
+		return l[i].Time.Before(l[j].Time)
+	})
+	return l
+}
+
+// commonParent returns the most recent local snapshot also present on the
+// peer, or nil if none.
+func commonParent(local, peer []snapshot) *snapshot {
+	m := make(map[string]any)
+	for _, snap := range peer {
+		m[snap.Name] = nil
+	}
+	for i := len(local) - 1; i >= 0; i-- {
+		if _, ok := m[local[i].Name]; ok {
+			return &local[i]
+		}
+	}
+	return nil
+}
+
+// expiredSnapshots returns the snapshots in excess of the keep most recent
+// ones.
+func expiredSnapshots(snaps []snapshot, keep int) []snapshot {
+	if len(snaps) <= keep {
+		return nil
+	}
+	return snaps[:len(snaps)-keep]
+}
@@ -0,0 +1,48 @@
+package ressyncbtrfs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSnapshots(t *testing.T) {
+	names := []string{
+		"sync.1.20240102T000000.000000000Z",
+		"sync.1.20240101T000000.000000000Z",
+		"sync.10.20240101T000000.000000000Z",
+		"sync.1.garbage",
+		"foo",
+	}
+	l := parseSnapshots("sync.1.", names)
+	require.Len(t, l, 2)
+	assert.Equal(t, "sync.1.20240101T000000.000000000Z", l[0].Name)
+	assert.Equal(t, "sync.1.20240102T000000.000000000Z", l[1].Name)
+	assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), l[1].Time)
+}
+
+func TestCommonParent(t *testing.T) {
+	s1 := snapshot{Name: "s1"}
+	s2 := snapshot{Name: "s2"}
+	s3 := snapshot{Name: "s3"}
+	assert.Nil(t, commonParent([]snapshot{s1, s2}, nil))
+	assert.Nil(t, commonParent(nil, []snapshot{s1}))
+	assert.Equal(t, "s2", commonParent([]snapshot{s1, s2, s3}, []snapshot{s1, s2}).Name)
+	assert.Equal(t, "s1", commonParent([]snapshot{s1, s2}, []snapshot{s1, s3}).Name)
+}
+
+func TestExpiredSnapshots(t *testing.T) {
+	s1 := snapshot{Name: "s1"}
+	s2 := snapshot{Name: "s2"}
+	s3 := snapshot{Name: "s3"}
+	assert.Empty(t, expiredSnapshots([]snapshot{s1, s2}, 2))
+	assert.Equal(t, []snapshot{s1}, expiredSnapshots([]snapshot{s1, s2, s3}, 2))
+	assert.Equal(t, []snapshot{s1, s2}, expiredSnapshots([]snapshot{s1, s2, s3}, 1))
+}
+
+func TestSnapDir(t *testing.T) {
+	assert.Equal(t, "/srv/.osvc-snap/data", snapDir("/srv/data"))
+	assert.Equal(t, "/srv/.osvc-snap/data", snapDir("/srv/data/"))
+}
@@ -0,0 +1,68 @@
+/*
+Package stonithcmd fences a peer node using a callout command.
+
+The command must exit 0 only when the peer is confirmed powered off. The
+fence agents of the ClusterLabs project satisfy this contract.
+*/
+package stonithcmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/core/stonith"
+	"github.com/opensvc/om3/util/command"
+	"github.com/opensvc/om3/util/funcopt"
+)
+
+type (
+	T struct {
+		stonith.T
+	}
+)
+
+var (
+	drvID = driver.NewID(driver.GroupStonith, "cmd")
+)
+
+func init() {
+	driver.Register(drvID, NewDriver)
+}
+
+func NewDriver() stonith.Driver {
+	t := New()
+	var i any = t
+	return i.(stonith.Driver)
+}
+
+func New() *T {
+	t := T{}
+	return &t
+}
+
+// Fence runs the stonith command, and returns an error if it does not
+// exit 0 before the stonith timeout.
+func (t *T) Fence(ctx context.Context) error {
+	argv := t.Config().GetStrings(t.Key("cmd"))
+	if len(argv) == 0 {
+		return fmt.Errorf("%s: cmd is not set", stonith.SectionName(t.Name()))
+	}
+	timeout := t.Timeout()
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < timeout {
+		timeout = time.Until(deadline)
+	}
+	opts := []funcopt.O{
+		command.WithName(argv[0]),
+		command.WithArgs(argv[1:]),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+		command.WithTimeout(timeout),
+	}
+	return command.New(opts...).Run()
+}
@@ -0,0 +1,212 @@
+/*
+Package stonithipmi fences a peer node powering it off through the
+IPMI-over-LAN interface of its baseboard management controller, using
+ipmitool.
+*/
+package stonithipmi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/stonith"
+	"github.com/opensvc/om3/util/command"
+	"github.com/opensvc/om3/util/funcopt"
+	"github.com/opensvc/om3/util/plog"
+)
+
+type (
+	T struct {
+		stonith.T
+
+		// Addr is the address of the bmc.
+		Addr string
+
+		// Interface is the ipmitool interface, lanplus or lan.
+		Interface string
+
+		Username string
+		Password string
+
+		// PollInterval is the interval between two power status checks,
+		// after the power off request.
+		PollInterval time.Duration
+
+		log *plog.Logger
+	}
+)
+
+var (
+	drvID = driver.NewID(driver.GroupStonith, "ipmi")
+
+	// ipmitool is the ipmitool executable.
+	ipmitool = "ipmitool"
+
+	// defaultPollInterval is the default interval between two power status
+	// checks.
+	defaultPollInterval = 2 * time.Second
+)
+
+func init() {
+	driver.Register(drvID, NewDriver)
+}
+
+func NewDriver() stonith.Driver {
+	t := New()
+	var i any = t
+	return i.(stonith.Driver)
+}
+
+func New() *T {
+	t := T{
+		PollInterval: defaultPollInterval,
+	}
+	return &t
+}
+
+// Fence powers off the peer node and waits until its chassis power status
+// is reported off, or the stonith timeout expires.
+func (t *T) Fence(ctx context.Context) error {
+	if err := t.configure(); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, t.Timeout())
+	defer cancel()
+	return t.fence(ctx)
+}
+
+func (t *T) configure() error {
+	t.Addr = t.GetString("addr")
+	if t.Addr == "" {
+		return fmt.Errorf("%s: addr is not set", stonith.SectionName(t.Name()))
+	}
+	t.Interface = t.GetString("interface")
+	t.Username = t.GetString("username")
+	t.log = t.Log()
+	if t.GetString("password") != "" {
+		password, err := t.password()
+		if err != nil {
+			return err
+		}
+		t.Password = password
+	}
+	return nil
+}
+
+func (t *T) passwordSec() (object.Sec, error) {
+	secPath, err := naming.ParsePath(t.GetString("password"))
+	if err != nil {
+		return nil, err
+	}
+	return object.NewSec(secPath, object.WithVolatile(true))
+}
+
+func (t *T) password() (string, error) {
+	sec, err := t.passwordSec()
+	if err != nil {
+		return "", err
+	}
+	b, err := sec.DecodeKey("password")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (t *T) fence(ctx context.Context) error {
+	if t.log == nil {
+		t.log = plog.NewDefaultLogger().WithPrefix("stonith: ipmi: ")
+	}
+	if t.Interface == "" {
+		t.Interface = "lanplus"
+	}
+	if isOff, err := t.powerIsOff(ctx); err != nil {
+		return err
+	} else if isOff {
+		t.log.Infof("%s chassis power is already off", t.Addr)
+		return nil
+	}
+	t.log.Infof("%s chassis power is on, power off", t.Addr)
+	if _, err := t.run(ctx, zerolog.InfoLevel, "chassis", "power", "off"); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(t.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s chassis power is not confirmed off: %w", t.Addr, ctx.Err())
+		case <-ticker.C:
+		}
+		if isOff, err := t.powerIsOff(ctx); err != nil {
+			t.log.Warnf("%s", err)
+		} else if isOff {
+			t.log.Infof("%s chassis power is now off", t.Addr)
+			return nil
+		}
+	}
+}
+
+func (t *T) powerIsOff(ctx context.Context) (bool, error) {
+	b, err := t.run(ctx, zerolog.DebugLevel, "chassis", "power", "status")
+	if err != nil {
+		return false, err
+	}
+	return parsePowerStatus(string(b))
+}
+
+// parsePowerStatus returns true if the output of the ipmitool chassis
+// power status command reports the power off.
+func parsePowerStatus(s string) (bool, error) {
+	s = strings.TrimSpace(s)
+	switch {
+	case strings.HasSuffix(s, "Chassis Power is off"):
+		return true, nil
+	case strings.HasSuffix(s, "Chassis Power is on"):
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected chassis power status: %s", s)
+	}
+}
+
+// args returns the ipmitool arguments selecting the bmc. The password is
+// passed through the IPMI_PASSWORD environment variable, so it does not
+// show in the process table.
+func (t *T) args() []string {
+	l := []string{"-I", t.Interface, "-H", t.Addr}
+	if t.Username != "" {
+		l = append(l, "-U", t.Username)
+	}
+	if t.Password != "" {
+		l = append(l, "-E")
+	}
+	return l
+}
+
+func (t *T) run(ctx context.Context, level zerolog.Level, args ...string) ([]byte, error) {
+	opts := []funcopt.O{
+		command.WithName(ipmitool),
+		command.WithArgs(append(t.args(), args...)),
+		command.WithVarEnv("IPMI_PASSWORD=" + t.Password),
+		command.WithLogger(t.log),
+		command.WithCommandLogLevel(level),
+		command.WithStdoutLogLevel(zerolog.DebugLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+		command.WithBufferedStdout(),
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		opts = append(opts, command.WithTimeout(time.Until(deadline)))
+	}
+	cmd := command.New(opts...)
+	if err := cmd.Run(); err != nil {
+		return nil, err
+	}
+	return cmd.Stdout(), nil
+}
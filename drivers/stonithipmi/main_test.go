@@ -0,0 +1,92 @@
+package stonithipmi
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePowerStatus(t *testing.T) {
+	isOff, err := parsePowerStatus("Chassis Power is off\n")
+	require.NoError(t, err)
+	require.True(t, isOff)
+
+	isOff, err = parsePowerStatus("Chassis Power is on\n")
+	require.NoError(t, err)
+	require.False(t, isOff)
+
+	_, err = parsePowerStatus("Error: Unable to establish IPMI v2 / RMCP+ session\n")
+	require.Error(t, err)
+}
+
+func TestArgs(t *testing.T) {
+	drv := New()
+	drv.Interface = "lanplus"
+	drv.Addr = "bmc1"
+	require.Equal(t, []string{"-I", "lanplus", "-H", "bmc1"}, drv.args())
+
+	drv.Username = "admin"
+	drv.Password = "secret"
+	require.Equal(t, []string{"-I", "lanplus", "-H", "bmc1", "-U", "admin", "-E"}, drv.args())
+}
+
+func TestFence(t *testing.T) {
+	// fakeIpmitool emulates a bmc powered on, and powering off on
+	// "chassis power off". The power state is stored in the state file.
+	fakeIpmitool := `#!/bin/sh
+state="$(dirname "$0")/state"
+test "$IPMI_PASSWORD" = "secret" || { echo "Error: invalid password" >&2; exit 1; }
+case "$*" in
+*"chassis power off") echo off >"$state"; echo "Chassis Power Control: Down/Off";;
+*"chassis power status") echo "Chassis Power is $(cat "$state")";;
+*) exit 1;;
+esac
+`
+	setup := func(t *testing.T, state string) string {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "ipmitool"), []byte(fakeIpmitool), 0700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "state"), []byte(state+"\n"), 0600))
+		prev := ipmitool
+		ipmitool = filepath.Join(dir, "ipmitool")
+		t.Cleanup(func() { ipmitool = prev })
+		return filepath.Join(dir, "state")
+	}
+
+	newTestDriver := func() *T {
+		drv := New()
+		drv.Addr = "bmc1"
+		drv.Username = "admin"
+		drv.Password = "secret"
+		drv.PollInterval = 10 * time.Millisecond
+		return drv
+	}
+
+	t.Run("power off a running node", func(t *testing.T) {
+		stateFile := setup(t, "on")
+		require.NoError(t, newTestDriver().fence(context.Background()))
+		b, err := os.ReadFile(stateFile)
+		require.NoError(t, err)
+		require.Equal(t, "off\n", string(b))
+	})
+
+	t.Run("do not power off a node already off", func(t *testing.T) {
+		setup(t, "off")
+		require.NoError(t, newTestDriver().fence(context.Background()))
+	})
+
+	t.Run("fail when the bmc refuses the request", func(t *testing.T) {
+		stateFile := setup(t, "on")
+		drv := newTestDriver()
+		drv.Password = "wrong"
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		require.Error(t, drv.fence(ctx))
+		b, err := os.ReadFile(stateFile)
+		require.NoError(t, err)
+		require.Equal(t, "on\n", string(b))
+	})
+}
@@ -0,0 +1,262 @@
+/*
+Package stonithredfish fences a peer node powering it off through the
+Redfish api of its baseboard management controller.
+*/
+package stonithredfish
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/stonith"
+	"github.com/opensvc/om3/util/plog"
+)
+
+type (
+	T struct {
+		stonith.T
+
+		// URL is the base url of the bmc, ie https://bmc1.acme.com
+		URL string
+
+		// System is the path of the Redfish ComputerSystem to power off.
+		// The first member of /redfish/v1/Systems is used if empty.
+		System string
+
+		Username  string
+		Password  string
+		Insecure  bool
+		ResetType string
+
+		// PollInterval is the interval between two power state checks,
+		// after the reset request.
+		PollInterval time.Duration
+
+		client *http.Client
+		log    *plog.Logger
+	}
+
+	odataID struct {
+		ID string `json:"@odata.id"`
+	}
+
+	collection struct {
+		Members []odataID `json:"Members"`
+	}
+
+	computerSystem struct {
+		PowerState string `json:"PowerState"`
+		Actions    struct {
+			Reset struct {
+				Target string `json:"target"`
+			} `json:"#ComputerSystem.Reset"`
+		} `json:"Actions"`
+	}
+)
+
+var (
+	drvID = driver.NewID(driver.GroupStonith, "redfish")
+
+	// defaultPollInterval is the default interval between two power state
+	// checks.
+	defaultPollInterval = 2 * time.Second
+)
+
+func init() {
+	driver.Register(drvID, NewDriver)
+}
+
+func NewDriver() stonith.Driver {
+	t := New()
+	var i any = t
+	return i.(stonith.Driver)
+}
+
+func New() *T {
+	t := T{
+		PollInterval: defaultPollInterval,
+	}
+	return &t
+}
+
+// Fence powers off the peer node and waits until its power state is
+// reported off, or the stonith timeout expires.
+func (t *T) Fence(ctx context.Context) error {
+	if err := t.configure(); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, t.Timeout())
+	defer cancel()
+	return t.fence(ctx)
+}
+
+func (t *T) configure() error {
+	t.URL = t.GetString("url")
+	if t.URL == "" {
+		return fmt.Errorf("%s: url is not set", stonith.SectionName(t.Name()))
+	}
+	t.System = t.GetString("system")
+	t.Username = t.GetString("username")
+	t.Insecure = t.GetBool("insecure")
+	t.ResetType = t.GetString("reset_type")
+	t.log = t.Log()
+	if t.GetString("password") != "" {
+		password, err := t.password()
+		if err != nil {
+			return err
+		}
+		t.Password = password
+	}
+	return nil
+}
+
+func (t *T) passwordSec() (object.Sec, error) {
+	secPath, err := naming.ParsePath(t.GetString("password"))
+	if err != nil {
+		return nil, err
+	}
+	return object.NewSec(secPath, object.WithVolatile(true))
+}
+
+func (t *T) password() (string, error) {
+	sec, err := t.passwordSec()
+	if err != nil {
+		return "", err
+	}
+	b, err := sec.DecodeKey("password")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (t *T) fence(ctx context.Context) error {
+	if t.client == nil {
+		t.client = &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: t.Insecure},
+			},
+		}
+	}
+	if t.log == nil {
+		t.log = plog.NewDefaultLogger().WithPrefix("stonith: redfish: ")
+	}
+	if t.ResetType == "" {
+		t.ResetType = "ForceOff"
+	}
+	systemPath, err := t.systemPath(ctx)
+	if err != nil {
+		return err
+	}
+	var system computerSystem
+	if err := t.get(ctx, systemPath, &system); err != nil {
+		return err
+	}
+	if isOff(system.PowerState) {
+		t.log.Infof("%s power state is already %s", systemPath, system.PowerState)
+		return nil
+	}
+	target := system.Actions.Reset.Target
+	if target == "" {
+		target = strings.TrimSuffix(systemPath, "/") + "/Actions/ComputerSystem.Reset"
+	}
+	t.log.Infof("%s power state is %s, post %s reset", systemPath, system.PowerState, t.ResetType)
+	if err := t.post(ctx, target, map[string]string{"ResetType": t.ResetType}); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(t.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s power state is not confirmed off: %w", systemPath, ctx.Err())
+		case <-ticker.C:
+		}
+		if err := t.get(ctx, systemPath, &system); err != nil {
+			t.log.Warnf("%s", err)
+			continue
+		}
+		if isOff(system.PowerState) {
+			t.log.Infof("%s power state is now %s", systemPath, system.PowerState)
+			return nil
+		}
+	}
+}
+
+// systemPath returns the path of the ComputerSystem to power off.
+func (t *T) systemPath(ctx context.Context) (string, error) {
+	if t.System != "" {
+		if strings.HasPrefix(t.System, "/") {
+			return t.System, nil
+		}
+		return "/redfish/v1/Systems/" + t.System, nil
+	}
+	var systems collection
+	if err := t.get(ctx, "/redfish/v1/Systems", &systems); err != nil {
+		return "", err
+	}
+	if len(systems.Members) == 0 {
+		return "", fmt.Errorf("no system found in /redfish/v1/Systems")
+	}
+	return systems.Members[0].ID, nil
+}
+
+func (t *T) get(ctx context.Context, path string, data any) error {
+	return t.do(ctx, http.MethodGet, path, nil, data)
+}
+
+func (t *T) post(ctx context.Context, path string, body any) error {
+	return t.do(ctx, http.MethodPost, path, body, nil)
+}
+
+func (t *T) do(ctx context.Context, method, path string, body, data any) error {
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(t.URL, "/")+path, r)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if t.Username != "" {
+		req.SetBasicAuth(t.Username, t.Password)
+	}
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s %s: unexpected status %s: %s", method, path, resp.Status, strings.TrimSpace(string(b)))
+	}
+	if data == nil || len(b) == 0 {
+		return nil
+	}
+	return json.Unmarshal(b, data)
+}
+
+func isOff(s string) bool {
+	return s == "Off"
+}
@@ -0,0 +1,135 @@
+package stonithredfish
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	mockBMC struct {
+		sync.Mutex
+		powerState string
+
+		// powerOffAfter is the number of system gets answering the previous
+		// power state after a reset request.
+		powerOffAfter int
+		resetTypes    []string
+		resetPending  int
+	}
+)
+
+func (m *mockBMC) handler(t *testing.T) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/redfish/v1/Systems", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		_, _ = w.Write([]byte(`{"Members": [{"@odata.id": "/redfish/v1/Systems/1"}]}`))
+	})
+	mux.HandleFunc("/redfish/v1/Systems/1", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		m.Lock()
+		defer m.Unlock()
+		if m.resetPending > 0 {
+			m.resetPending--
+			if m.resetPending == 0 {
+				m.powerState = "Off"
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"PowerState": m.powerState,
+			"Actions": map[string]any{
+				"#ComputerSystem.Reset": map[string]string{
+					"target": "/redfish/v1/Systems/1/Actions/ComputerSystem.Reset",
+				},
+			},
+		})
+	})
+	mux.HandleFunc("/redfish/v1/Systems/1/Actions/ComputerSystem.Reset", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "admin" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		m.Lock()
+		defer m.Unlock()
+		m.resetTypes = append(m.resetTypes, body["ResetType"])
+		m.resetPending = m.powerOffAfter + 1
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return mux
+}
+
+func newTestDriver(url string) *T {
+	t := New()
+	t.URL = url
+	t.Username = "admin"
+	t.Password = "secret"
+	t.PollInterval = 10 * time.Millisecond
+	return t
+}
+
+func TestFence(t *testing.T) {
+	t.Run("power off a running system", func(t *testing.T) {
+		bmc := &mockBMC{powerState: "On", powerOffAfter: 2}
+		srv := httptest.NewServer(bmc.handler(t))
+		defer srv.Close()
+
+		drv := newTestDriver(srv.URL)
+		require.NoError(t, drv.fence(context.Background()))
+		require.Equal(t, []string{"ForceOff"}, bmc.resetTypes)
+		require.Equal(t, "Off", bmc.powerState)
+	})
+
+	t.Run("use the configured system and reset type", func(t *testing.T) {
+		bmc := &mockBMC{powerState: "On"}
+		srv := httptest.NewServer(bmc.handler(t))
+		defer srv.Close()
+
+		drv := newTestDriver(srv.URL)
+		drv.System = "1"
+		drv.ResetType = "GracefulShutdown"
+		require.NoError(t, drv.fence(context.Background()))
+		require.Equal(t, []string{"GracefulShutdown"}, bmc.resetTypes)
+	})
+
+	t.Run("do not reset a system already off", func(t *testing.T) {
+		bmc := &mockBMC{powerState: "Off"}
+		srv := httptest.NewServer(bmc.handler(t))
+		defer srv.Close()
+
+		drv := newTestDriver(srv.URL)
+		require.NoError(t, drv.fence(context.Background()))
+		require.Empty(t, bmc.resetTypes)
+	})
+
+	t.Run("fail when the power off is not confirmed", func(t *testing.T) {
+		bmc := &mockBMC{powerState: "On", powerOffAfter: 1000}
+		srv := httptest.NewServer(bmc.handler(t))
+		defer srv.Close()
+
+		drv := newTestDriver(srv.URL)
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, drv.fence(ctx), context.DeadlineExceeded)
+	})
+
+	t.Run("fail when the reset request is refused", func(t *testing.T) {
+		bmc := &mockBMC{powerState: "On"}
+		srv := httptest.NewServer(bmc.handler(t))
+		defer srv.Close()
+
+		drv := newTestDriver(srv.URL)
+		drv.Password = "wrong"
+		require.ErrorContains(t, drv.fence(context.Background()), "401")
+		require.Equal(t, "On", bmc.powerState)
+	})
+}
@@ -0,0 +1,244 @@
+// Package blockdelta implements a block level incremental copy of a
+// block device or file.
+//
+// The sender reads the source blocks, compares their checksum with the
+// checksum map of the previous copy, and writes the changed blocks to a
+// delta stream. The receiver applies the delta stream to the destination.
+//
+// The delta stream format is:
+//
+//	header: magic "OSVCBDS1", uint64 block size, uint64 source size
+//	frames: uint64 offset, uint32 length, <length> bytes of data
+//	end:    uint64 offset 2^64-1, uint32 length 0
+//
+// All integers are big endian.
+package blockdelta
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+type (
+	// Sum is the checksum of a block.
+	Sum [sha256.Size]byte
+
+	// Map is the checksum map of a block device or file.
+	Map struct {
+		BlockSize int64
+		Size      int64
+		Sums      []Sum
+	}
+
+	// SendStats reports the work done by a Send.
+	SendStats struct {
+		ReadBytes    int64
+		SentBytes    int64
+		ChangedCount int64
+		BlockCount   int64
+	}
+)
+
+const (
+	streamMagic = "OSVCBDS1"
+	mapMagic    = "OSVCBDM1"
+	endOffset   = ^uint64(0)
+)
+
+var (
+	ErrInvalidStream = errors.New("invalid block delta stream")
+	ErrInvalidMap    = errors.New("invalid block delta checksum map")
+	ErrTooSmall      = errors.New("destination is smaller than the source")
+	ErrBaseMismatch  = errors.New("delta base is not the last applied stream")
+)
+
+// Compatible returns true if the checksum map can be used as the previous
+// map of a Send with the block size and source size.
+func (t *Map) Compatible(blockSize, size int64) bool {
+	if t == nil {
+		return false
+	}
+	return t.BlockSize == blockSize && t.Size == size && int64(len(t.Sums)) == blockCount(blockSize, size)
+}
+
+func blockCount(blockSize, size int64) int64 {
+	return (size + blockSize - 1) / blockSize
+}
+
+// Send reads the size bytes of r by blocks of blockSize bytes, and writes
+// to w the delta stream of the blocks whose checksum differs from the prev
+// map. All blocks are sent if prev is nil or not compatible.
+//
+// Send returns the checksum map of r to use as the prev map of the next
+// Send.
+func Send(ctx context.Context, w io.Writer, r io.ReaderAt, size, blockSize int64, prev *Map) (*Map, SendStats, error) {
+	var stats SendStats
+	if blockSize <= 0 {
+		return nil, stats, fmt.Errorf("invalid block size %d", blockSize)
+	}
+	if !prev.Compatible(blockSize, size) {
+		prev = nil
+	}
+	m := &Map{
+		BlockSize: blockSize,
+		Size:      size,
+		Sums:      make([]Sum, blockCount(blockSize, size)),
+	}
+	bw := bufio.NewWriterSize(w, int(min(blockSize, 4*1024*1024))+12)
+	header := make([]byte, 0, len(streamMagic)+16)
+	header = append(header, streamMagic...)
+	header = binary.BigEndian.AppendUint64(header, uint64(blockSize))
+	header = binary.BigEndian.AppendUint64(header, uint64(size))
+	if _, err := bw.Write(header); err != nil {
+		return nil, stats, err
+	}
+	stats.SentBytes += int64(len(header))
+
+	buf := make([]byte, blockSize)
+	frameHeader := make([]byte, 12)
+	for i := range m.Sums {
+		if err := ctx.Err(); err != nil {
+			return nil, stats, err
+		}
+		offset := int64(i) * blockSize
+		n := min(blockSize, size-offset)
+		if _, err := r.ReadAt(buf[:n], offset); err != nil && !(errors.Is(err, io.EOF) && n == size-offset) {
+			return nil, stats, fmt.Errorf("read block at offset %d: %w", offset, err)
+		}
+		stats.ReadBytes += n
+		stats.BlockCount++
+		m.Sums[i] = sha256.Sum256(buf[:n])
+		if prev != nil && prev.Sums[i] == m.Sums[i] {
+			continue
+		}
+		binary.BigEndian.PutUint64(frameHeader[0:8], uint64(offset))
+		binary.BigEndian.PutUint32(frameHeader[8:12], uint32(n))
+		if _, err := bw.Write(frameHeader); err != nil {
+			return nil, stats, err
+		}
+		if _, err := bw.Write(buf[:n]); err != nil {
+			return nil, stats, err
+		}
+		stats.ChangedCount++
+		stats.SentBytes += 12 + n
+	}
+	binary.BigEndian.PutUint64(frameHeader[0:8], endOffset)
+	binary.BigEndian.PutUint32(frameHeader[8:12], 0)
+	if _, err := bw.Write(frameHeader); err != nil {
+		return nil, stats, err
+	}
+	stats.SentBytes += 12
+	if err := bw.Flush(); err != nil {
+		return nil, stats, err
+	}
+	return m, stats, nil
+}
+
+// Receive applies the delta stream read from r to w, whose size is
+// dstSize. It returns the number of data bytes written.
+func Receive(r io.Reader, w io.WriterAt, dstSize int64) (int64, error) {
+	var written int64
+	br := bufio.NewReader(r)
+	header := make([]byte, len(streamMagic)+16)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return written, fmt.Errorf("%w: read header: %s", ErrInvalidStream, err)
+	}
+	if string(header[:len(streamMagic)]) != streamMagic {
+		return written, fmt.Errorf("%w: bad magic", ErrInvalidStream)
+	}
+	blockSize := int64(binary.BigEndian.Uint64(header[len(streamMagic):]))
+	size := int64(binary.BigEndian.Uint64(header[len(streamMagic)+8:]))
+	if blockSize <= 0 || size < 0 {
+		return written, fmt.Errorf("%w: bad block size %d or size %d", ErrInvalidStream, blockSize, size)
+	}
+	if size > dstSize {
+		return written, fmt.Errorf("%w: %d < %d", ErrTooSmall, dstSize, size)
+	}
+	buf := make([]byte, blockSize)
+	frameHeader := make([]byte, 12)
+	for {
+		if _, err := io.ReadFull(br, frameHeader); err != nil {
+			return written, fmt.Errorf("%w: read frame header: %s", ErrInvalidStream, err)
+		}
+		offset := binary.BigEndian.Uint64(frameHeader[0:8])
+		n := int64(binary.BigEndian.Uint32(frameHeader[8:12]))
+		if offset == endOffset {
+			return written, nil
+		}
+		if n > blockSize || int64(offset)+n > size || int64(offset) < 0 {
+			return written, fmt.Errorf("%w: bad frame offset %d length %d", ErrInvalidStream, offset, n)
+		}
+		if _, err := io.ReadFull(br, buf[:n]); err != nil {
+			return written, fmt.Errorf("%w: read frame data: %s", ErrInvalidStream, err)
+		}
+		if _, err := w.WriteAt(buf[:n], int64(offset)); err != nil {
+			return written, fmt.Errorf("write block at offset %d: %w", offset, err)
+		}
+		written += n
+	}
+}
+
+// Size returns the size of the block device or file at p.
+func Size(p string) (int64, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	return f.Seek(0, io.SeekEnd)
+}
+
+// LoadMap reads the checksum map file at p. It returns nil and no error
+// if the file does not exist.
+func LoadMap(p string) (*Map, error) {
+	b, err := os.ReadFile(p)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if len(b) < len(mapMagic)+16 || string(b[:len(mapMagic)]) != mapMagic {
+		return nil, fmt.Errorf("%w: %s: bad header", ErrInvalidMap, p)
+	}
+	m := &Map{
+		BlockSize: int64(binary.BigEndian.Uint64(b[len(mapMagic):])),
+		Size:      int64(binary.BigEndian.Uint64(b[len(mapMagic)+8:])),
+	}
+	data := b[len(mapMagic)+16:]
+	if m.BlockSize <= 0 || int64(len(data)) != blockCount(m.BlockSize, m.Size)*sha256.Size {
+		return nil, fmt.Errorf("%w: %s: bad length", ErrInvalidMap, p)
+	}
+	m.Sums = make([]Sum, len(data)/sha256.Size)
+	for i := range m.Sums {
+		copy(m.Sums[i][:], data[i*sha256.Size:])
+	}
+	return m, nil
+}
+
+// Save writes the checksum map to the file at p.
+func (t *Map) Save(p string) error {
+	var b bytes.Buffer
+	b.Grow(len(mapMagic) + 16 + len(t.Sums)*sha256.Size)
+	b.WriteString(mapMagic)
+	_ = binary.Write(&b, binary.BigEndian, uint64(t.BlockSize))
+	_ = binary.Write(&b, binary.BigEndian, uint64(t.Size))
+	for _, sum := range t.Sums {
+		b.Write(sum[:])
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return err
+	}
+	tmp := p + ".tmp"
+	if err := os.WriteFile(tmp, b.Bytes(), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, p)
+}
@@ -0,0 +1,115 @@
+package blockdelta
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writerAt is an in-memory io.WriterAt of fixed size.
+type writerAt []byte
+
+func (t writerAt) WriteAt(b []byte, off int64) (int, error) {
+	return copy(t[off:], b), nil
+}
+
+func randomBytes(t *testing.T, n int) []byte {
+	t.Helper()
+	b := make([]byte, n)
+	_, err := rand.Read(b)
+	require.NoError(t, err)
+	return b
+}
+
+func TestSendReceive(t *testing.T) {
+	const blockSize = 4096
+	ctx := context.Background()
+	src := randomBytes(t, 10*blockSize+100)
+	size := int64(len(src))
+	dst := make(writerAt, size)
+
+	t.Run("full send", func(t *testing.T) {
+		var stream bytes.Buffer
+		m, stats, err := Send(ctx, &stream, bytes.NewReader(src), size, blockSize, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int64(11), stats.BlockCount)
+		assert.Equal(t, int64(11), stats.ChangedCount)
+		assert.Equal(t, int64(stream.Len()), stats.SentBytes)
+		assert.Len(t, m.Sums, 11)
+
+		n, err := Receive(&stream, dst, size)
+		require.NoError(t, err)
+		assert.Equal(t, size, n)
+		assert.Equal(t, src, []byte(dst))
+
+		t.Run("delta send", func(t *testing.T) {
+			copy(src[3*blockSize+10:], []byte("changed"))
+			copy(src[10*blockSize+1:], []byte("tail"))
+			var stream bytes.Buffer
+			m2, stats, err := Send(ctx, &stream, bytes.NewReader(src), size, blockSize, m)
+			require.NoError(t, err)
+			assert.Equal(t, int64(2), stats.ChangedCount)
+			assert.NotEqual(t, m.Sums[3], m2.Sums[3])
+			assert.Equal(t, m.Sums[4], m2.Sums[4])
+
+			n, err := Receive(&stream, dst, size)
+			require.NoError(t, err)
+			assert.Equal(t, int64(blockSize+100), n)
+			assert.Equal(t, src, []byte(dst))
+		})
+	})
+
+	t.Run("incompatible map forces a full send", func(t *testing.T) {
+		m, _, err := Send(ctx, &bytes.Buffer{}, bytes.NewReader(src), size, blockSize, nil)
+		require.NoError(t, err)
+		_, stats, err := Send(ctx, &bytes.Buffer{}, bytes.NewReader(src), size, 2*blockSize, m)
+		require.NoError(t, err)
+		assert.Equal(t, stats.BlockCount, stats.ChangedCount)
+	})
+
+	t.Run("destination too small", func(t *testing.T) {
+		var stream bytes.Buffer
+		_, _, err := Send(ctx, &stream, bytes.NewReader(src), size, blockSize, nil)
+		require.NoError(t, err)
+		_, err = Receive(&stream, make(writerAt, size-1), size-1)
+		assert.ErrorIs(t, err, ErrTooSmall)
+	})
+
+	t.Run("truncated stream", func(t *testing.T) {
+		var stream bytes.Buffer
+		_, _, err := Send(ctx, &stream, bytes.NewReader(src), size, blockSize, nil)
+		require.NoError(t, err)
+		truncated := bytes.NewReader(stream.Bytes()[:stream.Len()-20])
+		_, err = Receive(truncated, make(writerAt, size), size)
+		assert.ErrorIs(t, err, ErrInvalidStream)
+	})
+}
+
+func TestMapSaveLoad(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "maps", "node1.map")
+
+	m, err := LoadMap(p)
+	require.NoError(t, err)
+	assert.Nil(t, m)
+
+	src := randomBytes(t, 3*512+1)
+	m, _, err = Send(context.Background(), &bytes.Buffer{}, bytes.NewReader(src), int64(len(src)), 512, nil)
+	require.NoError(t, err)
+	require.NoError(t, m.Save(p))
+
+	loaded, err := LoadMap(p)
+	require.NoError(t, err)
+	assert.Equal(t, m, loaded)
+	assert.True(t, loaded.Compatible(512, int64(len(src))))
+	assert.False(t, loaded.Compatible(1024, int64(len(src))))
+
+	require.NoError(t, os.WriteFile(p, []byte("garbage"), 0600))
+	_, err = LoadMap(p)
+	assert.ErrorIs(t, err, ErrInvalidMap)
+}
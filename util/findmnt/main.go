@@ -114,6 +114,29 @@ func List(dev string, mnt string) (mounts []MountInfo, err error) {
 	return
 }
 
+// ListMountPoint returns the list of MountInfo of the filesystems mounted
+// on mnt, the last one being the top of the mount stack.
+//
+// Unlike List, it does not filter on the source, so it can be used for
+// bind and overlay mounts whose findmnt source is not the mount device.
+func ListMountPoint(mnt string) (mounts []MountInfo, err error) {
+	if _, err = exec.LookPath("findmnt"); err != nil {
+		return
+	}
+	if mounts, err = findMnt([]string{"-J", "-l", "-M", mnt}); err != nil {
+		return
+	}
+	filtered := make([]MountInfo, 0)
+	for _, mi := range mounts {
+		if mi.Target != mnt {
+			continue
+		}
+		filtered = append(filtered, mi)
+	}
+	mounts = filtered
+	return
+}
+
 // findMntArgs returns findmnt exec args for dev and mnt.
 // When dev is on nfs, -T mnt is skipped to prevent command hang
 // When dev is dir, -S dev is skipped
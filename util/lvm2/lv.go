@@ -32,6 +32,7 @@ type (
 		ConvertPV       string `json:"convert_pv"`
 		MirrorLog       string `json:"mirror_log"`
 		Devices         string `json:"devices"`
+		LVTime          string `json:"lv_time"`
 	}
 	LV struct {
 		driver
@@ -0,0 +1,135 @@
+//go:build linux
+
+package lvm2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/util/command"
+	"github.com/opensvc/om3/util/sizeconv"
+)
+
+const (
+	// LVAttrTypeThinVolume is the type attr value of thin volumes.
+	LVAttrTypeThinVolume LVAttr = 'V'
+
+	lvTimeLayout = "2006-01-02 15:04:05 -0700"
+)
+
+// Size returns the logical volume size in bytes.
+func (t LVInfo) Size() (int64, error) {
+	return sizeconv.FromSize(strings.TrimLeft(t.LVSize, "<>+"))
+}
+
+// Time returns the logical volume creation time.
+func (t LVInfo) Time() (time.Time, error) {
+	return time.Parse(lvTimeLayout, t.LVTime)
+}
+
+// Used returns the bytes allocated in the snapshot or thin logical volume.
+func (t LVInfo) Used() int64 {
+	size, err := t.Size()
+	if err != nil {
+		return 0
+	}
+	pct, err := strconv.ParseFloat(t.DataPercent, 64)
+	if err != nil {
+		return 0
+	}
+	return int64(float64(size) * pct / 100)
+}
+
+// IsThin returns true if the logical volume is a thin volume.
+func (t LVInfo) IsThin() bool {
+	return LVAttrs(t.LVAttr).Attr(LVAttrIndexType) == LVAttrTypeThinVolume
+}
+
+// CreateSnapshot creates the snapshot logical volume <name> of the logical
+// volume. The size is ignored for thin volumes, and can be expressed as
+// a percentage of the origin size, like "20%ORIGIN".
+func (t *LV) CreateSnapshot(name, size string, thin bool) error {
+	args := []string{"-s", "-n", name}
+	switch {
+	case thin:
+		// thin snapshots are allocated in the thin pool, and skip the
+		// activation by default.
+		args = append(args, "-kn")
+	case strings.Contains(size, "%"):
+		args = append(args, "-l", size)
+	default:
+		if i, err := sizeconv.FromSize(size); err == nil {
+			size = fmt.Sprintf("%dB", i)
+		}
+		args = append(args, "-L", size)
+	}
+	cmd := command.New(
+		command.WithName("lvcreate"),
+		command.WithArgs(append(args, "--yes", t.FQN())),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	cmd.Run()
+	if cmd.ExitCode() != 0 {
+		return fmt.Errorf("%s error %d", cmd, cmd.ExitCode())
+	}
+	return nil
+}
+
+// Merge merges the snapshot logical volume into its origin. The snapshot
+// is removed when the merge completes. If the origin is open, the merge
+// is deferred to its next activation.
+func (t *LV) Merge() error {
+	cmd := command.New(
+		command.WithName("lvconvert"),
+		command.WithVarArgs("--merge", t.FQN()),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	cmd.Run()
+	if cmd.ExitCode() != 0 {
+		return fmt.Errorf("%s error %d", cmd, cmd.ExitCode())
+	}
+	return nil
+}
+
+// Snapshots returns the snapshot logical volumes of the logical volume,
+// oldest first.
+func (t *LV) Snapshots() ([]LVInfo, error) {
+	data := ShowData{}
+	cmd := command.New(
+		command.WithName("lvs"),
+		command.WithVarArgs(
+			"--reportformat", "json",
+			"-o", "lv_name,vg_name,lv_attr,lv_size,origin,data_percent,lv_time",
+			"-O", "lv_time",
+			"-S", "origin="+t.LVName,
+			t.VGName,
+		),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.DebugLevel),
+		command.WithStdoutLogLevel(zerolog.DebugLevel),
+		command.WithStderrLogLevel(zerolog.DebugLevel),
+		command.WithBufferedStdout(),
+	)
+	if err := cmd.Run(); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(cmd.Stdout(), &data); err != nil {
+		return nil, err
+	}
+	l := make([]LVInfo, 0)
+	for _, report := range data.Report {
+		l = append(l, report.LV...)
+	}
+	return l, nil
+}
@@ -1126,6 +1126,54 @@ func (t *Expr) Next(opts ...funcopt.O) (time.Time, time.Duration, error) {
 	return next, interval, nil
 }
 
+// WindowEnd returns the first time after tm the expression is no longer
+// valid, or limit if the expression is valid until limit. It returns tm if
+// the expression is not valid at tm.
+//
+// The candidate ends are the timerange boundaries and the midnights, where
+// the day, week and month constraints change, so the lookup steps are
+// bounded by the number of contiguous timeranges.
+func (t *Expr) WindowEnd(tm, limit time.Time) (time.Time, error) {
+	if err := t.makeDataset(); err != nil {
+		return tm, err
+	}
+	isValid := func(tm time.Time) bool {
+		_, err := t.Test(tm)
+		return err == nil
+	}
+	if !isValid(tm) {
+		return tm, nil
+	}
+	for {
+		tm = t.dataset.nextBoundary(tm)
+		if !tm.Before(limit) {
+			return limit, nil
+		}
+		if !isValid(tm) {
+			return tm, nil
+		}
+	}
+}
+
+// nextBoundary returns the first time after tm a timerange of the
+// schedules begins or ends, or the next midnight.
+func (t Schedules) nextBoundary(tm time.Time) time.Time {
+	midnight := time.Date(tm.Year(), tm.Month(), tm.Day(), 0, 0, 0, 0, tm.Location())
+	next := midnight.AddDate(0, 0, 1)
+	for _, data := range t {
+		for _, tr := range data.timeranges {
+			// the timerange end is included, so the first invalid time
+			// is one second after.
+			for _, offset := range []time.Duration{tr.begin, tr.end + time.Second} {
+				if b := midnight.Add(offset); b.After(tm) && b.Before(next) {
+					next = b
+				}
+			}
+		}
+	}
+	return next
+}
+
 func getNext(data Schedule, options nextOptionsT, excludes Schedules) (time.Time, time.Duration) {
 	var (
 		next     time.Time
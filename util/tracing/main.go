@@ -0,0 +1,180 @@
+/*
+Package tracing provides the OpenTelemetry spans of the opensvc actions.
+
+The spans are exported only if the OSVC_TRACES environment variable is set
+to one of:
+
+	stdout    write the spans as json to the standard output
+	stderr    write the spans as json to the standard error
+	<path>    append the spans as json to the file <path>
+
+The daemon and the commands it executes inherit the variable, so setting it
+in the daemon environment is enough to trace the orchestrations. Setting it
+in the om or ox environment traces the client side of the actions.
+
+The trace context is propagated:
+
+  - from client to daemon, and from daemon to peer daemon, using the W3C
+    traceparent http header
+  - from daemon to the commands it executes, using the TRACEPARENT
+    environment variable
+  - from the api handler to the instance monitors of all nodes, using the
+    trace_parent field of the instance monitor states
+
+When OSVC_TRACES is not set, the spans are not recorded but the trace
+context is still propagated, so a traced client can follow its actions
+through a non-traced daemon.
+*/
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/version"
+)
+
+const (
+	// EnvVar is the environment variable selecting the span exporter.
+	EnvVar = "OSVC_TRACES"
+
+	instrumentationName = "github.com/opensvc/om3"
+)
+
+var (
+	propagator = propagation.TraceContext{}
+
+	provider *sdktrace.TracerProvider
+	closer   io.Closer
+)
+
+// Start installs the span exporter selected by the OSVC_TRACES environment
+// variable. The name is the service name reported in the spans.
+func Start(name string) error {
+	var w io.Writer
+	switch s := os.Getenv(EnvVar); s {
+	case "":
+		return nil
+	case "stdout":
+		w = os.Stdout
+	case "stderr":
+		w = os.Stderr
+	default:
+		f, err := os.OpenFile(s, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+		if err != nil {
+			return fmt.Errorf("tracing: %w", err)
+		}
+		w, closer = f, f
+	}
+	exporter, err := stdouttrace.New(stdouttrace.WithWriter(w))
+	if err != nil {
+		return fmt.Errorf("tracing: %w", err)
+	}
+	res := resource.NewSchemaless(
+		attribute.String("service.name", name),
+		attribute.String("service.version", version.Version()),
+		attribute.String("host.name", hostname.Hostname()),
+	)
+	// the spans are exported synchronously, so the commands exiting
+	// without calling Stop don't lose their spans.
+	provider = sdktrace.NewTracerProvider(
+		sdktrace.WithSyncer(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	return nil
+}
+
+// Stop flushes the pending spans and closes the exporter.
+func Stop(ctx context.Context) error {
+	if provider == nil {
+		return nil
+	}
+	err := provider.Shutdown(ctx)
+	if closer != nil {
+		_ = closer.Close()
+	}
+	return err
+}
+
+// StartSpan starts a span named name, child of the span found in ctx.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records err in span, if not nil, and ends the span.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// InjectRequest adds the trace context of ctx to the req headers. Its
+// signature allows its use as an api client request editor.
+func InjectRequest(ctx context.Context, req *http.Request) error {
+	propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+	return nil
+}
+
+// ExtractRequest returns a copy of ctx with the trace context of the req
+// headers.
+func ExtractRequest(ctx context.Context, req *http.Request) context.Context {
+	return propagator.Extract(ctx, propagation.HeaderCarrier(req.Header))
+}
+
+// Environ returns the environment variables propagating the trace context
+// of ctx to a command. The list is empty if ctx has no trace context.
+func Environ(ctx context.Context) []string {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	l := make([]string, 0)
+	for k, v := range carrier {
+		l = append(l, strings.ToUpper(k)+"="+v)
+	}
+	return l
+}
+
+// ContextFromEnv returns a copy of ctx with the trace context passed in the
+// environment by the parent process.
+func ContextFromEnv(ctx context.Context) context.Context {
+	carrier := propagation.MapCarrier{}
+	for _, k := range propagator.Fields() {
+		if v := os.Getenv(strings.ToUpper(k)); v != "" {
+			carrier[k] = v
+		}
+	}
+	return propagator.Extract(ctx, carrier)
+}
+
+// TraceParent returns the W3C traceparent value of the trace context of
+// ctx, or "" if ctx has no trace context.
+func TraceParent(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	return carrier.Get("traceparent")
+}
+
+// ContextWithTraceParent returns a copy of ctx with the trace context of
+// the W3C traceparent value s.
+func ContextWithTraceParent(ctx context.Context, s string) context.Context {
+	if s == "" {
+		return ctx
+	}
+	return propagator.Extract(ctx, propagation.MapCarrier{"traceparent": s})
+}
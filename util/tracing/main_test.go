@@ -0,0 +1,40 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestPropagation(t *testing.T) {
+	const traceParent = "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+
+	ctx := ContextWithTraceParent(context.Background(), traceParent)
+	sc := trace.SpanContextFromContext(ctx)
+	require.True(t, sc.IsValid())
+	assert.Equal(t, "0af7651916cd43dd8448eb211c80319c", sc.TraceID().String())
+	assert.Equal(t, traceParent, TraceParent(ctx))
+
+	t.Run("environment", func(t *testing.T) {
+		assert.Equal(t, []string{"TRACEPARENT=" + traceParent}, Environ(ctx))
+		t.Setenv("TRACEPARENT", traceParent)
+		assert.Equal(t, traceParent, TraceParent(ContextFromEnv(context.Background())))
+	})
+
+	t.Run("http headers", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "http://localhost", nil)
+		require.NoError(t, err)
+		require.NoError(t, InjectRequest(ctx, req))
+		assert.Equal(t, traceParent, req.Header.Get("traceparent"))
+		assert.Equal(t, traceParent, TraceParent(ExtractRequest(context.Background(), req)))
+	})
+
+	t.Run("no trace context", func(t *testing.T) {
+		assert.Equal(t, "", TraceParent(context.Background()))
+		assert.Empty(t, Environ(context.Background()))
+	})
+}
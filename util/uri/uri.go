@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"path"
 	"path/filepath"
 
 	"github.com/opensvc/om3/core/rawconfig"
@@ -40,8 +41,13 @@ func (t T) Fetch() (string, error) {
 	if resp.StatusCode != 200 {
 		return "", fmt.Errorf("fetch %s error %d: %s", t.uri, resp.StatusCode, resp.Status)
 	}
+	// keep the uri path extension, so the file format can be deduced
+	var ext string
+	if u, err := url.Parse(t.uri); err == nil {
+		ext = path.Ext(u.Path)
+	}
 	createTemp := func() (*os.File, error) {
-		return os.CreateTemp(rawconfig.Paths.Tmp, ".fetch.*")
+		return os.CreateTemp(rawconfig.Paths.Tmp, ".fetch.*"+ext)
 	}
 	f, err := createTemp()
 	if err != nil {
@@ -0,0 +1,129 @@
+//go:build linux
+
+package vrrp
+
+import (
+	"fmt"
+	"net"
+
+	"golang.org/x/net/ipv4"
+)
+
+type (
+	// IPConn is the VRRP transport over a raw ipv4 socket, joined to the
+	// VRRP multicast group on an interface.
+	IPConn struct {
+		iface *net.Interface
+		local net.IP
+		conn  *ipv4.PacketConn
+	}
+)
+
+// Listen returns the VRRP transport on the interface ifName. The
+// advertisements source address is the first ipv4 address of the
+// interface not in the vips list.
+func Listen(ifName string, vips []net.IP) (*IPConn, error) {
+	iface, err := net.InterfaceByName(ifName)
+	if err != nil {
+		return nil, err
+	}
+	local, err := primaryAddr(iface, vips)
+	if err != nil {
+		return nil, err
+	}
+	c, err := net.ListenPacket(fmt.Sprintf("ip4:%d", Protocol), "0.0.0.0")
+	if err != nil {
+		return nil, err
+	}
+	conn := ipv4.NewPacketConn(c)
+	setup := func() error {
+		if err := conn.JoinGroup(iface, &net.IPAddr{IP: Group}); err != nil {
+			return fmt.Errorf("join %s on %s: %w", Group, ifName, err)
+		}
+		if err := conn.SetMulticastInterface(iface); err != nil {
+			return err
+		}
+		// RFC 5798: the advertisements are sent with a ttl of 255, and
+		// the receivers discard the advertisements with another ttl.
+		if err := conn.SetMulticastTTL(255); err != nil {
+			return err
+		}
+		if err := conn.SetMulticastLoopback(false); err != nil {
+			return err
+		}
+		return conn.SetControlMessage(ipv4.FlagTTL|ipv4.FlagDst|ipv4.FlagInterface, true)
+	}
+	if err := setup(); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return &IPConn{iface: iface, local: local, conn: conn}, nil
+}
+
+// LocalAddr returns the advertisements source address.
+func (t *IPConn) LocalAddr() net.IP {
+	return t.local
+}
+
+// WriteAdvert multicasts the advertisement on the interface.
+func (t *IPConn) WriteAdvert(a Advert) error {
+	b, err := a.Marshal(t.local, Group)
+	if err != nil {
+		return err
+	}
+	cm := &ipv4.ControlMessage{Src: t.local, IfIndex: t.iface.Index}
+	_, err = t.conn.WriteTo(b, cm, &net.IPAddr{IP: Group})
+	return err
+}
+
+// ReadAdvert returns the next valid advertisement received on the
+// interface. The invalid advertisements are discarded.
+func (t *IPConn) ReadAdvert() (Advert, net.IP, error) {
+	b := make([]byte, 1500)
+	for {
+		n, cm, addr, err := t.conn.ReadFrom(b)
+		if err != nil {
+			return Advert{}, nil, err
+		}
+		if cm == nil || cm.IfIndex != t.iface.Index || cm.TTL != 255 || !cm.Dst.Equal(Group) {
+			continue
+		}
+		ipAddr, ok := addr.(*net.IPAddr)
+		if !ok {
+			continue
+		}
+		a, err := ParseAdvert(b[:n], ipAddr.IP, cm.Dst)
+		if err != nil {
+			continue
+		}
+		return a, ipAddr.IP, nil
+	}
+}
+
+func (t *IPConn) Close() error {
+	return t.conn.Close()
+}
+
+func primaryAddr(iface *net.Interface, vips []net.IP) (net.IP, error) {
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return nil, err
+	}
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok || ipNet.IP.To4() == nil {
+			continue
+		}
+		isVIP := false
+		for _, vip := range vips {
+			if vip.Equal(ipNet.IP) {
+				isVIP = true
+				break
+			}
+		}
+		if !isVIP {
+			return ipNet.IP.To4(), nil
+		}
+	}
+	return nil, fmt.Errorf("no ipv4 address on %s", iface.Name)
+}
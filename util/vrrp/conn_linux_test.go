@@ -0,0 +1,102 @@
+//go:build linux
+
+package vrrp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vishvananda/netns"
+)
+
+// setupNetns creates two network namespaces connected by a veth pair, with
+// the 10.99.0.1/24 and 10.99.0.2/24 addresses.
+func setupNetns(t *testing.T) []string {
+	t.Helper()
+	if os.Getuid() != 0 {
+		t.Skip("skipped for non root user")
+	}
+	if _, err := exec.LookPath("ip"); err != nil {
+		t.Skip("ip not found, skip test")
+	}
+	prefix := fmt.Sprintf("vrrp%d", os.Getpid()%10000)
+	names := []string{prefix + "a", prefix + "b"}
+	run := func(args ...string) error {
+		b, err := exec.Command("ip", args...).CombinedOutput()
+		if err != nil {
+			t.Logf("ip %v: %s", args, b)
+		}
+		return err
+	}
+	for _, name := range names {
+		if err := run("netns", "add", name); err != nil {
+			t.Skip("can not create network namespaces")
+		}
+		name := name
+		t.Cleanup(func() { _ = run("netns", "del", name) })
+	}
+	require.NoError(t, run("link", "add", "veth0", "netns", names[0], "type", "veth", "peer", "name", "veth0", "netns", names[1]))
+	for i, name := range names {
+		require.NoError(t, run("-n", name, "addr", "add", fmt.Sprintf("10.99.0.%d/24", i+1), "dev", "veth0"))
+		require.NoError(t, run("-n", name, "link", "set", "veth0", "up"))
+		require.NoError(t, run("-n", name, "link", "set", "lo", "up"))
+	}
+	return names
+}
+
+// listenAt returns a transport on the veth0 interface of the network
+// namespace name.
+func listenAt(t *testing.T, name string, vips []net.IP) *IPConn {
+	t.Helper()
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	orig, err := netns.Get()
+	require.NoError(t, err)
+	defer orig.Close()
+	ns, err := netns.GetFromName(name)
+	require.NoError(t, err)
+	defer ns.Close()
+	require.NoError(t, netns.Set(ns))
+	defer func() { require.NoError(t, netns.Set(orig)) }()
+	conn, err := Listen("veth0", vips)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func TestIPConnElection(t *testing.T) {
+	names := setupNetns(t)
+	vips := []net.IP{net.ParseIP("10.99.0.100")}
+	speakers := make([]*Speaker, len(names))
+	cancels := make([]context.CancelFunc, len(names))
+	dones := make([]chan error, len(names))
+	for i, name := range names {
+		conn := listenAt(t, name, vips)
+		require.Equal(t, fmt.Sprintf("10.99.0.%d", i+1), conn.LocalAddr().String())
+		s := NewSpeaker(conn, 51, vips, uint8(200-100*i))
+		s.Interval = 100 * time.Millisecond
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() { done <- s.Run(ctx) }()
+		speakers[i], cancels[i], dones[i] = s, cancel, done
+		t.Cleanup(cancel)
+	}
+	waitMaster := func(s *Speaker, expected State) {
+		require.Eventuallyf(t, func() bool { return s.State() == expected }, 5*time.Second, 10*time.Millisecond,
+			"state is %s, expected %s", s.State(), expected)
+	}
+	waitMaster(speakers[0], StateMaster)
+	waitMaster(speakers[1], StateBackup)
+	require.Equal(t, "10.99.0.1", speakers[1].Master().String())
+
+	cancels[0]()
+	require.NoError(t, <-dones[0])
+	waitMaster(speakers[1], StateMaster)
+}
@@ -0,0 +1,152 @@
+// Package vrrp implements a VRRP version 3 speaker for IPv4, as described
+// by RFC 5798.
+//
+// The speakers of a virtual router elect the master holding the virtual
+// addresses. The master multicasts an advertisement every advertisement
+// interval. The backups take over when the master advertisements stop for
+// more than 3 intervals, the backup with the highest priority first.
+//
+// The advertisement format is:
+//
+//	byte 0:    version 3 (4 bits), type 1 (4 bits)
+//	byte 1:    virtual router id
+//	byte 2:    priority
+//	byte 3:    count of ipv4 addresses
+//	byte 4-5:  reserved (4 bits), max advertisement interval in centiseconds (12 bits)
+//	byte 6-7:  checksum
+//	byte 8-:   ipv4 addresses
+//
+// All integers are big endian. The checksum covers the ipv4 pseudo-header
+// and the advertisement.
+package vrrp
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+type (
+	// Advert is a VRRP advertisement.
+	Advert struct {
+		VRID     uint8
+		Priority uint8
+
+		// Interval is the advertisement interval of the sender, with a
+		// centisecond precision.
+		Interval time.Duration
+
+		Addrs []net.IP
+	}
+)
+
+const (
+	// Protocol is the VRRP ip protocol number.
+	Protocol = 112
+
+	version    = 3
+	typeAdvert = 1
+	headerLen  = 8
+
+	// maxInterval is the max advertisement interval encodable in the 12
+	// bits centisecond field.
+	maxInterval = 0xfff * 10 * time.Millisecond
+)
+
+var (
+	// Group is the VRRP ipv4 multicast group.
+	Group = net.IPv4(224, 0, 0, 18)
+
+	ErrInvalidAdvert = errors.New("invalid vrrp advertisement")
+)
+
+// Marshal returns the wire format of the advertisement sent from src to
+// dst.
+func (t Advert) Marshal(src, dst net.IP) ([]byte, error) {
+	if len(t.Addrs) > 255 {
+		return nil, fmt.Errorf("%w: too many addresses", ErrInvalidAdvert)
+	}
+	if t.Interval <= 0 || t.Interval > maxInterval {
+		return nil, fmt.Errorf("%w: interval %s out of range", ErrInvalidAdvert, t.Interval)
+	}
+	b := make([]byte, headerLen+4*len(t.Addrs))
+	b[0] = version<<4 | typeAdvert
+	b[1] = t.VRID
+	b[2] = t.Priority
+	b[3] = uint8(len(t.Addrs))
+	binary.BigEndian.PutUint16(b[4:6], uint16(t.Interval/(10*time.Millisecond))&0xfff)
+	for i, ip := range t.Addrs {
+		ip4 := ip.To4()
+		if ip4 == nil {
+			return nil, fmt.Errorf("%w: %s is not an ipv4 address", ErrInvalidAdvert, ip)
+		}
+		copy(b[headerLen+4*i:], ip4)
+	}
+	sum, err := checksum(b, src, dst)
+	if err != nil {
+		return nil, err
+	}
+	binary.BigEndian.PutUint16(b[6:8], sum)
+	return b, nil
+}
+
+// ParseAdvert parses the wire format of an advertisement sent from src to
+// dst, verifying its version, type and checksum.
+func ParseAdvert(b []byte, src, dst net.IP) (Advert, error) {
+	var t Advert
+	if len(b) < headerLen {
+		return t, fmt.Errorf("%w: %d bytes is too short", ErrInvalidAdvert, len(b))
+	}
+	if v := b[0] >> 4; v != version {
+		return t, fmt.Errorf("%w: unsupported version %d", ErrInvalidAdvert, v)
+	}
+	if typ := b[0] & 0xf; typ != typeAdvert {
+		return t, fmt.Errorf("%w: unsupported type %d", ErrInvalidAdvert, typ)
+	}
+	count := int(b[3])
+	if len(b) < headerLen+4*count {
+		return t, fmt.Errorf("%w: %d bytes is too short for %d addresses", ErrInvalidAdvert, len(b), count)
+	}
+	b = b[:headerLen+4*count]
+	if sum, err := checksum(b, src, dst); err != nil {
+		return t, err
+	} else if sum != 0 {
+		return t, fmt.Errorf("%w: bad checksum", ErrInvalidAdvert)
+	}
+	t.VRID = b[1]
+	t.Priority = b[2]
+	t.Interval = time.Duration(binary.BigEndian.Uint16(b[4:6])&0xfff) * 10 * time.Millisecond
+	t.Addrs = make([]net.IP, count)
+	for i := range t.Addrs {
+		t.Addrs[i] = net.IPv4(b[headerLen+4*i], b[headerLen+4*i+1], b[headerLen+4*i+2], b[headerLen+4*i+3])
+	}
+	return t, nil
+}
+
+// checksum returns the internet checksum of the ipv4 pseudo-header and b.
+// The checksum of a message including its checksum field is 0.
+func checksum(b []byte, src, dst net.IP) (uint16, error) {
+	src4, dst4 := src.To4(), dst.To4()
+	if src4 == nil || dst4 == nil {
+		return 0, fmt.Errorf("%w: checksum requires ipv4 source and destination", ErrInvalidAdvert)
+	}
+	pseudo := make([]byte, 12, 12+len(b))
+	copy(pseudo[0:4], src4)
+	copy(pseudo[4:8], dst4)
+	pseudo[9] = Protocol
+	binary.BigEndian.PutUint16(pseudo[10:12], uint16(len(b)))
+	data := append(pseudo, b...)
+	var sum uint32
+	for i := 0; i+1 < len(data); i += 2 {
+		sum += uint32(data[i])<<8 | uint32(data[i+1])
+	}
+	if len(data)%2 == 1 {
+		sum += uint32(data[len(data)-1]) << 8
+	}
+	for sum > 0xffff {
+		sum = sum>>16 + sum&0xffff
+	}
+	return ^uint16(sum), nil
+}
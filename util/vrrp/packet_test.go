@@ -0,0 +1,64 @@
+package vrrp
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdvertRoundTrip(t *testing.T) {
+	src := net.ParseIP("192.168.10.11")
+	a := Advert{
+		VRID:     51,
+		Priority: 254,
+		Interval: 1500 * time.Millisecond,
+		Addrs:    []net.IP{net.ParseIP("192.168.10.100"), net.ParseIP("192.168.10.101")},
+	}
+	b, err := a.Marshal(src, Group)
+	require.NoError(t, err)
+	assert.Len(t, b, 16)
+	assert.Equal(t, []byte{0x31, 51, 254, 2, 0, 150}, b[:6])
+
+	parsed, err := ParseAdvert(b, src, Group)
+	require.NoError(t, err)
+	assert.Equal(t, a.VRID, parsed.VRID)
+	assert.Equal(t, a.Priority, parsed.Priority)
+	assert.Equal(t, a.Interval, parsed.Interval)
+	require.Len(t, parsed.Addrs, 2)
+	assert.True(t, a.Addrs[1].Equal(parsed.Addrs[1]))
+
+	t.Run("the checksum covers the pseudo-header", func(t *testing.T) {
+		_, err := ParseAdvert(b, net.ParseIP("192.168.10.12"), Group)
+		assert.ErrorIs(t, err, ErrInvalidAdvert)
+	})
+
+	t.Run("bad checksum", func(t *testing.T) {
+		c := append([]byte{}, b...)
+		c[2] = 1
+		_, err := ParseAdvert(c, src, Group)
+		assert.ErrorIs(t, err, ErrInvalidAdvert)
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		c := append([]byte{}, b...)
+		c[0] = 0x21
+		_, err := ParseAdvert(c, src, Group)
+		assert.ErrorIs(t, err, ErrInvalidAdvert)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		_, err := ParseAdvert(b[:12], src, Group)
+		assert.ErrorIs(t, err, ErrInvalidAdvert)
+	})
+}
+
+func TestAdvertMarshalErrors(t *testing.T) {
+	src := net.ParseIP("192.168.10.11")
+	_, err := Advert{VRID: 1, Priority: 100, Interval: time.Minute, Addrs: []net.IP{src}}.Marshal(src, Group)
+	assert.ErrorIs(t, err, ErrInvalidAdvert, "interval out of range")
+	_, err = Advert{VRID: 1, Priority: 100, Interval: time.Second, Addrs: []net.IP{net.ParseIP("fe80::1")}}.Marshal(src, Group)
+	assert.ErrorIs(t, err, ErrInvalidAdvert, "ipv6 address")
+}
@@ -0,0 +1,361 @@
+package vrrp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+)
+
+type (
+	// Conn is the transport of the advertisements of a virtual router.
+	Conn interface {
+		// ReadAdvert blocks until an advertisement is received, and returns
+		// it with its source address.
+		ReadAdvert() (Advert, net.IP, error)
+
+		// WriteAdvert multicasts the advertisement.
+		WriteAdvert(Advert) error
+
+		// LocalAddr returns the primary address of the local interface,
+		// used as the advertisements source address.
+		LocalAddr() net.IP
+
+		Close() error
+	}
+
+	// State is the state of a speaker.
+	State int
+
+	// Speaker is the VRRP speaker of a virtual router.
+	Speaker struct {
+		// VRID is the virtual router id, from 1 to 255.
+		VRID uint8
+
+		// Addrs are the virtual addresses.
+		Addrs []net.IP
+
+		// Interval is the advertisement interval.
+		Interval time.Duration
+
+		// Preempt allows a higher priority backup to take over from a lower
+		// priority master.
+		Preempt bool
+
+		// OnMaster is called when the speaker becomes master, to plumb the
+		// virtual addresses.
+		OnMaster func() error
+
+		// OnBackup is called when the speaker leaves the master state, to
+		// unplumb the virtual addresses.
+		OnBackup func() error
+
+		// OnStateChange is called after every state transition.
+		OnStateChange func(State)
+
+		conn Conn
+
+		mu       sync.RWMutex
+		state    State
+		priority uint8
+		master   net.IP
+		prioC    chan struct{}
+	}
+
+	received struct {
+		advert Advert
+		src    net.IP
+	}
+)
+
+const (
+	StateInit State = iota
+	StateBackup
+	StateMaster
+)
+
+var (
+	stateToString = map[State]string{
+		StateInit:   "init",
+		StateBackup: "backup",
+		StateMaster: "master",
+	}
+)
+
+func (t State) String() string {
+	if s, ok := stateToString[t]; ok {
+		return s
+	}
+	return "unknown"
+}
+
+// NewSpeaker returns a speaker of the virtual router vrid advertising
+// through conn.
+func NewSpeaker(conn Conn, vrid uint8, addrs []net.IP, priority uint8) *Speaker {
+	return &Speaker{
+		VRID:     vrid,
+		Addrs:    addrs,
+		Interval: time.Second,
+		Preempt:  true,
+		conn:     conn,
+		priority: priority,
+		prioC:    make(chan struct{}, 1),
+	}
+}
+
+// State returns the current state of the speaker.
+func (t *Speaker) State() State {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.state
+}
+
+// Master returns the address of the current master, as seen by the
+// speaker.
+func (t *Speaker) Master() net.IP {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.master
+}
+
+// Priority returns the current priority of the speaker.
+func (t *Speaker) Priority() uint8 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.priority
+}
+
+// SetPriority changes the priority of the speaker. The priority 0 is
+// reserved to the master resignation, and 255 to the virtual addresses
+// owner, so the priority is bounded to [1, 254].
+func (t *Speaker) SetPriority(priority uint8) {
+	switch {
+	case priority < 1:
+		priority = 1
+	case priority > 254:
+		priority = 254
+	}
+	t.mu.Lock()
+	changed := t.priority != priority
+	t.priority = priority
+	t.mu.Unlock()
+	if changed {
+		select {
+		case t.prioC <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Run runs the speaker state machine until ctx is done. On return, a
+// master speaker resigns, so a backup takes over without waiting for the
+// master down interval.
+func (t *Speaker) Run(ctx context.Context) error {
+	if t.VRID == 0 {
+		return fmt.Errorf("invalid vrid 0")
+	}
+	if len(t.Addrs) == 0 {
+		return fmt.Errorf("no virtual address")
+	}
+	if t.Interval <= 0 || t.Interval > maxInterval {
+		return fmt.Errorf("advertisement interval %s out of range", t.Interval)
+	}
+	t.SetPriority(t.Priority())
+
+	recvC := make(chan received)
+	errC := make(chan error, 1)
+	go func() {
+		for {
+			a, src, err := t.conn.ReadAdvert()
+			if err != nil {
+				errC <- err
+				return
+			}
+			select {
+			case recvC <- received{advert: a, src: src}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	masterInterval := t.Interval
+	timer := time.NewTimer(t.masterDownInterval(masterInterval))
+	defer timer.Stop()
+	t.setState(StateBackup, nil)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return t.shutdown()
+		case err := <-errC:
+			if errors.Is(ctx.Err(), context.Canceled) {
+				return t.shutdown()
+			}
+			return t.abort(err)
+		case <-t.prioC:
+			if t.State() == StateMaster {
+				// advertise the new priority without delay
+				if err := t.advertise(t.Priority()); err != nil {
+					return t.abort(err)
+				}
+				resetTimer(timer, t.Interval)
+			}
+		case <-timer.C:
+			switch t.State() {
+			case StateBackup:
+				if err := t.becomeMaster(); err != nil {
+					return t.abort(err)
+				}
+			case StateMaster:
+				if err := t.advertise(t.Priority()); err != nil {
+					return t.abort(err)
+				}
+			}
+			if t.State() == StateMaster {
+				timer.Reset(t.Interval)
+			} else {
+				timer.Reset(t.masterDownInterval(masterInterval))
+			}
+		case r := <-recvC:
+			if r.advert.VRID != t.VRID {
+				continue
+			}
+			if r.src.Equal(t.conn.LocalAddr()) {
+				continue
+			}
+			priority := t.Priority()
+			switch t.State() {
+			case StateBackup:
+				switch {
+				case r.advert.Priority == 0:
+					// the master resigned
+					resetTimer(timer, t.skewTime(masterInterval))
+				case !t.Preempt || r.advert.Priority >= priority:
+					masterInterval = r.advert.Interval
+					t.setMaster(r.src)
+					resetTimer(timer, t.masterDownInterval(masterInterval))
+				}
+			case StateMaster:
+				switch {
+				case r.advert.Priority == 0:
+					// a resigning master, reassert our mastership
+					if err := t.advertise(priority); err != nil {
+						return t.abort(err)
+					}
+					resetTimer(timer, t.Interval)
+				case r.advert.Priority > priority || (r.advert.Priority == priority && ipGreater(r.src, t.conn.LocalAddr())):
+					masterInterval = r.advert.Interval
+					if err := t.becomeBackup(r.src); err != nil {
+						return t.abort(err)
+					}
+					resetTimer(timer, t.masterDownInterval(masterInterval))
+				}
+			}
+		}
+	}
+}
+
+func (t *Speaker) becomeMaster() error {
+	if err := t.advertise(t.Priority()); err != nil {
+		return err
+	}
+	if t.OnMaster != nil {
+		if err := t.OnMaster(); err != nil {
+			return err
+		}
+	}
+	t.setState(StateMaster, t.conn.LocalAddr())
+	return nil
+}
+
+func (t *Speaker) becomeBackup(master net.IP) error {
+	if t.OnBackup != nil {
+		if err := t.OnBackup(); err != nil {
+			return err
+		}
+	}
+	t.setState(StateBackup, master)
+	return nil
+}
+
+// abort shuts the speaker down on a fatal error.
+func (t *Speaker) abort(err error) error {
+	return errors.Join(err, t.shutdown())
+}
+
+// shutdown resigns the mastership with a priority 0 advertisement, and
+// unplumbs the virtual addresses.
+func (t *Speaker) shutdown() error {
+	defer t.setState(StateInit, nil)
+	if t.State() != StateMaster {
+		return nil
+	}
+	errs := t.advertise(0)
+	if t.OnBackup != nil {
+		errs = errors.Join(errs, t.OnBackup())
+	}
+	return errs
+}
+
+func (t *Speaker) advertise(priority uint8) error {
+	return t.conn.WriteAdvert(Advert{
+		VRID:     t.VRID,
+		Priority: priority,
+		Interval: t.Interval,
+		Addrs:    t.Addrs,
+	})
+}
+
+func (t *Speaker) setMaster(master net.IP) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.master = master
+}
+
+func (t *Speaker) setState(state State, master net.IP) {
+	t.mu.Lock()
+	changed := t.state != state
+	t.state = state
+	t.master = master
+	t.mu.Unlock()
+	if changed && t.OnStateChange != nil {
+		t.OnStateChange(state)
+	}
+}
+
+// skewTime returns the delay added to the master down interval, so the
+// higher priority backup takes over first.
+func (t *Speaker) skewTime(masterInterval time.Duration) time.Duration {
+	return time.Duration(256-int(t.Priority())) * masterInterval / 256
+}
+
+// masterDownInterval returns the delay after which a backup not receiving
+// the master advertisements takes over.
+func (t *Speaker) masterDownInterval(masterInterval time.Duration) time.Duration {
+	return 3*masterInterval + t.skewTime(masterInterval)
+}
+
+func resetTimer(timer *time.Timer, d time.Duration) {
+	if !timer.Stop() {
+		select {
+		case <-timer.C:
+		default:
+		}
+	}
+	timer.Reset(d)
+}
+
+// ipGreater returns true if the ipv4 address a is greater than b, in
+// network byte order.
+func ipGreater(a, b net.IP) bool {
+	a4, b4 := a.To4(), b.To4()
+	for i := range a4 {
+		if a4[i] != b4[i] {
+			return a4[i] > b4[i]
+		}
+	}
+	return false
+}
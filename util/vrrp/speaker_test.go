@@ -0,0 +1,193 @@
+package vrrp
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	// hub emulates the multicast delivery of advertisements between
+	// memory transports.
+	hub struct {
+		mu    sync.Mutex
+		conns []*memConn
+	}
+
+	memConn struct {
+		hub    *hub
+		local  net.IP
+		recvC  chan received
+		closeC chan struct{}
+		once   sync.Once
+	}
+
+	testSpeaker struct {
+		*Speaker
+		cancel context.CancelFunc
+		done   chan error
+	}
+)
+
+func (t *hub) conn(local string) *memConn {
+	c := &memConn{
+		hub:    t,
+		local:  net.ParseIP(local).To4(),
+		recvC:  make(chan received, 100),
+		closeC: make(chan struct{}),
+	}
+	t.mu.Lock()
+	t.conns = append(t.conns, c)
+	t.mu.Unlock()
+	return c
+}
+
+func (t *memConn) LocalAddr() net.IP {
+	return t.local
+}
+
+func (t *memConn) WriteAdvert(a Advert) error {
+	b, err := a.Marshal(t.local, Group)
+	if err != nil {
+		return err
+	}
+	t.hub.mu.Lock()
+	defer t.hub.mu.Unlock()
+	for _, c := range t.hub.conns {
+		if c == t {
+			continue
+		}
+		parsed, err := ParseAdvert(b, t.local, Group)
+		if err != nil {
+			return err
+		}
+		select {
+		case c.recvC <- received{advert: parsed, src: t.local}:
+		default:
+		}
+	}
+	return nil
+}
+
+func (t *memConn) ReadAdvert() (Advert, net.IP, error) {
+	select {
+	case r := <-t.recvC:
+		return r.advert, r.src, nil
+	case <-t.closeC:
+		return Advert{}, nil, net.ErrClosed
+	}
+}
+
+func (t *memConn) Close() error {
+	t.once.Do(func() { close(t.closeC) })
+	return nil
+}
+
+func startSpeaker(t *testing.T, h *hub, local string, priority uint8, preempt bool) *testSpeaker {
+	t.Helper()
+	conn := h.conn(local)
+	s := NewSpeaker(conn, 51, []net.IP{net.ParseIP("10.0.0.100")}, priority)
+	s.Interval = 20 * time.Millisecond
+	s.Preempt = preempt
+	ctx, cancel := context.WithCancel(context.Background())
+	ts := &testSpeaker{Speaker: s, cancel: cancel, done: make(chan error, 1)}
+	go func() {
+		ts.done <- s.Run(ctx)
+		_ = conn.Close()
+	}()
+	t.Cleanup(ts.stop)
+	return ts
+}
+
+func (t *testSpeaker) stop() {
+	t.cancel()
+	<-t.done
+	t.done <- nil
+}
+
+func waitState(t *testing.T, s *testSpeaker, state State) {
+	t.Helper()
+	require.Eventuallyf(t, func() bool { return s.State() == state }, 2*time.Second, 5*time.Millisecond,
+		"speaker %s state is %s, expected %s", s.conn.LocalAddr(), s.State(), state)
+}
+
+func TestElection(t *testing.T) {
+	h := &hub{}
+	s1 := startSpeaker(t, h, "10.0.0.1", 200, true)
+	s2 := startSpeaker(t, h, "10.0.0.2", 100, true)
+	waitState(t, s1, StateMaster)
+	waitState(t, s2, StateBackup)
+	assert.True(t, s2.Master().Equal(net.ParseIP("10.0.0.1")))
+
+	t.Run("the higher priority backup preempts", func(t *testing.T) {
+		s1.SetPriority(50)
+		waitState(t, s2, StateMaster)
+		waitState(t, s1, StateBackup)
+		s1.SetPriority(200)
+		waitState(t, s1, StateMaster)
+		waitState(t, s2, StateBackup)
+	})
+
+	t.Run("the master resigns on stop", func(t *testing.T) {
+		begin := time.Now()
+		s1.stop()
+		waitState(t, s2, StateMaster)
+		assert.Less(t, time.Since(begin), 3*s2.Interval, "the backup takes over before the master down interval")
+	})
+}
+
+func TestNoPreempt(t *testing.T) {
+	h := &hub{}
+	s2 := startSpeaker(t, h, "10.0.0.2", 100, false)
+	waitState(t, s2, StateMaster)
+	s1 := startSpeaker(t, h, "10.0.0.1", 200, false)
+	waitState(t, s1, StateBackup)
+	time.Sleep(10 * s1.Interval)
+	assert.Equal(t, StateBackup, s1.State(), "the higher priority backup does not preempt")
+	assert.Equal(t, StateMaster, s2.State())
+}
+
+func TestEqualPriority(t *testing.T) {
+	h := &hub{}
+	s1 := startSpeaker(t, h, "10.0.0.1", 100, true)
+	s2 := startSpeaker(t, h, "10.0.0.2", 100, true)
+	// on equal priorities, the master with the greater address wins
+	waitState(t, s2, StateMaster)
+	waitState(t, s1, StateBackup)
+}
+
+func TestCallbacks(t *testing.T) {
+	h := &hub{}
+	var (
+		mu     sync.Mutex
+		events []string
+	)
+	conn := h.conn("10.0.0.1")
+	s := NewSpeaker(conn, 51, []net.IP{net.ParseIP("10.0.0.100")}, 100)
+	s.Interval = 20 * time.Millisecond
+	s.OnMaster = func() error {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, "plumb")
+		return nil
+	}
+	s.OnBackup = func() error {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, "unplumb")
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- s.Run(ctx) }()
+	require.Eventually(t, func() bool { return s.State() == StateMaster }, 2*time.Second, 5*time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+	assert.Equal(t, StateInit, s.State())
+	assert.Equal(t, []string{"plumb", "unplumb"}, events)
+}
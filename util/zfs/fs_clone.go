@@ -0,0 +1,28 @@
+package zfs
+
+import (
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/util/args"
+	"github.com/opensvc/om3/util/command"
+)
+
+// Clone creates the dataset <dst> from the filesystem snapshot <snapshot>,
+// with the <props> properties formatted as <prop>=<value>.
+func (t *Filesystem) Clone(snapshot, dst string, props ...string) error {
+	a := args.New()
+	a.Append("clone")
+	for _, prop := range props {
+		a.Append("-o", prop)
+	}
+	a.Append(t.Name+"@"+snapshot, dst)
+	cmd := command.New(
+		command.WithName("zfs"),
+		command.WithArgs(a.Get()),
+		command.WithLogger(t.Log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
@@ -0,0 +1,21 @@
+package zfs
+
+import (
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/util/command"
+)
+
+// Rollback rolls the filesystem back to its snapshot <snapshot>. The
+// snapshots more recent than <snapshot> are destroyed.
+func (t *Filesystem) Rollback(snapshot string) error {
+	cmd := command.New(
+		command.WithName("zfs"),
+		command.WithVarArgs("rollback", "-r", t.Name+"@"+snapshot),
+		command.WithLogger(t.Log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
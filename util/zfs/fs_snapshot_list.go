@@ -0,0 +1,63 @@
+package zfs
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/util/command"
+)
+
+type (
+	// SnapshotInfo describes a snapshot of a dataset.
+	SnapshotInfo struct {
+		// Name is the snapshot name, without the dataset name prefix.
+		Name     string
+		Creation time.Time
+		Used     int64
+	}
+	SnapshotInfos []SnapshotInfo
+)
+
+// ListSnapshots returns the snapshots of the filesystem, oldest first.
+func (t *Filesystem) ListSnapshots() (SnapshotInfos, error) {
+	cmd := command.New(
+		command.WithName("zfs"),
+		command.WithVarArgs("list", "-Hp", "-t", "snapshot", "-d", "1", "-o", "name,creation,used", "-s", "creation", t.Name),
+		command.WithBufferedStdout(),
+		command.WithLogger(t.Log),
+		command.WithCommandLogLevel(zerolog.DebugLevel),
+		command.WithStdoutLogLevel(zerolog.DebugLevel),
+		command.WithStderrLogLevel(zerolog.DebugLevel),
+	)
+	b, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	return parseSnapshotInfos(b, t.Name), nil
+}
+
+func parseSnapshotInfos(b []byte, name string) SnapshotInfos {
+	data := make(SnapshotInfos, 0)
+	prefix := name + "@"
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		words := strings.Split(strings.TrimSpace(scanner.Text()), "\t")
+		if len(words) != 3 || !strings.HasPrefix(words[0], prefix) {
+			continue
+		}
+		info := SnapshotInfo{Name: strings.TrimPrefix(words[0], prefix)}
+		if i, err := strconv.ParseInt(words[1], 10, 64); err == nil {
+			info.Creation = time.Unix(i, 0)
+		}
+		if i, err := strconv.ParseInt(words[2], 10, 64); err == nil {
+			info.Used = i
+		}
+		data = append(data, info)
+	}
+	return data
+}
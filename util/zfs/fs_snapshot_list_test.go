@@ -0,0 +1,16 @@
+package zfs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSnapshotInfos(t *testing.T) {
+	b := []byte("pool/vol@a\t1700000000\t1024\npool/vol@b\t1700000100\t0\npool/vol/child@c\t1700000200\t0\ngarbage\n")
+	l := parseSnapshotInfos(b, "pool/vol")
+	require.Len(t, l, 2)
+	require.Equal(t, SnapshotInfo{Name: "a", Creation: time.Unix(1700000000, 0), Used: 1024}, l[0])
+	require.Equal(t, "b", l[1].Name)
+}